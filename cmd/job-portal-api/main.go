@@ -10,6 +10,7 @@ import (
 	"job-portal-api/internal/handler"
 	"job-portal-api/internal/repository"
 	"job-portal-api/internal/service"
+	"job-portal-api/internal/storage"
 	"net/http"
 	"os"
 	"os/signal"
@@ -109,31 +110,136 @@ func StartApp() error {
 		return err
 	}
 
+	taxonomyRepo, err := repository.NewTaxonomyRepo(db)
+	if err != nil {
+		log.Info().Msg("error while initializing the taxonomy repository")
+		return err
+	}
+
+	applicationRepo, err := repository.NewApplicationRepo(db)
+	if err != nil {
+		log.Info().Msg("error while initializing the application repository")
+		return err
+	}
+
+	screeningBatchRepo, err := repository.NewScreeningBatchRepo(db)
+	if err != nil {
+		log.Info().Msg("error while initializing the screening batch repository")
+		return err
+	}
+
+	attachmentRepo, err := repository.NewAttachmentRepo(db)
+	if err != nil {
+		log.Info().Msg("error while initializing the attachment repository")
+		return err
+	}
+
+	profileRepo, err := repository.NewCandidateProfileRepo(db)
+	if err != nil {
+		log.Info().Msg("error while initializing the candidate profile repository")
+		return err
+	}
+
+	notificationRepo, err := repository.NewNotificationRepo(db)
+	if err != nil {
+		log.Info().Msg("error while initializing the notification repository")
+		return err
+	}
+
+	reviewRepo, err := repository.NewApplicationReviewRepo(db)
+	if err != nil {
+		log.Info().Msg("error while initializing the application review repository")
+		return err
+	}
+
+	//attachments are kept on the local disk or in an s3 compatible bucket
+	store, err := newStorage(cfg.StorageConfig)
+	if err != nil {
+		log.Info().Msg("error while initializing the attachment storage")
+		return fmt.Errorf("error while initializing the attachment storage : %w", err)
+	}
+
 	userService, err := service.NewUserService(userRepo, auth, rdb)
 	if err != nil {
 		log.Info().Msg("error while initializing user service")
 		return fmt.Errorf("error while initializing uservservice : %w", err)
 	}
 
-	companyService, err := service.NewCompanyService(companyRepo)
+	companyService, err := service.NewCompanyService(companyRepo, notificationRepo)
 	if err != nil {
 		log.Info().Msg("error while initializing company service")
 		return fmt.Errorf("error while initializing company service : %w", err)
 	}
 
-	jobService, err := service.NewJobService(jobRepo, rdb)
+	rates, err := service.NewExchangeRates(cfg.SalaryConfig.BaseCurrency, cfg.SalaryConfig.ExchangeRates)
+	if err != nil {
+		log.Info().Msg("error while reading the exchange rates")
+		return fmt.Errorf("error while reading the exchange rates : %w", err)
+	}
+
+	jobService, err := service.NewJobService(jobRepo, companyRepo, taxonomyRepo, rdb, rates)
 	if err != nil {
 		log.Info().Msg("error while initializing job service")
 		return fmt.Errorf("error while initializing job service : %w", err)
 	}
 
+	taxonomyService, err := service.NewTaxonomyService(taxonomyRepo)
+	if err != nil {
+		log.Info().Msg("error while initializing taxonomy service")
+		return fmt.Errorf("error while initializing taxonomy service : %w", err)
+	}
+
+	applicationService, err := service.NewApplicationService(applicationRepo, jobRepo, companyRepo, rdb)
+	if err != nil {
+		log.Info().Msg("error while initializing application service")
+		return fmt.Errorf("error while initializing application service : %w", err)
+	}
+
+	screeningBatchService, err := service.NewScreeningBatchService(screeningBatchRepo, jobRepo, rdb)
+	if err != nil {
+		log.Info().Msg("error while initializing screening batch service")
+		return fmt.Errorf("error while initializing screening batch service : %w", err)
+	}
+
+	attachmentService, err := service.NewAttachmentService(attachmentRepo, applicationRepo, jobRepo, companyRepo, store, cfg.StorageConfig.MaxAttachmentSize)
+	if err != nil {
+		log.Info().Msg("error while initializing attachment service")
+		return fmt.Errorf("error while initializing attachment service : %w", err)
+	}
+
+	resumeService, err := service.NewResumeService(taxonomyRepo, attachmentRepo, store, cfg.StorageConfig.MaxAttachmentSize)
+	if err != nil {
+		log.Info().Msg("error while initializing resume service")
+		return fmt.Errorf("error while initializing resume service : %w", err)
+	}
+
+	profileService, err := service.NewCandidateProfileService(profileRepo, applicationRepo, jobRepo, rdb)
+	if err != nil {
+		log.Info().Msg("error while initializing candidate profile service")
+		return fmt.Errorf("error while initializing candidate profile service : %w", err)
+	}
+
+	reviewService, err := service.NewApplicationReviewService(reviewRepo, applicationRepo, jobRepo, companyRepo)
+	if err != nil {
+		log.Info().Msg("error while initializing application review service")
+		return fmt.Errorf("error while initializing application review service : %w", err)
+	}
+
+	//expiring job postings past their end date in the background
+	sweeperCtx, stopSweeper := context.WithCancel(context.Background())
+	defer stopSweeper()
+	go service.StartJobExpirySweeper(sweeperCtx, jobService, time.Duration(cfg.AppConfig.JobSweepInterval)*time.Second)
+
+	//screening submitted application batches in the background
+	go service.StartScreeningBatchWorker(sweeperCtx, screeningBatchService, time.Duration(cfg.AppConfig.ScreeningBatchInterval)*time.Second)
+
 	//initilazing http server
 	api := http.Server{
 		Addr:         fmt.Sprintf(":%s", cfg.AppConfig.Port),
 		ReadTimeout:  time.Duration(cfg.AppConfig.ReadTimeOut) * time.Second,
 		WriteTimeout: time.Duration(cfg.AppConfig.WriteTimeOut) * time.Second,
 		IdleTimeout:  time.Duration(cfg.AppConfig.IdleTimeout) * time.Second,
-		Handler:      handler.SetupApi(auth, userService, companyService, jobService),
+		Handler:      handler.SetupApi(auth, userService, companyService, jobService, taxonomyService, applicationService, screeningBatchService, attachmentService, resumeService, profileService, reviewService),
 	}
 
 	serverErrors := make(chan error, 1)
@@ -167,3 +273,19 @@ func StartApp() error {
 	return nil
 
 }
+
+func newStorage(cfg config.StorageConfig) (storage.Storage, error) {
+	switch cfg.Backend {
+	case "local":
+		return storage.NewLocalStorage(cfg.LocalDir)
+	case "s3":
+		return storage.NewS3Storage(storage.S3Config{
+			Endpoint:  cfg.S3Endpoint,
+			Region:    cfg.S3Region,
+			Bucket:    cfg.S3Bucket,
+			AccessKey: cfg.S3AccessKey,
+			SecretKey: cfg.S3SecretKey,
+		}, &http.Client{Timeout: time.Minute})
+	}
+	return nil, fmt.Errorf("unknown storage backend %q", cfg.Backend)
+}
@@ -0,0 +1,60 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"job-portal-api/internal/database"
+	"job-portal-api/internal/repository"
+	"job-portal-api/internal/seed"
+	"job-portal-api/internal/service"
+
+	"github.com/rs/zerolog/log"
+)
+
+func main() {
+	err := SeedTaxonomies()
+	if err != nil {
+		log.Panic().Err(err).Send()
+	}
+}
+
+// SeedTaxonomies loads the reference data catalogue into the database. It can
+// be run any number of times, entries that already exist are left untouched.
+func SeedTaxonomies() error {
+	catalogueFile := flag.String("file", "", "path to a JSON catalogue, defaults to the bundled catalogue")
+	flag.Parse()
+
+	catalogue, err := seed.LoadCatalogue(*catalogueFile)
+	if err != nil {
+		return err
+	}
+
+	db, err := database.DatabaseConnection()
+	if err != nil {
+		log.Info().Msg("error while opening data base connection")
+		return fmt.Errorf("error while opening data base connection : %w", err)
+	}
+
+	taxonomyRepo, err := repository.NewTaxonomyRepo(db)
+	if err != nil {
+		log.Info().Msg("error while initializing the taxonomy repository")
+		return err
+	}
+
+	taxonomyService, err := service.NewTaxonomyService(taxonomyRepo)
+	if err != nil {
+		log.Info().Msg("error while initializing taxonomy service")
+		return fmt.Errorf("error while initializing taxonomy service : %w", err)
+	}
+
+	created, err := taxonomyService.SeedTaxonomies(catalogue)
+	if err != nil {
+		return fmt.Errorf("error while seeding reference data : %w", err)
+	}
+
+	for kind, count := range created {
+		log.Info().Str("kind", kind).Int("created", count).Msg("reference data seeded")
+	}
+
+	return nil
+}
@@ -1,6 +1,7 @@
 package config
 
 import (
+	"errors"
 	"log"
 
 	env "github.com/Netflix/go-env"
@@ -13,6 +14,8 @@ type Config struct {
 	PostgresConfig PostgresConfig
 	AuthConfig     AuthConfig
 	RedisConfig    RedisConfig
+	SalaryConfig   SalaryConfig
+	StorageConfig  StorageConfig
 }
 
 type AppConfig struct {
@@ -20,6 +23,12 @@ type AppConfig struct {
 	ReadTimeOut  uint32 `env:"APP_READTIMEOUT,required=true"`
 	WriteTimeOut uint32 `env:"APP_WRIETIMEOUT,required=true"`
 	IdleTimeout  uint32 `env:"APP_IDLETIMEOUT,required=true"`
+	//interval in seconds between sweeps that expire job postings
+	JobSweepInterval uint32 `env:"APP_JOBSWEEPINTERVAL,default=60"`
+	//interval in seconds between checks for unfinished screening batches
+	ScreeningBatchInterval uint32 `env:"APP_SCREENINGBATCHINTERVAL,default=30"`
+	//user id that takes over the companies created before companies had an owner, 0 leaves them without one
+	DefaultCompanyOwner uint `env:"APP_DEFAULTCOMPANYOWNER,default=0"`
 }
 
 type PostgresConfig struct {
@@ -43,12 +52,48 @@ type RedisConfig struct {
 	Db       string `env:"DB,required=true"`
 }
 
+type SalaryConfig struct {
+	BaseCurrency string `env:"SALARY_BASECURRENCY,default=INR"`
+	//semicolon separated list of CODE:rate, the rate being the base currency value of one unit
+	ExchangeRates string `env:"SALARY_EXCHANGERATES,default=USD:83.0;EUR:90.0;GBP:105.0"`
+}
+
+type StorageConfig struct {
+	//local or s3
+	Backend  string `env:"STORAGE_BACKEND,default=local"`
+	LocalDir string `env:"STORAGE_LOCALDIR,default=uploads"`
+	//largest attachment accepted, in bytes
+	MaxAttachmentSize int64  `env:"STORAGE_MAXATTACHMENTSIZE,default=5242880"`
+	S3Endpoint        string `env:"STORAGE_S3ENDPOINT"`
+	S3Region          string `env:"STORAGE_S3REGION,default=us-east-1"`
+	S3Bucket          string `env:"STORAGE_S3BUCKET"`
+	S3AccessKey       string `env:"STORAGE_S3ACCESSKEY"`
+	S3SecretKey       string `env:"STORAGE_S3SECRETKEY"`
+}
+
 func init() {
 
 	_, err := env.UnmarshalFromEnviron(&cfg)
 	if err != nil {
 		log.Panic(err)
 	}
+
+	err = validate(cfg)
+	if err != nil {
+		log.Panic(err)
+	}
+}
+
+// validate rejects the values that would only fail once the server is
+// running, e.g. a zero interval panics in time.NewTicker.
+func validate(cfg Config) error {
+	if cfg.AppConfig.JobSweepInterval == 0 {
+		return errors.New("APP_JOBSWEEPINTERVAL must be greater than zero")
+	}
+	if cfg.AppConfig.ScreeningBatchInterval == 0 {
+		return errors.New("APP_SCREENINGBATCHINTERVAL must be greater than zero")
+	}
+	return nil
 }
 
 func GetConfig() Config {
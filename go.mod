@@ -7,6 +7,7 @@ require (
 	github.com/golang-jwt/jwt v3.2.2+incompatible
 	github.com/redis/go-redis/v9 v9.3.0
 	go.uber.org/mock v0.3.0
+	golang.org/x/sync v0.5.0
 	gorm.io/driver/postgres v1.5.4
 )
 
@@ -21,6 +21,11 @@ type RDBLayer struct {
 type Caching interface {
 	AddToTheCache(ctx context.Context, jID uint, jobData model.Job) error
 	GetTheCacheData(ctx context.Context, jID uint) (string, error)
+	DeleteTheCacheData(ctx context.Context, jID uint) error
+	AddShortlist(ctx context.Context, jID uint, version int64, shortlist []model.ShortlistEntry) error
+	GetShortlist(ctx context.Context, jID uint) (string, error)
+	GetShortlistVersion(ctx context.Context, jID uint) (int64, error)
+	DeleteShortlist(ctx context.Context, jID uint) error
 	AddOTP(ctx context.Context, otp string, emailID string) error
 	GetOTP(ctx context.Context, otp string) (string, error)
 }
@@ -60,6 +65,106 @@ func (r *RDBLayer) GetTheCacheData(ctx context.Context, jID uint) (string, error
 	return str, nil
 }
 
+func (r *RDBLayer) DeleteTheCacheData(ctx context.Context, jID uint) error {
+	jobId := strconv.FormatUint(uint64(jID), 10)
+	err := r.rdb.Del(ctx, jobId).Err()
+	if err != nil {
+		log.Err(err).Msg("error in deleting job from redis")
+		return err
+	}
+	return nil
+}
+
+// shortlistTTL only bounds how long a ranking that missed an invalidation
+// can be served, rankings are dropped when an application or the job changes.
+const shortlistTTL = time.Hour
+
+func shortlistKey(jID uint) string {
+	return "shortlist:" + strconv.FormatUint(uint64(jID), 10)
+}
+
+// ErrStaleShortlist is returned by AddShortlist when the shortlist of the
+// job was invalidated after the ranking was computed.
+var ErrStaleShortlist = errors.New("shortlist was invalidated while it was ranked")
+
+// shortlistVersionKey counts the invalidations of a job's shortlist. It has
+// no expiry, so that a version read before an invalidation never matches
+// the one after it.
+func shortlistVersionKey(jID uint) string {
+	return "shortlist-version:" + strconv.FormatUint(uint64(jID), 10)
+}
+
+// AddShortlist caches the ranking only while the shortlist version is still
+// the one read by GetShortlistVersion before it was computed.
+func (r *RDBLayer) AddShortlist(ctx context.Context, jID uint, version int64, shortlist []model.ShortlistEntry) error {
+	val, err := json.Marshal(shortlist)
+	if err != nil {
+		log.Error().Err(err).Msg("error in marshaling shortlist")
+		return fmt.Errorf("error in marshaling shortlist : %w", err)
+	}
+	versionKey := shortlistVersionKey(jID)
+	err = r.rdb.Watch(ctx, func(tx *redis.Tx) error {
+		current, err := tx.Get(ctx, versionKey).Int64()
+		if err != nil && !errors.Is(err, redis.Nil) {
+			return err
+		}
+		if current != version {
+			return ErrStaleShortlist
+		}
+		_, err = tx.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+			pipe.Set(ctx, shortlistKey(jID), val, shortlistTTL)
+			return nil
+		})
+		return err
+	}, versionKey)
+	if errors.Is(err, redis.TxFailedErr) {
+		err = ErrStaleShortlist
+	}
+	if err != nil {
+		log.Err(err).Msg("error in adding shortlist to redis")
+		return err
+	}
+	return nil
+}
+
+func (r *RDBLayer) GetShortlist(ctx context.Context, jID uint) (string, error) {
+	str, err := r.rdb.Get(ctx, shortlistKey(jID)).Result()
+	if err != nil {
+		log.Err(err).Msg("error in getting shortlist from redis")
+		return "", err
+	}
+	return str, nil
+}
+
+// GetShortlistVersion returns the number of times the shortlist of the job
+// was invalidated, zero when it never was.
+func (r *RDBLayer) GetShortlistVersion(ctx context.Context, jID uint) (int64, error) {
+	version, err := r.rdb.Get(ctx, shortlistVersionKey(jID)).Int64()
+	if errors.Is(err, redis.Nil) {
+		return 0, nil
+	}
+	if err != nil {
+		log.Err(err).Msg("error in getting shortlist version from redis")
+		return 0, err
+	}
+	return version, nil
+}
+
+// DeleteShortlist drops the cached ranking and bumps the shortlist version,
+// so that a ranking computed before it is not cached after it.
+func (r *RDBLayer) DeleteShortlist(ctx context.Context, jID uint) error {
+	_, err := r.rdb.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.Incr(ctx, shortlistVersionKey(jID))
+		pipe.Del(ctx, shortlistKey(jID))
+		return nil
+	})
+	if err != nil {
+		log.Err(err).Msg("error in deleting shortlist from redis")
+		return err
+	}
+	return nil
+}
+
 func (r *RDBLayer) AddOTP(ctx context.Context, emailID string, otp string) error {
 	err := r.rdb.Set(ctx, emailID, otp, 5*time.Minute).Err()
 	fmt.Println("=============", err)
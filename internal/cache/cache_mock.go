@@ -53,6 +53,20 @@ func (mr *MockCachingMockRecorder) AddOTP(ctx, otp, emailID any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddOTP", reflect.TypeOf((*MockCaching)(nil).AddOTP), ctx, otp, emailID)
 }
 
+// AddShortlist mocks base method.
+func (m *MockCaching) AddShortlist(ctx context.Context, jID uint, version int64, shortlist []model.ShortlistEntry) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddShortlist", ctx, jID, version, shortlist)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddShortlist indicates an expected call of AddShortlist.
+func (mr *MockCachingMockRecorder) AddShortlist(ctx, jID, version, shortlist any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddShortlist", reflect.TypeOf((*MockCaching)(nil).AddShortlist), ctx, jID, version, shortlist)
+}
+
 // AddToTheCache mocks base method.
 func (m *MockCaching) AddToTheCache(ctx context.Context, jID uint, jobData model.Job) error {
 	m.ctrl.T.Helper()
@@ -67,6 +81,34 @@ func (mr *MockCachingMockRecorder) AddToTheCache(ctx, jID, jobData any) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddToTheCache", reflect.TypeOf((*MockCaching)(nil).AddToTheCache), ctx, jID, jobData)
 }
 
+// DeleteShortlist mocks base method.
+func (m *MockCaching) DeleteShortlist(ctx context.Context, jID uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteShortlist", ctx, jID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteShortlist indicates an expected call of DeleteShortlist.
+func (mr *MockCachingMockRecorder) DeleteShortlist(ctx, jID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteShortlist", reflect.TypeOf((*MockCaching)(nil).DeleteShortlist), ctx, jID)
+}
+
+// DeleteTheCacheData mocks base method.
+func (m *MockCaching) DeleteTheCacheData(ctx context.Context, jID uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTheCacheData", ctx, jID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteTheCacheData indicates an expected call of DeleteTheCacheData.
+func (mr *MockCachingMockRecorder) DeleteTheCacheData(ctx, jID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTheCacheData", reflect.TypeOf((*MockCaching)(nil).DeleteTheCacheData), ctx, jID)
+}
+
 // GetOTP mocks base method.
 func (m *MockCaching) GetOTP(ctx context.Context, otp string) (string, error) {
 	m.ctrl.T.Helper()
@@ -82,6 +124,36 @@ func (mr *MockCachingMockRecorder) GetOTP(ctx, otp any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOTP", reflect.TypeOf((*MockCaching)(nil).GetOTP), ctx, otp)
 }
 
+// GetShortlist mocks base method.
+func (m *MockCaching) GetShortlist(ctx context.Context, jID uint) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetShortlist", ctx, jID)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetShortlist indicates an expected call of GetShortlist.
+func (mr *MockCachingMockRecorder) GetShortlist(ctx, jID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetShortlist", reflect.TypeOf((*MockCaching)(nil).GetShortlist), ctx, jID)
+}
+
+// GetShortlistVersion mocks base method.
+func (m *MockCaching) GetShortlistVersion(ctx context.Context, jID uint) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetShortlistVersion", ctx, jID)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetShortlistVersion indicates an expected call of GetShortlistVersion.
+func (mr *MockCachingMockRecorder) GetShortlistVersion(ctx, jID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetShortlistVersion", reflect.TypeOf((*MockCaching)(nil).GetShortlistVersion), ctx, jID)
+}
+
 // GetTheCacheData mocks base method.
 func (m *MockCaching) GetTheCacheData(ctx context.Context, jID uint) (string, error) {
 	m.ctrl.T.Helper()
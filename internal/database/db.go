@@ -17,7 +17,10 @@ func DatabaseConnection() (*gorm.DB, error) {
 
 	dsn := fmt.Sprintf("host=%s user=%s password=%s  dbname=%s  port=%s  sslmode=%s TimeZone=%s", cfg.PostgresConfig.Host, cfg.PostgresConfig.User, cfg.PostgresConfig.Password, cfg.PostgresConfig.Db, cfg.PostgresConfig.Port, cfg.PostgresConfig.SslMode, cfg.PostgresConfig.TimeZone)
 
-	db, err := gorm.Open(postgres.Open(dsn), &gorm.Config{})
+	db, err := gorm.Open(postgres.Open(dsn), &gorm.Config{
+		//unique violations come back as gorm.ErrDuplicatedKey
+		TranslateError: true,
+	})
 	if err != nil {
 		log.Info().Msg("error in opening database connection")
 		return nil, fmt.Errorf("error in opening database connection : %w", err)
@@ -38,12 +41,136 @@ func DatabaseConnection() (*gorm.DB, error) {
 		return nil, fmt.Errorf("database is not connected : %w", err)
 	}
 
+	//duplicate applications taken before the unique index existed have to go first, the index cannot be built over them
+	err = removeDuplicateApplications(db)
+	if err != nil {
+		log.Error().Err(err).Msg("error in removing duplicate applications")
+		return nil, fmt.Errorf("error in removing duplicate applications : %w", err)
+	}
+
 	//need auto migrate
-	err = db.Migrator().AutoMigrate(&model.User{}, &model.Company{}, &model.Job{})
+	err = db.Migrator().AutoMigrate(&model.User{}, &model.Company{}, &model.Location{}, &model.TechnologyStack{}, &model.Qualification{}, &model.Shift{}, &model.JobType{}, &model.Job{}, &model.JobRevision{}, &model.Application{}, &model.ApplicationStageHistory{}, &model.ScreeningBatch{}, &model.ScreeningBatchItem{}, &model.Attachment{}, &model.CandidateProfile{}, &model.CompanyNotification{}, &model.ApplicationNote{}, &model.ApplicationTag{}, &model.ApplicationRating{})
 	if err != nil {
 		log.Error().Err(err).Msg("error in creating tables")
 		return nil, fmt.Errorf("error in creating tables : %w", err)
 	}
 
+	err = assignCompanyOwners(db, cfg.AppConfig.DefaultCompanyOwner)
+	if err != nil {
+		log.Error().Err(err).Msg("error in assigning company owners")
+		return nil, fmt.Errorf("error in assigning company owners : %w", err)
+	}
+
+	//full text search vector for jobs, generated by postgres so it stays in sync on every insert and update
+	err = db.Exec(`ALTER TABLE jobs ADD COLUMN IF NOT EXISTS search_vector tsvector GENERATED ALWAYS AS (
+		setweight(to_tsvector('english', coalesce(jobname, '')), 'A') ||
+		setweight(to_tsvector('english', coalesce(description, '')), 'B')) STORED`).Error
+	if err != nil {
+		log.Error().Err(err).Msg("error in creating job search column")
+		return nil, fmt.Errorf("error in creating job search column : %w", err)
+	}
+
+	err = db.Exec(`CREATE INDEX IF NOT EXISTS idx_jobs_search_vector ON jobs USING GIN (search_vector)`).Error
+	if err != nil {
+		log.Error().Err(err).Msg("error in creating job search index")
+		return nil, fmt.Errorf("error in creating job search index : %w", err)
+	}
+
+	//two active entries of a kind cannot share a name, whatever the case. Retired entries are left out so that a name can be used again
+	for table, column := range map[string]string{
+		"locations":         "place_name",
+		"technology_stacks": "stack_name",
+		"qualifications":    "qualification_required",
+		"shifts":            "shift_type",
+		"job_types":         "job_type_name",
+	} {
+		err = db.Exec(`CREATE UNIQUE INDEX IF NOT EXISTS idx_` + table + `_name ON ` + table + ` (lower(` + column + `)) WHERE deleted_at IS NULL`).Error
+		if err != nil {
+			log.Error().Err(err).Str("table", table).Msg("error in creating reference data name index")
+			return nil, fmt.Errorf("error in creating reference data name index on %s, rename or retire the duplicate entries : %w", table, err)
+		}
+	}
+
 	return db, nil
 }
+
+// removeDuplicateApplications prepares a database that took duplicate
+// applications for the unique index idx_application_user_job. Of every
+// candidate's applications to a job the most recently updated one is kept,
+// the others are deleted together with the rows that belong to them. It
+// only runs while the index is missing, once AutoMigrate has built it there
+// is nothing left to remove.
+func removeDuplicateApplications(db *gorm.DB) error {
+	if !db.Migrator().HasTable(&model.Application{}) || db.Migrator().HasIndex(&model.Application{}, "idx_application_user_job") {
+		return nil
+	}
+
+	return db.Transaction(func(tx *gorm.DB) error {
+		var duplicateIDs []uint
+		err := tx.Raw(`SELECT id FROM (
+			SELECT id, row_number() OVER (PARTITION BY user_id, job_id ORDER BY updated_at DESC, id DESC) AS position
+			FROM applications) ranked
+			WHERE position > 1`).Scan(&duplicateIDs).Error
+		if err != nil {
+			return err
+		}
+		if len(duplicateIDs) == 0 {
+			return nil
+		}
+
+		for _, v := range []any{&model.ApplicationStageHistory{}, &model.Attachment{}, &model.ApplicationNote{}, &model.ApplicationTag{}, &model.ApplicationRating{}} {
+			if !tx.Migrator().HasTable(v) {
+				continue
+			}
+			err = tx.Where("application_id IN ?", duplicateIDs).Delete(v).Error
+			if err != nil {
+				return err
+			}
+		}
+
+		err = tx.Unscoped().Where("id IN ?", duplicateIDs).Delete(&model.Application{}).Error
+		if err != nil {
+			return err
+		}
+
+		log.Info().Int("applications", len(duplicateIDs)).Msg("removed duplicate applications")
+		return nil
+	})
+}
+
+// assignCompanyOwners hands the companies created before companies had an
+// owner to the configured user, the only way anyone can act for them again.
+// Without a configured owner they are left alone and counted in the log.
+func assignCompanyOwners(db *gorm.DB, ownerID uint) error {
+	unowned := db.Model(&model.Company{}).Where("owner_id IS NULL OR owner_id = 0")
+
+	if ownerID == 0 {
+		var count int64
+		err := unowned.Count(&count).Error
+		if err != nil {
+			return err
+		}
+		if count != 0 {
+			log.Warn().Int64("companies", count).Msg("companies without an owner cannot be managed, set APP_DEFAULTCOMPANYOWNER to assign them")
+		}
+		return nil
+	}
+
+	var users int64
+	err := db.Model(&model.User{}).Where("id = ?", ownerID).Count(&users).Error
+	if err != nil {
+		return err
+	}
+	if users == 0 {
+		return fmt.Errorf("default company owner %d does not exist", ownerID)
+	}
+
+	output := unowned.Update("owner_id", ownerID)
+	if output.Error != nil {
+		return output.Error
+	}
+	if output.RowsAffected != 0 {
+		log.Info().Int64("companies", output.RowsAffected).Uint("owner id", ownerID).Msg("assigned companies to the default owner")
+	}
+	return nil
+}
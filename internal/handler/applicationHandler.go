@@ -0,0 +1,236 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"job-portal-api/internal/authentication"
+	"job-portal-api/internal/middleware"
+	"job-portal-api/internal/model"
+	"job-portal-api/internal/service"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/rs/zerolog/log"
+)
+
+type ApplicationHandler interface {
+	SubmitApplication(c *gin.Context)
+	ResubmitApplication(c *gin.Context)
+	ViewApplication(c *gin.Context)
+	ViewApplicationsByJobID(c *gin.Context)
+	ChangeApplicationStage(c *gin.Context)
+	ViewApplicationPipeline(c *gin.Context)
+	ViewApplicationStageHistory(c *gin.Context)
+	ViewShortlist(c *gin.Context)
+	ViewMyApplications(c *gin.Context)
+	WithdrawApplication(c *gin.Context)
+}
+
+func NewApplicationHandler(serviceApplication service.ApplicationService) (ApplicationHandler, error) {
+	if serviceApplication == nil {
+		log.Info().Msg("application service cannot be nil")
+		return nil, errors.New("application service cannot be nil")
+	}
+	return &Handler{
+		serviceApplication: serviceApplication,
+	}, nil
+}
+
+func (h *Handler) SubmitApplication(c *gin.Context) {
+	ctx := c.Request.Context()
+	traceId, ok := ctx.Value(middleware.TraceIDKey).(string)
+	if !ok {
+		log.Info().Msg("missing trace id")
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"error": http.StatusText(http.StatusInternalServerError)})
+		return
+	}
+
+	claims, ok := ctx.Value(authentication.AuthKey).(jwt.RegisteredClaims)
+	if !ok {
+		log.Info().Str("trace id : ", traceId).Msg("login first")
+		c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": http.StatusText(http.StatusUnauthorized)})
+		return
+	}
+
+	uID, err := strconv.ParseUint(claims.Subject, 10, 64)
+	if err != nil {
+		log.Error().Err(err).Str("trace id : ", traceId).Msg("error invalid user id in token")
+		c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": http.StatusText(http.StatusUnauthorized)})
+		return
+	}
+
+	var applicationData model.NewUserApplication
+	err = json.NewDecoder(c.Request.Body).Decode(&applicationData)
+	if err != nil {
+		log.Error().Err(err).Str("trace id : ", traceId).Msg("error in decoding")
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": http.StatusText(http.StatusBadRequest)})
+		return
+	}
+
+	validate := validator.New()
+	err = validate.Struct(applicationData)
+	if err != nil {
+		log.Error().Err(err).Str("trace id : ", traceId).Msg("error in validating")
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": http.StatusText(http.StatusBadRequest)})
+		return
+	}
+
+	application, err := h.serviceApplication.SubmitApplication(ctx, uint(uID), applicationData)
+	var conflictErr *service.ApplicationConflictError
+	if errors.As(err, &conflictErr) {
+		log.Error().Err(err).Str("trace id : ", traceId).Msg("error application already exists")
+		c.AbortWithStatusJSON(http.StatusConflict, gin.H{"error": http.StatusText(http.StatusConflict), "application_id": conflictErr.ApplicationID})
+		return
+	}
+	if err != nil {
+		log.Error().Err(err).Str("trace id : ", traceId).Msg("error in submitting application")
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": http.StatusText(http.StatusBadRequest)})
+		return
+	}
+
+	c.JSON(http.StatusOK, application)
+}
+
+// ResubmitApplication updates the candidate's existing application to the
+// job in the body.
+func (h *Handler) ResubmitApplication(c *gin.Context) {
+	ctx := c.Request.Context()
+	traceId, ok := ctx.Value(middleware.TraceIDKey).(string)
+	if !ok {
+		log.Info().Msg("missing trace id")
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"error": http.StatusText(http.StatusInternalServerError)})
+		return
+	}
+
+	claims, ok := ctx.Value(authentication.AuthKey).(jwt.RegisteredClaims)
+	if !ok {
+		log.Info().Str("trace id : ", traceId).Msg("login first")
+		c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": http.StatusText(http.StatusUnauthorized)})
+		return
+	}
+
+	uID, err := strconv.ParseUint(claims.Subject, 10, 64)
+	if err != nil {
+		log.Error().Err(err).Str("trace id : ", traceId).Msg("error invalid user id in token")
+		c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": http.StatusText(http.StatusUnauthorized)})
+		return
+	}
+
+	var applicationData model.NewUserApplication
+	err = json.NewDecoder(c.Request.Body).Decode(&applicationData)
+	if err != nil {
+		log.Error().Err(err).Str("trace id : ", traceId).Msg("error in decoding")
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": http.StatusText(http.StatusBadRequest)})
+		return
+	}
+
+	validate := validator.New()
+	err = validate.Struct(applicationData)
+	if err != nil {
+		log.Error().Err(err).Str("trace id : ", traceId).Msg("error in validating")
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": http.StatusText(http.StatusBadRequest)})
+		return
+	}
+
+	application, err := h.serviceApplication.ResubmitApplication(ctx, uint(uID), applicationData)
+	if err != nil {
+		log.Error().Err(err).Str("trace id : ", traceId).Msg("error in resubmitting application")
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": http.StatusText(http.StatusBadRequest)})
+		return
+	}
+
+	c.JSON(http.StatusOK, application)
+}
+
+func (h *Handler) ViewApplication(c *gin.Context) {
+	ctx := c.Request.Context()
+	traceId, ok := ctx.Value(middleware.TraceIDKey).(string)
+	if !ok {
+		log.Info().Msg("missing trace id")
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"error": http.StatusText(http.StatusInternalServerError)})
+		return
+	}
+
+	claims, ok := ctx.Value(authentication.AuthKey).(jwt.RegisteredClaims)
+	if !ok {
+		log.Info().Str("trace id : ", traceId).Msg("login first")
+		c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": http.StatusText(http.StatusUnauthorized)})
+		return
+	}
+
+	uID, err := strconv.ParseUint(claims.Subject, 10, 64)
+	if err != nil {
+		log.Error().Err(err).Str("trace id : ", traceId).Msg("error invalid user id in token")
+		c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": http.StatusText(http.StatusUnauthorized)})
+		return
+	}
+
+	aID, err := strconv.ParseUint(c.Param("id"), 10, 64)
+	if err != nil {
+		log.Error().Err(err).Str("trace id : ", traceId).Msg("error invalid application id")
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": http.StatusText(http.StatusBadRequest)})
+		return
+	}
+
+	application, err := h.serviceApplication.ViewApplication(uint(uID), uint(aID))
+	if errors.Is(err, service.ErrForbidden) {
+		log.Error().Err(err).Str("trace id : ", traceId).Msg("error reading another user's application")
+		c.AbortWithStatusJSON(http.StatusForbidden, gin.H{"error": http.StatusText(http.StatusForbidden)})
+		return
+	}
+	if err != nil {
+		log.Error().Err(err).Str("trace id : ", traceId).Msg("error in fetching application")
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": http.StatusText(http.StatusBadRequest)})
+		return
+	}
+
+	c.JSON(http.StatusOK, application)
+}
+
+func (h *Handler) ViewApplicationsByJobID(c *gin.Context) {
+	ctx := c.Request.Context()
+	traceId, ok := ctx.Value(middleware.TraceIDKey).(string)
+	if !ok {
+		log.Info().Msg("missing trace id")
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"error": http.StatusText(http.StatusInternalServerError)})
+		return
+	}
+
+	claims, ok := ctx.Value(authentication.AuthKey).(jwt.RegisteredClaims)
+	if !ok {
+		log.Info().Str("trace id : ", traceId).Msg("login first")
+		c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": http.StatusText(http.StatusUnauthorized)})
+		return
+	}
+
+	uID, err := strconv.ParseUint(claims.Subject, 10, 64)
+	if err != nil {
+		log.Error().Err(err).Str("trace id : ", traceId).Msg("error invalid user id in token")
+		c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": http.StatusText(http.StatusUnauthorized)})
+		return
+	}
+
+	jID, err := strconv.ParseUint(c.Param("id"), 10, 64)
+	if err != nil {
+		log.Error().Err(err).Str("trace id : ", traceId).Msg("error invalid job id")
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": http.StatusText(http.StatusBadRequest)})
+		return
+	}
+
+	applications, err := h.serviceApplication.ViewApplicationsByJobID(uint(uID), uint(jID))
+	if errors.Is(err, service.ErrForbidden) {
+		log.Error().Err(err).Str("trace id : ", traceId).Msg("error user does not recruit for the job")
+		c.AbortWithStatusJSON(http.StatusForbidden, gin.H{"error": http.StatusText(http.StatusForbidden)})
+		return
+	}
+	if err != nil {
+		log.Error().Err(err).Str("trace id : ", traceId).Msg("error in fetching applications")
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": http.StatusText(http.StatusBadRequest)})
+		return
+	}
+
+	c.JSON(http.StatusOK, applications)
+}
@@ -0,0 +1,646 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"job-portal-api/internal/authentication"
+	"job-portal-api/internal/middleware"
+	"job-portal-api/internal/model"
+	"job-portal-api/internal/service"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang-jwt/jwt/v5"
+	"go.uber.org/mock/gomock"
+	"gopkg.in/go-playground/assert.v1"
+	"gorm.io/gorm"
+)
+
+func TestHandler_SubmitApplication(t *testing.T) {
+	tests := []struct {
+		name               string
+		setup              func() (*gin.Context, *httptest.ResponseRecorder, service.ApplicationService)
+		expectedStatusCode int
+		expectedResponse   string
+	}{
+		{
+			name: "missing trace id",
+			setup: func() (*gin.Context, *httptest.ResponseRecorder, service.ApplicationService) {
+				rr := httptest.NewRecorder()
+				c, _ := gin.CreateTestContext(rr)
+				httpRequest, _ := http.NewRequest(http.MethodPost, "http://test.com", nil)
+				c.Request = httpRequest
+
+				return c, rr, nil
+			},
+			expectedStatusCode: http.StatusInternalServerError,
+			expectedResponse:   `{"error":"Internal Server Error"}`,
+		},
+		{
+			name: "missing jwt claims",
+			setup: func() (*gin.Context, *httptest.ResponseRecorder, service.ApplicationService) {
+				rr := httptest.NewRecorder()
+				c, _ := gin.CreateTestContext(rr)
+				httpRequest, _ := http.NewRequest(http.MethodPost, "http://test.com", nil)
+				ctx := httpRequest.Context()
+				ctx = context.WithValue(ctx, middleware.TraceIDKey, "123")
+				httpRequest = httpRequest.WithContext(ctx)
+				c.Request = httpRequest
+
+				return c, rr, nil
+			},
+			expectedStatusCode: http.StatusUnauthorized,
+			expectedResponse:   `{"error":"Unauthorized"}`,
+		},
+		{
+			name: "invalid user id in token",
+			setup: func() (*gin.Context, *httptest.ResponseRecorder, service.ApplicationService) {
+				rr := httptest.NewRecorder()
+				c, _ := gin.CreateTestContext(rr)
+				httpRequest, _ := http.NewRequest(http.MethodPost, "http://test.com", strings.NewReader(`{"name":"asha","age":"25","jid":2,"job_application":{"noticePeriod":30,"experience":2}}`))
+				ctx := httpRequest.Context()
+				ctx = context.WithValue(ctx, middleware.TraceIDKey, "123")
+				ctx = context.WithValue(ctx, authentication.AuthKey, jwt.RegisteredClaims{Subject: "admin"})
+				httpRequest = httpRequest.WithContext(ctx)
+				c.Request = httpRequest
+
+				return c, rr, nil
+			},
+			expectedStatusCode: http.StatusUnauthorized,
+			expectedResponse:   `{"error":"Unauthorized"}`,
+		},
+		{
+			name: "invalid body",
+			setup: func() (*gin.Context, *httptest.ResponseRecorder, service.ApplicationService) {
+				rr := httptest.NewRecorder()
+				c, _ := gin.CreateTestContext(rr)
+				httpRequest, _ := http.NewRequest(http.MethodPost, "http://test.com", strings.NewReader(`{"name":`))
+				ctx := httpRequest.Context()
+				ctx = context.WithValue(ctx, middleware.TraceIDKey, "123")
+				ctx = context.WithValue(ctx, authentication.AuthKey, jwt.RegisteredClaims{Subject: "1"})
+				httpRequest = httpRequest.WithContext(ctx)
+				c.Request = httpRequest
+
+				return c, rr, nil
+			},
+			expectedStatusCode: http.StatusBadRequest,
+			expectedResponse:   `{"error":"Bad Request"}`,
+		},
+		{
+			name: "validation failure",
+			setup: func() (*gin.Context, *httptest.ResponseRecorder, service.ApplicationService) {
+				rr := httptest.NewRecorder()
+				c, _ := gin.CreateTestContext(rr)
+				httpRequest, _ := http.NewRequest(http.MethodPost, "http://test.com", strings.NewReader(`{"name":"asha","age":"25"}`))
+				ctx := httpRequest.Context()
+				ctx = context.WithValue(ctx, middleware.TraceIDKey, "123")
+				ctx = context.WithValue(ctx, authentication.AuthKey, jwt.RegisteredClaims{Subject: "1"})
+				httpRequest = httpRequest.WithContext(ctx)
+				c.Request = httpRequest
+
+				return c, rr, nil
+			},
+			expectedStatusCode: http.StatusBadRequest,
+			expectedResponse:   `{"error":"Bad Request"}`,
+		},
+		{
+			name: "failure",
+			setup: func() (*gin.Context, *httptest.ResponseRecorder, service.ApplicationService) {
+				rr := httptest.NewRecorder()
+				c, _ := gin.CreateTestContext(rr)
+				httpRequest, _ := http.NewRequest(http.MethodPost, "http://test.com", strings.NewReader(`{"name":"asha","age":"25","jid":2,"job_application":{"noticePeriod":30,"experience":2}}`))
+				ctx := httpRequest.Context()
+				ctx = context.WithValue(ctx, middleware.TraceIDKey, "123")
+				ctx = context.WithValue(ctx, authentication.AuthKey, jwt.RegisteredClaims{Subject: "1"})
+				httpRequest = httpRequest.WithContext(ctx)
+				c.Request = httpRequest
+
+				mc := gomock.NewController(t)
+				ma := service.NewMockApplicationService(mc)
+
+				ma.EXPECT().SubmitApplication(gomock.Any(), uint(1), gomock.Any()).Return(model.Application{}, errors.New("error"))
+
+				return c, rr, ma
+			},
+			expectedStatusCode: http.StatusBadRequest,
+			expectedResponse:   `{"error":"Bad Request"}`,
+		},
+		{
+			name: "already applied",
+			setup: func() (*gin.Context, *httptest.ResponseRecorder, service.ApplicationService) {
+				rr := httptest.NewRecorder()
+				c, _ := gin.CreateTestContext(rr)
+				httpRequest, _ := http.NewRequest(http.MethodPost, "http://test.com", strings.NewReader(`{"name":"asha","age":"25","jid":2,"job_application":{"noticePeriod":30,"experience":2}}`))
+				ctx := httpRequest.Context()
+				ctx = context.WithValue(ctx, middleware.TraceIDKey, "123")
+				ctx = context.WithValue(ctx, authentication.AuthKey, jwt.RegisteredClaims{Subject: "1"})
+				httpRequest = httpRequest.WithContext(ctx)
+				c.Request = httpRequest
+
+				mc := gomock.NewController(t)
+				ma := service.NewMockApplicationService(mc)
+
+				ma.EXPECT().SubmitApplication(gomock.Any(), uint(1), gomock.Any()).Return(model.Application{}, &service.ApplicationConflictError{ApplicationID: 5})
+
+				return c, rr, ma
+			},
+			expectedStatusCode: http.StatusConflict,
+			expectedResponse:   `{"application_id":5,"error":"Conflict"}`,
+		},
+		{
+			name: "success",
+			setup: func() (*gin.Context, *httptest.ResponseRecorder, service.ApplicationService) {
+				rr := httptest.NewRecorder()
+				c, _ := gin.CreateTestContext(rr)
+				httpRequest, _ := http.NewRequest(http.MethodPost, "http://test.com", strings.NewReader(`{"name":"asha","age":"25","jid":2,"job_application":{"noticePeriod":30,"experience":2}}`))
+				ctx := httpRequest.Context()
+				ctx = context.WithValue(ctx, middleware.TraceIDKey, "123")
+				ctx = context.WithValue(ctx, authentication.AuthKey, jwt.RegisteredClaims{Subject: "1"})
+				httpRequest = httpRequest.WithContext(ctx)
+				c.Request = httpRequest
+
+				mc := gomock.NewController(t)
+				ma := service.NewMockApplicationService(mc)
+
+				ma.EXPECT().SubmitApplication(gomock.Any(), uint(1), model.NewUserApplication{Name: "asha", Age: "25", Jid: 2, Jobs: model.Requestfield{NoticePeriod: 30, Experience: 2}}).Return(model.Application{Model: gorm.Model{ID: 1}, UserID: 1, JobID: 2, Name: "asha", Age: "25", Details: model.Requestfield{NoticePeriod: 30, Experience: 2}, Accepted: true, Screening: model.ScreeningResult{Accepted: true, Score: 4, MaxScore: 7, Threshold: 3.5}, Stage: model.ApplicationStageApplied}, nil)
+
+				return c, rr, ma
+			},
+			expectedStatusCode: http.StatusOK,
+			expectedResponse:   `{"ID":1,"CreatedAt":"0001-01-01T00:00:00Z","UpdatedAt":"0001-01-01T00:00:00Z","DeletedAt":null,"user_id":1,"job_id":2,"name":"asha","age":"25","details":{"noticePeriod":30,"location":null,"technologyStack":null,"experience":2,"qualifications":null,"shifts":null,"jobtype":null,"skills":null},"accepted":true,"screening":{"accepted":true,"score":4,"max_score":7,"threshold":3.5,"knocked_out":false,"criteria":null},"screened_at":"0001-01-01T00:00:00Z","stage":"applied","stage_changed_at":"0001-01-01T00:00:00Z"}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gin.SetMode(gin.TestMode)
+			c, rr, ma := tt.setup()
+			h := Handler{
+				serviceApplication: ma,
+			}
+			h.SubmitApplication(c)
+			assert.Equal(t, tt.expectedStatusCode, rr.Code)
+			assert.Equal(t, tt.expectedResponse, rr.Body.String())
+		})
+	}
+}
+
+func TestHandler_ResubmitApplication(t *testing.T) {
+	tests := []struct {
+		name               string
+		setup              func() (*gin.Context, *httptest.ResponseRecorder, service.ApplicationService)
+		expectedStatusCode int
+		expectedResponse   string
+	}{
+		{
+			name: "missing trace id",
+			setup: func() (*gin.Context, *httptest.ResponseRecorder, service.ApplicationService) {
+				rr := httptest.NewRecorder()
+				c, _ := gin.CreateTestContext(rr)
+				httpRequest, _ := http.NewRequest(http.MethodPut, "http://test.com", nil)
+				c.Request = httpRequest
+
+				return c, rr, nil
+			},
+			expectedStatusCode: http.StatusInternalServerError,
+			expectedResponse:   `{"error":"Internal Server Error"}`,
+		},
+		{
+			name: "missing jwt claims",
+			setup: func() (*gin.Context, *httptest.ResponseRecorder, service.ApplicationService) {
+				rr := httptest.NewRecorder()
+				c, _ := gin.CreateTestContext(rr)
+				httpRequest, _ := http.NewRequest(http.MethodPut, "http://test.com", nil)
+				ctx := httpRequest.Context()
+				ctx = context.WithValue(ctx, middleware.TraceIDKey, "123")
+				httpRequest = httpRequest.WithContext(ctx)
+				c.Request = httpRequest
+
+				return c, rr, nil
+			},
+			expectedStatusCode: http.StatusUnauthorized,
+			expectedResponse:   `{"error":"Unauthorized"}`,
+		},
+		{
+			name: "invalid user id in token",
+			setup: func() (*gin.Context, *httptest.ResponseRecorder, service.ApplicationService) {
+				rr := httptest.NewRecorder()
+				c, _ := gin.CreateTestContext(rr)
+				httpRequest, _ := http.NewRequest(http.MethodPut, "http://test.com", strings.NewReader(`{"name":"asha","age":"25","jid":2,"job_application":{"noticePeriod":30,"experience":2}}`))
+				ctx := httpRequest.Context()
+				ctx = context.WithValue(ctx, middleware.TraceIDKey, "123")
+				ctx = context.WithValue(ctx, authentication.AuthKey, jwt.RegisteredClaims{Subject: "admin"})
+				httpRequest = httpRequest.WithContext(ctx)
+				c.Request = httpRequest
+
+				return c, rr, nil
+			},
+			expectedStatusCode: http.StatusUnauthorized,
+			expectedResponse:   `{"error":"Unauthorized"}`,
+		},
+		{
+			name: "invalid body",
+			setup: func() (*gin.Context, *httptest.ResponseRecorder, service.ApplicationService) {
+				rr := httptest.NewRecorder()
+				c, _ := gin.CreateTestContext(rr)
+				httpRequest, _ := http.NewRequest(http.MethodPut, "http://test.com", strings.NewReader(`{"name":`))
+				ctx := httpRequest.Context()
+				ctx = context.WithValue(ctx, middleware.TraceIDKey, "123")
+				ctx = context.WithValue(ctx, authentication.AuthKey, jwt.RegisteredClaims{Subject: "1"})
+				httpRequest = httpRequest.WithContext(ctx)
+				c.Request = httpRequest
+
+				return c, rr, nil
+			},
+			expectedStatusCode: http.StatusBadRequest,
+			expectedResponse:   `{"error":"Bad Request"}`,
+		},
+		{
+			name: "validation failure",
+			setup: func() (*gin.Context, *httptest.ResponseRecorder, service.ApplicationService) {
+				rr := httptest.NewRecorder()
+				c, _ := gin.CreateTestContext(rr)
+				httpRequest, _ := http.NewRequest(http.MethodPut, "http://test.com", strings.NewReader(`{"name":"asha","age":"25"}`))
+				ctx := httpRequest.Context()
+				ctx = context.WithValue(ctx, middleware.TraceIDKey, "123")
+				ctx = context.WithValue(ctx, authentication.AuthKey, jwt.RegisteredClaims{Subject: "1"})
+				httpRequest = httpRequest.WithContext(ctx)
+				c.Request = httpRequest
+
+				return c, rr, nil
+			},
+			expectedStatusCode: http.StatusBadRequest,
+			expectedResponse:   `{"error":"Bad Request"}`,
+		},
+		{
+			name: "failure",
+			setup: func() (*gin.Context, *httptest.ResponseRecorder, service.ApplicationService) {
+				rr := httptest.NewRecorder()
+				c, _ := gin.CreateTestContext(rr)
+				httpRequest, _ := http.NewRequest(http.MethodPut, "http://test.com", strings.NewReader(`{"name":"asha","age":"25","jid":2,"job_application":{"noticePeriod":30,"experience":2}}`))
+				ctx := httpRequest.Context()
+				ctx = context.WithValue(ctx, middleware.TraceIDKey, "123")
+				ctx = context.WithValue(ctx, authentication.AuthKey, jwt.RegisteredClaims{Subject: "1"})
+				httpRequest = httpRequest.WithContext(ctx)
+				c.Request = httpRequest
+
+				mc := gomock.NewController(t)
+				ma := service.NewMockApplicationService(mc)
+
+				ma.EXPECT().ResubmitApplication(gomock.Any(), uint(1), gomock.Any()).Return(model.Application{}, errors.New("error"))
+
+				return c, rr, ma
+			},
+			expectedStatusCode: http.StatusBadRequest,
+			expectedResponse:   `{"error":"Bad Request"}`,
+		},
+		{
+			name: "success",
+			setup: func() (*gin.Context, *httptest.ResponseRecorder, service.ApplicationService) {
+				rr := httptest.NewRecorder()
+				c, _ := gin.CreateTestContext(rr)
+				httpRequest, _ := http.NewRequest(http.MethodPut, "http://test.com", strings.NewReader(`{"name":"asha","age":"25","jid":2,"job_application":{"noticePeriod":30,"experience":2}}`))
+				ctx := httpRequest.Context()
+				ctx = context.WithValue(ctx, middleware.TraceIDKey, "123")
+				ctx = context.WithValue(ctx, authentication.AuthKey, jwt.RegisteredClaims{Subject: "1"})
+				httpRequest = httpRequest.WithContext(ctx)
+				c.Request = httpRequest
+
+				mc := gomock.NewController(t)
+				ma := service.NewMockApplicationService(mc)
+
+				ma.EXPECT().ResubmitApplication(gomock.Any(), uint(1), model.NewUserApplication{Name: "asha", Age: "25", Jid: 2, Jobs: model.Requestfield{NoticePeriod: 30, Experience: 2}}).Return(model.Application{Model: gorm.Model{ID: 1}, UserID: 1, JobID: 2, Name: "asha", Age: "25", Details: model.Requestfield{NoticePeriod: 30, Experience: 2}, Accepted: true, Screening: model.ScreeningResult{Accepted: true, Score: 4, MaxScore: 7, Threshold: 3.5}, Stage: model.ApplicationStageApplied}, nil)
+
+				return c, rr, ma
+			},
+			expectedStatusCode: http.StatusOK,
+			expectedResponse:   `{"ID":1,"CreatedAt":"0001-01-01T00:00:00Z","UpdatedAt":"0001-01-01T00:00:00Z","DeletedAt":null,"user_id":1,"job_id":2,"name":"asha","age":"25","details":{"noticePeriod":30,"location":null,"technologyStack":null,"experience":2,"qualifications":null,"shifts":null,"jobtype":null,"skills":null},"accepted":true,"screening":{"accepted":true,"score":4,"max_score":7,"threshold":3.5,"knocked_out":false,"criteria":null},"screened_at":"0001-01-01T00:00:00Z","stage":"applied","stage_changed_at":"0001-01-01T00:00:00Z"}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gin.SetMode(gin.TestMode)
+			c, rr, ma := tt.setup()
+			h := Handler{
+				serviceApplication: ma,
+			}
+			h.ResubmitApplication(c)
+			assert.Equal(t, tt.expectedStatusCode, rr.Code)
+			assert.Equal(t, tt.expectedResponse, rr.Body.String())
+		})
+	}
+}
+
+func TestHandler_ViewApplication(t *testing.T) {
+	tests := []struct {
+		name               string
+		setup              func() (*gin.Context, *httptest.ResponseRecorder, service.ApplicationService)
+		expectedStatusCode int
+		expectedResponse   string
+	}{
+		{
+			name: "missing trace id",
+			setup: func() (*gin.Context, *httptest.ResponseRecorder, service.ApplicationService) {
+				rr := httptest.NewRecorder()
+				c, _ := gin.CreateTestContext(rr)
+				httpRequest, _ := http.NewRequest(http.MethodGet, "http://test.com", nil)
+				c.Request = httpRequest
+
+				return c, rr, nil
+			},
+			expectedStatusCode: http.StatusInternalServerError,
+			expectedResponse:   `{"error":"Internal Server Error"}`,
+		},
+		{
+			name: "missing jwt claims",
+			setup: func() (*gin.Context, *httptest.ResponseRecorder, service.ApplicationService) {
+				rr := httptest.NewRecorder()
+				c, _ := gin.CreateTestContext(rr)
+				httpRequest, _ := http.NewRequest(http.MethodGet, "http://test.com", nil)
+				ctx := httpRequest.Context()
+				ctx = context.WithValue(ctx, middleware.TraceIDKey, "123")
+				httpRequest = httpRequest.WithContext(ctx)
+				c.Request = httpRequest
+
+				return c, rr, nil
+			},
+			expectedStatusCode: http.StatusUnauthorized,
+			expectedResponse:   `{"error":"Unauthorized"}`,
+		},
+		{
+			name: "invalid user id in token",
+			setup: func() (*gin.Context, *httptest.ResponseRecorder, service.ApplicationService) {
+				rr := httptest.NewRecorder()
+				c, _ := gin.CreateTestContext(rr)
+				httpRequest, _ := http.NewRequest(http.MethodGet, "http://test.com", nil)
+				ctx := httpRequest.Context()
+				ctx = context.WithValue(ctx, middleware.TraceIDKey, "123")
+				ctx = context.WithValue(ctx, authentication.AuthKey, jwt.RegisteredClaims{Subject: "admin"})
+				httpRequest = httpRequest.WithContext(ctx)
+				c.Params = append(c.Params, gin.Param{Key: "id", Value: "1"})
+				c.Request = httpRequest
+
+				return c, rr, nil
+			},
+			expectedStatusCode: http.StatusUnauthorized,
+			expectedResponse:   `{"error":"Unauthorized"}`,
+		},
+		{
+			name: "invalid application id",
+			setup: func() (*gin.Context, *httptest.ResponseRecorder, service.ApplicationService) {
+				rr := httptest.NewRecorder()
+				c, _ := gin.CreateTestContext(rr)
+				httpRequest, _ := http.NewRequest(http.MethodGet, "http://test.com", nil)
+				ctx := httpRequest.Context()
+				ctx = context.WithValue(ctx, middleware.TraceIDKey, "123")
+				ctx = context.WithValue(ctx, authentication.AuthKey, jwt.RegisteredClaims{Subject: "1"})
+				httpRequest = httpRequest.WithContext(ctx)
+				c.Params = append(c.Params, gin.Param{Key: "id", Value: "abc"})
+				c.Request = httpRequest
+
+				return c, rr, nil
+			},
+			expectedStatusCode: http.StatusBadRequest,
+			expectedResponse:   `{"error":"Bad Request"}`,
+		},
+		{
+			name: "another user's application",
+			setup: func() (*gin.Context, *httptest.ResponseRecorder, service.ApplicationService) {
+				rr := httptest.NewRecorder()
+				c, _ := gin.CreateTestContext(rr)
+				httpRequest, _ := http.NewRequest(http.MethodGet, "http://test.com", nil)
+				ctx := httpRequest.Context()
+				ctx = context.WithValue(ctx, middleware.TraceIDKey, "123")
+				ctx = context.WithValue(ctx, authentication.AuthKey, jwt.RegisteredClaims{Subject: "1"})
+				httpRequest = httpRequest.WithContext(ctx)
+				c.Params = append(c.Params, gin.Param{Key: "id", Value: "1"})
+				c.Request = httpRequest
+
+				mc := gomock.NewController(t)
+				ma := service.NewMockApplicationService(mc)
+
+				ma.EXPECT().ViewApplication(uint(1), uint(1)).Return(model.Application{}, service.ErrForbidden)
+
+				return c, rr, ma
+			},
+			expectedStatusCode: http.StatusForbidden,
+			expectedResponse:   `{"error":"Forbidden"}`,
+		},
+		{
+			name: "failure",
+			setup: func() (*gin.Context, *httptest.ResponseRecorder, service.ApplicationService) {
+				rr := httptest.NewRecorder()
+				c, _ := gin.CreateTestContext(rr)
+				httpRequest, _ := http.NewRequest(http.MethodGet, "http://test.com", nil)
+				ctx := httpRequest.Context()
+				ctx = context.WithValue(ctx, middleware.TraceIDKey, "123")
+				ctx = context.WithValue(ctx, authentication.AuthKey, jwt.RegisteredClaims{Subject: "1"})
+				httpRequest = httpRequest.WithContext(ctx)
+				c.Params = append(c.Params, gin.Param{Key: "id", Value: "1"})
+				c.Request = httpRequest
+
+				mc := gomock.NewController(t)
+				ma := service.NewMockApplicationService(mc)
+
+				ma.EXPECT().ViewApplication(uint(1), uint(1)).Return(model.Application{}, errors.New("error"))
+
+				return c, rr, ma
+			},
+			expectedStatusCode: http.StatusBadRequest,
+			expectedResponse:   `{"error":"Bad Request"}`,
+		},
+		{
+			name: "success",
+			setup: func() (*gin.Context, *httptest.ResponseRecorder, service.ApplicationService) {
+				rr := httptest.NewRecorder()
+				c, _ := gin.CreateTestContext(rr)
+				httpRequest, _ := http.NewRequest(http.MethodGet, "http://test.com", nil)
+				ctx := httpRequest.Context()
+				ctx = context.WithValue(ctx, middleware.TraceIDKey, "123")
+				ctx = context.WithValue(ctx, authentication.AuthKey, jwt.RegisteredClaims{Subject: "1"})
+				httpRequest = httpRequest.WithContext(ctx)
+				c.Params = append(c.Params, gin.Param{Key: "id", Value: "1"})
+				c.Request = httpRequest
+
+				mc := gomock.NewController(t)
+				ma := service.NewMockApplicationService(mc)
+
+				ma.EXPECT().ViewApplication(uint(1), uint(1)).Return(model.Application{Model: gorm.Model{ID: 1}, UserID: 1, JobID: 2, Name: "asha", Age: "25", Details: model.Requestfield{NoticePeriod: 30, Experience: 2}, Accepted: true, Screening: model.ScreeningResult{Accepted: true, Score: 4, MaxScore: 7, Threshold: 3.5}, Stage: model.ApplicationStageApplied}, nil)
+
+				return c, rr, ma
+			},
+			expectedStatusCode: http.StatusOK,
+			expectedResponse:   `{"ID":1,"CreatedAt":"0001-01-01T00:00:00Z","UpdatedAt":"0001-01-01T00:00:00Z","DeletedAt":null,"user_id":1,"job_id":2,"name":"asha","age":"25","details":{"noticePeriod":30,"location":null,"technologyStack":null,"experience":2,"qualifications":null,"shifts":null,"jobtype":null,"skills":null},"accepted":true,"screening":{"accepted":true,"score":4,"max_score":7,"threshold":3.5,"knocked_out":false,"criteria":null},"screened_at":"0001-01-01T00:00:00Z","stage":"applied","stage_changed_at":"0001-01-01T00:00:00Z"}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gin.SetMode(gin.TestMode)
+			c, rr, ma := tt.setup()
+			h := Handler{
+				serviceApplication: ma,
+			}
+			h.ViewApplication(c)
+			assert.Equal(t, tt.expectedStatusCode, rr.Code)
+			assert.Equal(t, tt.expectedResponse, rr.Body.String())
+		})
+	}
+}
+
+func TestHandler_ViewApplicationsByJobID(t *testing.T) {
+	tests := []struct {
+		name               string
+		setup              func() (*gin.Context, *httptest.ResponseRecorder, service.ApplicationService)
+		expectedStatusCode int
+		expectedResponse   string
+	}{
+		{
+			name: "missing trace id",
+			setup: func() (*gin.Context, *httptest.ResponseRecorder, service.ApplicationService) {
+				rr := httptest.NewRecorder()
+				c, _ := gin.CreateTestContext(rr)
+				httpRequest, _ := http.NewRequest(http.MethodGet, "http://test.com", nil)
+				c.Request = httpRequest
+
+				return c, rr, nil
+			},
+			expectedStatusCode: http.StatusInternalServerError,
+			expectedResponse:   `{"error":"Internal Server Error"}`,
+		},
+		{
+			name: "missing jwt claims",
+			setup: func() (*gin.Context, *httptest.ResponseRecorder, service.ApplicationService) {
+				rr := httptest.NewRecorder()
+				c, _ := gin.CreateTestContext(rr)
+				httpRequest, _ := http.NewRequest(http.MethodGet, "http://test.com", nil)
+				ctx := httpRequest.Context()
+				ctx = context.WithValue(ctx, middleware.TraceIDKey, "123")
+				httpRequest = httpRequest.WithContext(ctx)
+				c.Request = httpRequest
+
+				return c, rr, nil
+			},
+			expectedStatusCode: http.StatusUnauthorized,
+			expectedResponse:   `{"error":"Unauthorized"}`,
+		},
+		{
+			name: "invalid user id in token",
+			setup: func() (*gin.Context, *httptest.ResponseRecorder, service.ApplicationService) {
+				rr := httptest.NewRecorder()
+				c, _ := gin.CreateTestContext(rr)
+				httpRequest, _ := http.NewRequest(http.MethodGet, "http://test.com", nil)
+				ctx := httpRequest.Context()
+				ctx = context.WithValue(ctx, middleware.TraceIDKey, "123")
+				ctx = context.WithValue(ctx, authentication.AuthKey, jwt.RegisteredClaims{Subject: "admin"})
+				httpRequest = httpRequest.WithContext(ctx)
+				c.Params = append(c.Params, gin.Param{Key: "id", Value: "2"})
+				c.Request = httpRequest
+
+				return c, rr, nil
+			},
+			expectedStatusCode: http.StatusUnauthorized,
+			expectedResponse:   `{"error":"Unauthorized"}`,
+		},
+		{
+			name: "invalid job id",
+			setup: func() (*gin.Context, *httptest.ResponseRecorder, service.ApplicationService) {
+				rr := httptest.NewRecorder()
+				c, _ := gin.CreateTestContext(rr)
+				httpRequest, _ := http.NewRequest(http.MethodGet, "http://test.com", nil)
+				ctx := httpRequest.Context()
+				ctx = context.WithValue(ctx, middleware.TraceIDKey, "123")
+				ctx = context.WithValue(ctx, authentication.AuthKey, jwt.RegisteredClaims{Subject: "8"})
+				httpRequest = httpRequest.WithContext(ctx)
+				c.Params = append(c.Params, gin.Param{Key: "id", Value: "abc"})
+				c.Request = httpRequest
+
+				return c, rr, nil
+			},
+			expectedStatusCode: http.StatusBadRequest,
+			expectedResponse:   `{"error":"Bad Request"}`,
+		},
+		{
+			name: "not the company owner",
+			setup: func() (*gin.Context, *httptest.ResponseRecorder, service.ApplicationService) {
+				rr := httptest.NewRecorder()
+				c, _ := gin.CreateTestContext(rr)
+				httpRequest, _ := http.NewRequest(http.MethodGet, "http://test.com", nil)
+				ctx := httpRequest.Context()
+				ctx = context.WithValue(ctx, middleware.TraceIDKey, "123")
+				ctx = context.WithValue(ctx, authentication.AuthKey, jwt.RegisteredClaims{Subject: "8"})
+				httpRequest = httpRequest.WithContext(ctx)
+				c.Params = append(c.Params, gin.Param{Key: "id", Value: "2"})
+				c.Request = httpRequest
+
+				mc := gomock.NewController(t)
+				ma := service.NewMockApplicationService(mc)
+
+				ma.EXPECT().ViewApplicationsByJobID(uint(8), uint(2)).Return(nil, service.ErrForbidden)
+
+				return c, rr, ma
+			},
+			expectedStatusCode: http.StatusForbidden,
+			expectedResponse:   `{"error":"Forbidden"}`,
+		},
+		{
+			name: "failure",
+			setup: func() (*gin.Context, *httptest.ResponseRecorder, service.ApplicationService) {
+				rr := httptest.NewRecorder()
+				c, _ := gin.CreateTestContext(rr)
+				httpRequest, _ := http.NewRequest(http.MethodGet, "http://test.com", nil)
+				ctx := httpRequest.Context()
+				ctx = context.WithValue(ctx, middleware.TraceIDKey, "123")
+				ctx = context.WithValue(ctx, authentication.AuthKey, jwt.RegisteredClaims{Subject: "8"})
+				httpRequest = httpRequest.WithContext(ctx)
+				c.Params = append(c.Params, gin.Param{Key: "id", Value: "2"})
+				c.Request = httpRequest
+
+				mc := gomock.NewController(t)
+				ma := service.NewMockApplicationService(mc)
+
+				ma.EXPECT().ViewApplicationsByJobID(uint(8), uint(2)).Return(nil, errors.New("error"))
+
+				return c, rr, ma
+			},
+			expectedStatusCode: http.StatusBadRequest,
+			expectedResponse:   `{"error":"Bad Request"}`,
+		},
+		{
+			name: "success",
+			setup: func() (*gin.Context, *httptest.ResponseRecorder, service.ApplicationService) {
+				rr := httptest.NewRecorder()
+				c, _ := gin.CreateTestContext(rr)
+				httpRequest, _ := http.NewRequest(http.MethodGet, "http://test.com", nil)
+				ctx := httpRequest.Context()
+				ctx = context.WithValue(ctx, middleware.TraceIDKey, "123")
+				ctx = context.WithValue(ctx, authentication.AuthKey, jwt.RegisteredClaims{Subject: "8"})
+				httpRequest = httpRequest.WithContext(ctx)
+				c.Params = append(c.Params, gin.Param{Key: "id", Value: "2"})
+				c.Request = httpRequest
+
+				mc := gomock.NewController(t)
+				ma := service.NewMockApplicationService(mc)
+
+				ma.EXPECT().ViewApplicationsByJobID(uint(8), uint(2)).Return([]model.Application{model.Application{Model: gorm.Model{ID: 1}, UserID: 1, JobID: 2, Name: "asha", Age: "25", Details: model.Requestfield{NoticePeriod: 30, Experience: 2}, Accepted: true, Screening: model.ScreeningResult{Accepted: true, Score: 4, MaxScore: 7, Threshold: 3.5}, Stage: model.ApplicationStageApplied}}, nil)
+
+				return c, rr, ma
+			},
+			expectedStatusCode: http.StatusOK,
+			expectedResponse:   `[{"ID":1,"CreatedAt":"0001-01-01T00:00:00Z","UpdatedAt":"0001-01-01T00:00:00Z","DeletedAt":null,"user_id":1,"job_id":2,"name":"asha","age":"25","details":{"noticePeriod":30,"location":null,"technologyStack":null,"experience":2,"qualifications":null,"shifts":null,"jobtype":null,"skills":null},"accepted":true,"screening":{"accepted":true,"score":4,"max_score":7,"threshold":3.5,"knocked_out":false,"criteria":null},"screened_at":"0001-01-01T00:00:00Z","stage":"applied","stage_changed_at":"0001-01-01T00:00:00Z"}]`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gin.SetMode(gin.TestMode)
+			c, rr, ma := tt.setup()
+			h := Handler{
+				serviceApplication: ma,
+			}
+			h.ViewApplicationsByJobID(c)
+			assert.Equal(t, tt.expectedStatusCode, rr.Code)
+			assert.Equal(t, tt.expectedResponse, rr.Body.String())
+		})
+	}
+}
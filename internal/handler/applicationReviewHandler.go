@@ -0,0 +1,327 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"job-portal-api/internal/authentication"
+	"job-portal-api/internal/middleware"
+	"job-portal-api/internal/model"
+	"job-portal-api/internal/service"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/rs/zerolog/log"
+)
+
+type ApplicationReviewHandler interface {
+	AddApplicationNote(c *gin.Context)
+	ViewApplicationReview(c *gin.Context)
+	TagApplication(c *gin.Context)
+	RateApplication(c *gin.Context)
+	FilterApplications(c *gin.Context)
+}
+
+func NewApplicationReviewHandler(serviceReview service.ApplicationReviewService) (ApplicationReviewHandler, error) {
+	if serviceReview == nil {
+		log.Info().Msg("application review service cannot be nil")
+		return nil, errors.New("application review service cannot be nil")
+	}
+	return &Handler{
+		serviceReview: serviceReview,
+	}, nil
+}
+
+// AddApplicationNote adds a private note of the logged in recruiter to the
+// application.
+func (h *Handler) AddApplicationNote(c *gin.Context) {
+	ctx := c.Request.Context()
+	traceId, ok := ctx.Value(middleware.TraceIDKey).(string)
+	if !ok {
+		log.Info().Msg("missing trace id")
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"error": http.StatusText(http.StatusInternalServerError)})
+		return
+	}
+
+	claims, ok := ctx.Value(authentication.AuthKey).(jwt.RegisteredClaims)
+	if !ok {
+		log.Info().Str("trace id : ", traceId).Msg("login first")
+		c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": http.StatusText(http.StatusUnauthorized)})
+		return
+	}
+
+	uID, err := strconv.ParseUint(claims.Subject, 10, 64)
+	if err != nil {
+		log.Error().Err(err).Str("trace id : ", traceId).Msg("error invalid user id in token")
+		c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": http.StatusText(http.StatusUnauthorized)})
+		return
+	}
+
+	aID, err := strconv.ParseUint(c.Param("id"), 10, 64)
+	if err != nil {
+		log.Error().Err(err).Str("trace id : ", traceId).Msg("error invalid application id")
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": http.StatusText(http.StatusBadRequest)})
+		return
+	}
+
+	var noteData model.NewApplicationNote
+	err = json.NewDecoder(c.Request.Body).Decode(&noteData)
+	if err != nil {
+		log.Error().Err(err).Str("trace id : ", traceId).Msg("error in decoding")
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": http.StatusText(http.StatusBadRequest)})
+		return
+	}
+
+	validate := validator.New()
+	err = validate.Struct(noteData)
+	if err != nil {
+		log.Error().Err(err).Str("trace id : ", traceId).Msg("error in validating")
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": http.StatusText(http.StatusBadRequest)})
+		return
+	}
+
+	note, err := h.serviceReview.AddApplicationNote(uint(uID), uint(aID), noteData)
+	if errors.Is(err, service.ErrForbidden) {
+		log.Error().Err(err).Str("trace id : ", traceId).Msg("error user does not recruit for the job")
+		c.AbortWithStatusJSON(http.StatusForbidden, gin.H{"error": http.StatusText(http.StatusForbidden)})
+		return
+	}
+	if err != nil {
+		log.Error().Err(err).Str("trace id : ", traceId).Msg("error in adding note")
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": http.StatusText(http.StatusBadRequest)})
+		return
+	}
+
+	c.JSON(http.StatusOK, note)
+}
+
+// ViewApplicationReview returns the rating, tags and notes of the
+// application. Only the recruiter can see them.
+func (h *Handler) ViewApplicationReview(c *gin.Context) {
+	ctx := c.Request.Context()
+	traceId, ok := ctx.Value(middleware.TraceIDKey).(string)
+	if !ok {
+		log.Info().Msg("missing trace id")
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"error": http.StatusText(http.StatusInternalServerError)})
+		return
+	}
+
+	claims, ok := ctx.Value(authentication.AuthKey).(jwt.RegisteredClaims)
+	if !ok {
+		log.Info().Str("trace id : ", traceId).Msg("login first")
+		c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": http.StatusText(http.StatusUnauthorized)})
+		return
+	}
+
+	uID, err := strconv.ParseUint(claims.Subject, 10, 64)
+	if err != nil {
+		log.Error().Err(err).Str("trace id : ", traceId).Msg("error invalid user id in token")
+		c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": http.StatusText(http.StatusUnauthorized)})
+		return
+	}
+
+	aID, err := strconv.ParseUint(c.Param("id"), 10, 64)
+	if err != nil {
+		log.Error().Err(err).Str("trace id : ", traceId).Msg("error invalid application id")
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": http.StatusText(http.StatusBadRequest)})
+		return
+	}
+
+	review, err := h.serviceReview.ViewApplicationReview(uint(uID), uint(aID))
+	if errors.Is(err, service.ErrForbidden) {
+		log.Error().Err(err).Str("trace id : ", traceId).Msg("error user does not recruit for the job")
+		c.AbortWithStatusJSON(http.StatusForbidden, gin.H{"error": http.StatusText(http.StatusForbidden)})
+		return
+	}
+	if err != nil {
+		log.Error().Err(err).Str("trace id : ", traceId).Msg("error in fetching application review")
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": http.StatusText(http.StatusBadRequest)})
+		return
+	}
+
+	c.JSON(http.StatusOK, review)
+}
+
+// TagApplication replaces the tags of the application with the ones in the
+// body.
+func (h *Handler) TagApplication(c *gin.Context) {
+	ctx := c.Request.Context()
+	traceId, ok := ctx.Value(middleware.TraceIDKey).(string)
+	if !ok {
+		log.Info().Msg("missing trace id")
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"error": http.StatusText(http.StatusInternalServerError)})
+		return
+	}
+
+	claims, ok := ctx.Value(authentication.AuthKey).(jwt.RegisteredClaims)
+	if !ok {
+		log.Info().Str("trace id : ", traceId).Msg("login first")
+		c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": http.StatusText(http.StatusUnauthorized)})
+		return
+	}
+
+	uID, err := strconv.ParseUint(claims.Subject, 10, 64)
+	if err != nil {
+		log.Error().Err(err).Str("trace id : ", traceId).Msg("error invalid user id in token")
+		c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": http.StatusText(http.StatusUnauthorized)})
+		return
+	}
+
+	aID, err := strconv.ParseUint(c.Param("id"), 10, 64)
+	if err != nil {
+		log.Error().Err(err).Str("trace id : ", traceId).Msg("error invalid application id")
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": http.StatusText(http.StatusBadRequest)})
+		return
+	}
+
+	var tagData model.NewApplicationTags
+	err = json.NewDecoder(c.Request.Body).Decode(&tagData)
+	if err != nil {
+		log.Error().Err(err).Str("trace id : ", traceId).Msg("error in decoding")
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": http.StatusText(http.StatusBadRequest)})
+		return
+	}
+
+	validate := validator.New()
+	err = validate.Struct(tagData)
+	if err != nil {
+		log.Error().Err(err).Str("trace id : ", traceId).Msg("error in validating")
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": http.StatusText(http.StatusBadRequest)})
+		return
+	}
+
+	review, err := h.serviceReview.TagApplication(uint(uID), uint(aID), tagData)
+	if errors.Is(err, service.ErrForbidden) {
+		log.Error().Err(err).Str("trace id : ", traceId).Msg("error user does not recruit for the job")
+		c.AbortWithStatusJSON(http.StatusForbidden, gin.H{"error": http.StatusText(http.StatusForbidden)})
+		return
+	}
+	if err != nil {
+		log.Error().Err(err).Str("trace id : ", traceId).Msg("error in tagging application")
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": http.StatusText(http.StatusBadRequest)})
+		return
+	}
+
+	c.JSON(http.StatusOK, review)
+}
+
+// RateApplication sets the star rating of the application.
+func (h *Handler) RateApplication(c *gin.Context) {
+	ctx := c.Request.Context()
+	traceId, ok := ctx.Value(middleware.TraceIDKey).(string)
+	if !ok {
+		log.Info().Msg("missing trace id")
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"error": http.StatusText(http.StatusInternalServerError)})
+		return
+	}
+
+	claims, ok := ctx.Value(authentication.AuthKey).(jwt.RegisteredClaims)
+	if !ok {
+		log.Info().Str("trace id : ", traceId).Msg("login first")
+		c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": http.StatusText(http.StatusUnauthorized)})
+		return
+	}
+
+	uID, err := strconv.ParseUint(claims.Subject, 10, 64)
+	if err != nil {
+		log.Error().Err(err).Str("trace id : ", traceId).Msg("error invalid user id in token")
+		c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": http.StatusText(http.StatusUnauthorized)})
+		return
+	}
+
+	aID, err := strconv.ParseUint(c.Param("id"), 10, 64)
+	if err != nil {
+		log.Error().Err(err).Str("trace id : ", traceId).Msg("error invalid application id")
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": http.StatusText(http.StatusBadRequest)})
+		return
+	}
+
+	var ratingData model.NewApplicationRating
+	err = json.NewDecoder(c.Request.Body).Decode(&ratingData)
+	if err != nil {
+		log.Error().Err(err).Str("trace id : ", traceId).Msg("error in decoding")
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": http.StatusText(http.StatusBadRequest)})
+		return
+	}
+
+	validate := validator.New()
+	err = validate.Struct(ratingData)
+	if err != nil {
+		log.Error().Err(err).Str("trace id : ", traceId).Msg("error in validating")
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": http.StatusText(http.StatusBadRequest)})
+		return
+	}
+
+	review, err := h.serviceReview.RateApplication(uint(uID), uint(aID), ratingData)
+	if errors.Is(err, service.ErrForbidden) {
+		log.Error().Err(err).Str("trace id : ", traceId).Msg("error user does not recruit for the job")
+		c.AbortWithStatusJSON(http.StatusForbidden, gin.H{"error": http.StatusText(http.StatusForbidden)})
+		return
+	}
+	if err != nil {
+		log.Error().Err(err).Str("trace id : ", traceId).Msg("error in rating application")
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": http.StatusText(http.StatusBadRequest)})
+		return
+	}
+
+	c.JSON(http.StatusOK, review)
+}
+
+// FilterApplications lists the applications of a job by tag and minimum
+// rating, given as the tag and min_rating query parameters.
+func (h *Handler) FilterApplications(c *gin.Context) {
+	ctx := c.Request.Context()
+	traceId, ok := ctx.Value(middleware.TraceIDKey).(string)
+	if !ok {
+		log.Info().Msg("missing trace id")
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"error": http.StatusText(http.StatusInternalServerError)})
+		return
+	}
+
+	claims, ok := ctx.Value(authentication.AuthKey).(jwt.RegisteredClaims)
+	if !ok {
+		log.Info().Str("trace id : ", traceId).Msg("login first")
+		c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": http.StatusText(http.StatusUnauthorized)})
+		return
+	}
+
+	uID, err := strconv.ParseUint(claims.Subject, 10, 64)
+	if err != nil {
+		log.Error().Err(err).Str("trace id : ", traceId).Msg("error invalid user id in token")
+		c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": http.StatusText(http.StatusUnauthorized)})
+		return
+	}
+
+	jID, err := strconv.ParseUint(c.Param("id"), 10, 64)
+	if err != nil {
+		log.Error().Err(err).Str("trace id : ", traceId).Msg("error invalid job id")
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": http.StatusText(http.StatusBadRequest)})
+		return
+	}
+
+	minRating := 0
+	if v := c.Query("min_rating"); v != "" {
+		minRating, err = strconv.Atoi(v)
+		if err != nil {
+			log.Error().Err(err).Str("trace id : ", traceId).Msg("error in parsing min rating")
+			c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": http.StatusText(http.StatusBadRequest)})
+			return
+		}
+	}
+
+	applications, err := h.serviceReview.FilterApplications(uint(uID), uint(jID), c.Query("tag"), minRating)
+	if errors.Is(err, service.ErrForbidden) {
+		log.Error().Err(err).Str("trace id : ", traceId).Msg("error user does not recruit for the job")
+		c.AbortWithStatusJSON(http.StatusForbidden, gin.H{"error": http.StatusText(http.StatusForbidden)})
+		return
+	}
+	if err != nil {
+		log.Error().Err(err).Str("trace id : ", traceId).Msg("error in filtering applications")
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": http.StatusText(http.StatusBadRequest)})
+		return
+	}
+
+	c.JSON(http.StatusOK, applications)
+}
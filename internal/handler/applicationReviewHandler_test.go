@@ -0,0 +1,949 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"job-portal-api/internal/authentication"
+	"job-portal-api/internal/middleware"
+	"job-portal-api/internal/model"
+	"job-portal-api/internal/service"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang-jwt/jwt/v5"
+	"go.uber.org/mock/gomock"
+	"gopkg.in/go-playground/assert.v1"
+	"gorm.io/gorm"
+)
+
+func TestHandler_AddApplicationNote(t *testing.T) {
+	tests := []struct {
+		name               string
+		setup              func() (*gin.Context, *httptest.ResponseRecorder, service.ApplicationReviewService)
+		expectedStatusCode int
+		expectedResponse   string
+	}{
+		{
+			name: "missing trace id",
+			setup: func() (*gin.Context, *httptest.ResponseRecorder, service.ApplicationReviewService) {
+				rr := httptest.NewRecorder()
+				c, _ := gin.CreateTestContext(rr)
+				httpRequest, _ := http.NewRequest(http.MethodPost, "http://test.com", nil)
+				c.Request = httpRequest
+
+				return c, rr, nil
+			},
+			expectedStatusCode: http.StatusInternalServerError,
+			expectedResponse:   `{"error":"Internal Server Error"}`,
+		},
+		{
+			name: "missing jwt claims",
+			setup: func() (*gin.Context, *httptest.ResponseRecorder, service.ApplicationReviewService) {
+				rr := httptest.NewRecorder()
+				c, _ := gin.CreateTestContext(rr)
+				httpRequest, _ := http.NewRequest(http.MethodPost, "http://test.com", nil)
+				ctx := httpRequest.Context()
+				ctx = context.WithValue(ctx, middleware.TraceIDKey, "123")
+				httpRequest = httpRequest.WithContext(ctx)
+				c.Request = httpRequest
+
+				return c, rr, nil
+			},
+			expectedStatusCode: http.StatusUnauthorized,
+			expectedResponse:   `{"error":"Unauthorized"}`,
+		},
+		{
+			name: "invalid user id in token",
+			setup: func() (*gin.Context, *httptest.ResponseRecorder, service.ApplicationReviewService) {
+				rr := httptest.NewRecorder()
+				c, _ := gin.CreateTestContext(rr)
+				httpRequest, _ := http.NewRequest(http.MethodPost, "http://test.com", strings.NewReader(`{"body":"strong on go"}`))
+				ctx := httpRequest.Context()
+				ctx = context.WithValue(ctx, middleware.TraceIDKey, "123")
+				ctx = context.WithValue(ctx, authentication.AuthKey, jwt.RegisteredClaims{Subject: "admin"})
+				httpRequest = httpRequest.WithContext(ctx)
+				c.Params = append(c.Params, gin.Param{Key: "id", Value: "3"})
+				c.Request = httpRequest
+
+				return c, rr, nil
+			},
+			expectedStatusCode: http.StatusUnauthorized,
+			expectedResponse:   `{"error":"Unauthorized"}`,
+		},
+		{
+			name: "invalid application id",
+			setup: func() (*gin.Context, *httptest.ResponseRecorder, service.ApplicationReviewService) {
+				rr := httptest.NewRecorder()
+				c, _ := gin.CreateTestContext(rr)
+				httpRequest, _ := http.NewRequest(http.MethodPost, "http://test.com", strings.NewReader(`{"body":"strong on go"}`))
+				ctx := httpRequest.Context()
+				ctx = context.WithValue(ctx, middleware.TraceIDKey, "123")
+				ctx = context.WithValue(ctx, authentication.AuthKey, jwt.RegisteredClaims{Subject: "8"})
+				httpRequest = httpRequest.WithContext(ctx)
+				c.Params = append(c.Params, gin.Param{Key: "id", Value: "abc"})
+				c.Request = httpRequest
+
+				return c, rr, nil
+			},
+			expectedStatusCode: http.StatusBadRequest,
+			expectedResponse:   `{"error":"Bad Request"}`,
+		},
+		{
+			name: "invalid body",
+			setup: func() (*gin.Context, *httptest.ResponseRecorder, service.ApplicationReviewService) {
+				rr := httptest.NewRecorder()
+				c, _ := gin.CreateTestContext(rr)
+				httpRequest, _ := http.NewRequest(http.MethodPost, "http://test.com", strings.NewReader(`{"body":`))
+				ctx := httpRequest.Context()
+				ctx = context.WithValue(ctx, middleware.TraceIDKey, "123")
+				ctx = context.WithValue(ctx, authentication.AuthKey, jwt.RegisteredClaims{Subject: "8"})
+				httpRequest = httpRequest.WithContext(ctx)
+				c.Params = append(c.Params, gin.Param{Key: "id", Value: "3"})
+				c.Request = httpRequest
+
+				return c, rr, nil
+			},
+			expectedStatusCode: http.StatusBadRequest,
+			expectedResponse:   `{"error":"Bad Request"}`,
+		},
+		{
+			name: "validation failure",
+			setup: func() (*gin.Context, *httptest.ResponseRecorder, service.ApplicationReviewService) {
+				rr := httptest.NewRecorder()
+				c, _ := gin.CreateTestContext(rr)
+				httpRequest, _ := http.NewRequest(http.MethodPost, "http://test.com", strings.NewReader(`{}`))
+				ctx := httpRequest.Context()
+				ctx = context.WithValue(ctx, middleware.TraceIDKey, "123")
+				ctx = context.WithValue(ctx, authentication.AuthKey, jwt.RegisteredClaims{Subject: "8"})
+				httpRequest = httpRequest.WithContext(ctx)
+				c.Params = append(c.Params, gin.Param{Key: "id", Value: "3"})
+				c.Request = httpRequest
+
+				return c, rr, nil
+			},
+			expectedStatusCode: http.StatusBadRequest,
+			expectedResponse:   `{"error":"Bad Request"}`,
+		},
+		{
+			name: "not the recruiter",
+			setup: func() (*gin.Context, *httptest.ResponseRecorder, service.ApplicationReviewService) {
+				rr := httptest.NewRecorder()
+				c, _ := gin.CreateTestContext(rr)
+				httpRequest, _ := http.NewRequest(http.MethodPost, "http://test.com", strings.NewReader(`{"body":"strong on go"}`))
+				ctx := httpRequest.Context()
+				ctx = context.WithValue(ctx, middleware.TraceIDKey, "123")
+				ctx = context.WithValue(ctx, authentication.AuthKey, jwt.RegisteredClaims{Subject: "8"})
+				httpRequest = httpRequest.WithContext(ctx)
+				c.Params = append(c.Params, gin.Param{Key: "id", Value: "3"})
+				c.Request = httpRequest
+
+				mc := gomock.NewController(t)
+				mr := service.NewMockApplicationReviewService(mc)
+
+				mr.EXPECT().AddApplicationNote(uint(8), uint(3), model.NewApplicationNote{Body: "strong on go"}).Return(model.ApplicationNote{}, service.ErrForbidden)
+
+				return c, rr, mr
+			},
+			expectedStatusCode: http.StatusForbidden,
+			expectedResponse:   `{"error":"Forbidden"}`,
+		},
+		{
+			name: "failure",
+			setup: func() (*gin.Context, *httptest.ResponseRecorder, service.ApplicationReviewService) {
+				rr := httptest.NewRecorder()
+				c, _ := gin.CreateTestContext(rr)
+				httpRequest, _ := http.NewRequest(http.MethodPost, "http://test.com", strings.NewReader(`{"body":"strong on go"}`))
+				ctx := httpRequest.Context()
+				ctx = context.WithValue(ctx, middleware.TraceIDKey, "123")
+				ctx = context.WithValue(ctx, authentication.AuthKey, jwt.RegisteredClaims{Subject: "8"})
+				httpRequest = httpRequest.WithContext(ctx)
+				c.Params = append(c.Params, gin.Param{Key: "id", Value: "3"})
+				c.Request = httpRequest
+
+				mc := gomock.NewController(t)
+				mr := service.NewMockApplicationReviewService(mc)
+
+				mr.EXPECT().AddApplicationNote(uint(8), uint(3), model.NewApplicationNote{Body: "strong on go"}).Return(model.ApplicationNote{}, errors.New("error"))
+
+				return c, rr, mr
+			},
+			expectedStatusCode: http.StatusBadRequest,
+			expectedResponse:   `{"error":"Bad Request"}`,
+		},
+		{
+			name: "success",
+			setup: func() (*gin.Context, *httptest.ResponseRecorder, service.ApplicationReviewService) {
+				rr := httptest.NewRecorder()
+				c, _ := gin.CreateTestContext(rr)
+				httpRequest, _ := http.NewRequest(http.MethodPost, "http://test.com", strings.NewReader(`{"body":"strong on go"}`))
+				ctx := httpRequest.Context()
+				ctx = context.WithValue(ctx, middleware.TraceIDKey, "123")
+				ctx = context.WithValue(ctx, authentication.AuthKey, jwt.RegisteredClaims{Subject: "8"})
+				httpRequest = httpRequest.WithContext(ctx)
+				c.Params = append(c.Params, gin.Param{Key: "id", Value: "3"})
+				c.Request = httpRequest
+
+				mc := gomock.NewController(t)
+				mr := service.NewMockApplicationReviewService(mc)
+
+				mr.EXPECT().AddApplicationNote(uint(8), uint(3), model.NewApplicationNote{Body: "strong on go"}).Return(model.ApplicationNote{ID: 1, ApplicationID: 3, AuthorID: 8, AuthorName: "ravi", Body: "strong on go"}, nil)
+
+				return c, rr, mr
+			},
+			expectedStatusCode: http.StatusOK,
+			expectedResponse:   `{"id":1,"application_id":3,"author_id":8,"author_name":"ravi","body":"strong on go","created_at":"0001-01-01T00:00:00Z"}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gin.SetMode(gin.TestMode)
+			c, rr, mr := tt.setup()
+			h := Handler{
+				serviceReview: mr,
+			}
+			h.AddApplicationNote(c)
+			assert.Equal(t, tt.expectedStatusCode, rr.Code)
+			assert.Equal(t, tt.expectedResponse, rr.Body.String())
+		})
+	}
+}
+
+func TestHandler_ViewApplicationReview(t *testing.T) {
+	tests := []struct {
+		name               string
+		setup              func() (*gin.Context, *httptest.ResponseRecorder, service.ApplicationReviewService)
+		expectedStatusCode int
+		expectedResponse   string
+	}{
+		{
+			name: "missing trace id",
+			setup: func() (*gin.Context, *httptest.ResponseRecorder, service.ApplicationReviewService) {
+				rr := httptest.NewRecorder()
+				c, _ := gin.CreateTestContext(rr)
+				httpRequest, _ := http.NewRequest(http.MethodGet, "http://test.com", nil)
+				c.Request = httpRequest
+
+				return c, rr, nil
+			},
+			expectedStatusCode: http.StatusInternalServerError,
+			expectedResponse:   `{"error":"Internal Server Error"}`,
+		},
+		{
+			name: "missing jwt claims",
+			setup: func() (*gin.Context, *httptest.ResponseRecorder, service.ApplicationReviewService) {
+				rr := httptest.NewRecorder()
+				c, _ := gin.CreateTestContext(rr)
+				httpRequest, _ := http.NewRequest(http.MethodGet, "http://test.com", nil)
+				ctx := httpRequest.Context()
+				ctx = context.WithValue(ctx, middleware.TraceIDKey, "123")
+				httpRequest = httpRequest.WithContext(ctx)
+				c.Request = httpRequest
+
+				return c, rr, nil
+			},
+			expectedStatusCode: http.StatusUnauthorized,
+			expectedResponse:   `{"error":"Unauthorized"}`,
+		},
+		{
+			name: "invalid user id in token",
+			setup: func() (*gin.Context, *httptest.ResponseRecorder, service.ApplicationReviewService) {
+				rr := httptest.NewRecorder()
+				c, _ := gin.CreateTestContext(rr)
+				httpRequest, _ := http.NewRequest(http.MethodGet, "http://test.com", nil)
+				ctx := httpRequest.Context()
+				ctx = context.WithValue(ctx, middleware.TraceIDKey, "123")
+				ctx = context.WithValue(ctx, authentication.AuthKey, jwt.RegisteredClaims{Subject: "admin"})
+				httpRequest = httpRequest.WithContext(ctx)
+				c.Params = append(c.Params, gin.Param{Key: "id", Value: "3"})
+				c.Request = httpRequest
+
+				return c, rr, nil
+			},
+			expectedStatusCode: http.StatusUnauthorized,
+			expectedResponse:   `{"error":"Unauthorized"}`,
+		},
+		{
+			name: "invalid application id",
+			setup: func() (*gin.Context, *httptest.ResponseRecorder, service.ApplicationReviewService) {
+				rr := httptest.NewRecorder()
+				c, _ := gin.CreateTestContext(rr)
+				httpRequest, _ := http.NewRequest(http.MethodGet, "http://test.com", nil)
+				ctx := httpRequest.Context()
+				ctx = context.WithValue(ctx, middleware.TraceIDKey, "123")
+				ctx = context.WithValue(ctx, authentication.AuthKey, jwt.RegisteredClaims{Subject: "8"})
+				httpRequest = httpRequest.WithContext(ctx)
+				c.Params = append(c.Params, gin.Param{Key: "id", Value: "abc"})
+				c.Request = httpRequest
+
+				return c, rr, nil
+			},
+			expectedStatusCode: http.StatusBadRequest,
+			expectedResponse:   `{"error":"Bad Request"}`,
+		},
+		{
+			name: "not the recruiter",
+			setup: func() (*gin.Context, *httptest.ResponseRecorder, service.ApplicationReviewService) {
+				rr := httptest.NewRecorder()
+				c, _ := gin.CreateTestContext(rr)
+				httpRequest, _ := http.NewRequest(http.MethodGet, "http://test.com", nil)
+				ctx := httpRequest.Context()
+				ctx = context.WithValue(ctx, middleware.TraceIDKey, "123")
+				ctx = context.WithValue(ctx, authentication.AuthKey, jwt.RegisteredClaims{Subject: "8"})
+				httpRequest = httpRequest.WithContext(ctx)
+				c.Params = append(c.Params, gin.Param{Key: "id", Value: "3"})
+				c.Request = httpRequest
+
+				mc := gomock.NewController(t)
+				mr := service.NewMockApplicationReviewService(mc)
+
+				mr.EXPECT().ViewApplicationReview(uint(8), uint(3)).Return(model.ApplicationReview{}, service.ErrForbidden)
+
+				return c, rr, mr
+			},
+			expectedStatusCode: http.StatusForbidden,
+			expectedResponse:   `{"error":"Forbidden"}`,
+		},
+		{
+			name: "failure",
+			setup: func() (*gin.Context, *httptest.ResponseRecorder, service.ApplicationReviewService) {
+				rr := httptest.NewRecorder()
+				c, _ := gin.CreateTestContext(rr)
+				httpRequest, _ := http.NewRequest(http.MethodGet, "http://test.com", nil)
+				ctx := httpRequest.Context()
+				ctx = context.WithValue(ctx, middleware.TraceIDKey, "123")
+				ctx = context.WithValue(ctx, authentication.AuthKey, jwt.RegisteredClaims{Subject: "8"})
+				httpRequest = httpRequest.WithContext(ctx)
+				c.Params = append(c.Params, gin.Param{Key: "id", Value: "3"})
+				c.Request = httpRequest
+
+				mc := gomock.NewController(t)
+				mr := service.NewMockApplicationReviewService(mc)
+
+				mr.EXPECT().ViewApplicationReview(uint(8), uint(3)).Return(model.ApplicationReview{}, errors.New("error"))
+
+				return c, rr, mr
+			},
+			expectedStatusCode: http.StatusBadRequest,
+			expectedResponse:   `{"error":"Bad Request"}`,
+		},
+		{
+			name: "success",
+			setup: func() (*gin.Context, *httptest.ResponseRecorder, service.ApplicationReviewService) {
+				rr := httptest.NewRecorder()
+				c, _ := gin.CreateTestContext(rr)
+				httpRequest, _ := http.NewRequest(http.MethodGet, "http://test.com", nil)
+				ctx := httpRequest.Context()
+				ctx = context.WithValue(ctx, middleware.TraceIDKey, "123")
+				ctx = context.WithValue(ctx, authentication.AuthKey, jwt.RegisteredClaims{Subject: "8"})
+				httpRequest = httpRequest.WithContext(ctx)
+				c.Params = append(c.Params, gin.Param{Key: "id", Value: "3"})
+				c.Request = httpRequest
+
+				mc := gomock.NewController(t)
+				mr := service.NewMockApplicationReviewService(mc)
+
+				mr.EXPECT().ViewApplicationReview(uint(8), uint(3)).Return(model.ApplicationReview{ApplicationID: 3, Rating: &model.ApplicationRating{ApplicationID: 3, Rating: 4, RatedBy: 8}, Tags: []string{"backend"}, Notes: []model.ApplicationNote{model.ApplicationNote{ID: 1, ApplicationID: 3, AuthorID: 8, AuthorName: "ravi", Body: "strong on go"}}}, nil)
+
+				return c, rr, mr
+			},
+			expectedStatusCode: http.StatusOK,
+			expectedResponse:   `{"application_id":3,"rating":{"application_id":3,"rating":4,"rated_by":8,"rated_at":"0001-01-01T00:00:00Z"},"tags":["backend"],"notes":[{"id":1,"application_id":3,"author_id":8,"author_name":"ravi","body":"strong on go","created_at":"0001-01-01T00:00:00Z"}]}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gin.SetMode(gin.TestMode)
+			c, rr, mr := tt.setup()
+			h := Handler{
+				serviceReview: mr,
+			}
+			h.ViewApplicationReview(c)
+			assert.Equal(t, tt.expectedStatusCode, rr.Code)
+			assert.Equal(t, tt.expectedResponse, rr.Body.String())
+		})
+	}
+}
+
+func TestHandler_TagApplication(t *testing.T) {
+	tests := []struct {
+		name               string
+		setup              func() (*gin.Context, *httptest.ResponseRecorder, service.ApplicationReviewService)
+		expectedStatusCode int
+		expectedResponse   string
+	}{
+		{
+			name: "missing trace id",
+			setup: func() (*gin.Context, *httptest.ResponseRecorder, service.ApplicationReviewService) {
+				rr := httptest.NewRecorder()
+				c, _ := gin.CreateTestContext(rr)
+				httpRequest, _ := http.NewRequest(http.MethodPut, "http://test.com", nil)
+				c.Request = httpRequest
+
+				return c, rr, nil
+			},
+			expectedStatusCode: http.StatusInternalServerError,
+			expectedResponse:   `{"error":"Internal Server Error"}`,
+		},
+		{
+			name: "missing jwt claims",
+			setup: func() (*gin.Context, *httptest.ResponseRecorder, service.ApplicationReviewService) {
+				rr := httptest.NewRecorder()
+				c, _ := gin.CreateTestContext(rr)
+				httpRequest, _ := http.NewRequest(http.MethodPut, "http://test.com", nil)
+				ctx := httpRequest.Context()
+				ctx = context.WithValue(ctx, middleware.TraceIDKey, "123")
+				httpRequest = httpRequest.WithContext(ctx)
+				c.Request = httpRequest
+
+				return c, rr, nil
+			},
+			expectedStatusCode: http.StatusUnauthorized,
+			expectedResponse:   `{"error":"Unauthorized"}`,
+		},
+		{
+			name: "invalid user id in token",
+			setup: func() (*gin.Context, *httptest.ResponseRecorder, service.ApplicationReviewService) {
+				rr := httptest.NewRecorder()
+				c, _ := gin.CreateTestContext(rr)
+				httpRequest, _ := http.NewRequest(http.MethodPut, "http://test.com", strings.NewReader(`{"tags":["backend"]}`))
+				ctx := httpRequest.Context()
+				ctx = context.WithValue(ctx, middleware.TraceIDKey, "123")
+				ctx = context.WithValue(ctx, authentication.AuthKey, jwt.RegisteredClaims{Subject: "admin"})
+				httpRequest = httpRequest.WithContext(ctx)
+				c.Params = append(c.Params, gin.Param{Key: "id", Value: "3"})
+				c.Request = httpRequest
+
+				return c, rr, nil
+			},
+			expectedStatusCode: http.StatusUnauthorized,
+			expectedResponse:   `{"error":"Unauthorized"}`,
+		},
+		{
+			name: "invalid application id",
+			setup: func() (*gin.Context, *httptest.ResponseRecorder, service.ApplicationReviewService) {
+				rr := httptest.NewRecorder()
+				c, _ := gin.CreateTestContext(rr)
+				httpRequest, _ := http.NewRequest(http.MethodPut, "http://test.com", strings.NewReader(`{"tags":["backend"]}`))
+				ctx := httpRequest.Context()
+				ctx = context.WithValue(ctx, middleware.TraceIDKey, "123")
+				ctx = context.WithValue(ctx, authentication.AuthKey, jwt.RegisteredClaims{Subject: "8"})
+				httpRequest = httpRequest.WithContext(ctx)
+				c.Params = append(c.Params, gin.Param{Key: "id", Value: "abc"})
+				c.Request = httpRequest
+
+				return c, rr, nil
+			},
+			expectedStatusCode: http.StatusBadRequest,
+			expectedResponse:   `{"error":"Bad Request"}`,
+		},
+		{
+			name: "invalid body",
+			setup: func() (*gin.Context, *httptest.ResponseRecorder, service.ApplicationReviewService) {
+				rr := httptest.NewRecorder()
+				c, _ := gin.CreateTestContext(rr)
+				httpRequest, _ := http.NewRequest(http.MethodPut, "http://test.com", strings.NewReader(`{"tags":`))
+				ctx := httpRequest.Context()
+				ctx = context.WithValue(ctx, middleware.TraceIDKey, "123")
+				ctx = context.WithValue(ctx, authentication.AuthKey, jwt.RegisteredClaims{Subject: "8"})
+				httpRequest = httpRequest.WithContext(ctx)
+				c.Params = append(c.Params, gin.Param{Key: "id", Value: "3"})
+				c.Request = httpRequest
+
+				return c, rr, nil
+			},
+			expectedStatusCode: http.StatusBadRequest,
+			expectedResponse:   `{"error":"Bad Request"}`,
+		},
+		{
+			name: "validation failure",
+			setup: func() (*gin.Context, *httptest.ResponseRecorder, service.ApplicationReviewService) {
+				rr := httptest.NewRecorder()
+				c, _ := gin.CreateTestContext(rr)
+				httpRequest, _ := http.NewRequest(http.MethodPut, "http://test.com", strings.NewReader(`{"tags":[""]}`))
+				ctx := httpRequest.Context()
+				ctx = context.WithValue(ctx, middleware.TraceIDKey, "123")
+				ctx = context.WithValue(ctx, authentication.AuthKey, jwt.RegisteredClaims{Subject: "8"})
+				httpRequest = httpRequest.WithContext(ctx)
+				c.Params = append(c.Params, gin.Param{Key: "id", Value: "3"})
+				c.Request = httpRequest
+
+				return c, rr, nil
+			},
+			expectedStatusCode: http.StatusBadRequest,
+			expectedResponse:   `{"error":"Bad Request"}`,
+		},
+		{
+			name: "not the recruiter",
+			setup: func() (*gin.Context, *httptest.ResponseRecorder, service.ApplicationReviewService) {
+				rr := httptest.NewRecorder()
+				c, _ := gin.CreateTestContext(rr)
+				httpRequest, _ := http.NewRequest(http.MethodPut, "http://test.com", strings.NewReader(`{"tags":["backend"]}`))
+				ctx := httpRequest.Context()
+				ctx = context.WithValue(ctx, middleware.TraceIDKey, "123")
+				ctx = context.WithValue(ctx, authentication.AuthKey, jwt.RegisteredClaims{Subject: "8"})
+				httpRequest = httpRequest.WithContext(ctx)
+				c.Params = append(c.Params, gin.Param{Key: "id", Value: "3"})
+				c.Request = httpRequest
+
+				mc := gomock.NewController(t)
+				mr := service.NewMockApplicationReviewService(mc)
+
+				mr.EXPECT().TagApplication(uint(8), uint(3), model.NewApplicationTags{Tags: []string{"backend"}}).Return(model.ApplicationReview{}, service.ErrForbidden)
+
+				return c, rr, mr
+			},
+			expectedStatusCode: http.StatusForbidden,
+			expectedResponse:   `{"error":"Forbidden"}`,
+		},
+		{
+			name: "failure",
+			setup: func() (*gin.Context, *httptest.ResponseRecorder, service.ApplicationReviewService) {
+				rr := httptest.NewRecorder()
+				c, _ := gin.CreateTestContext(rr)
+				httpRequest, _ := http.NewRequest(http.MethodPut, "http://test.com", strings.NewReader(`{"tags":["backend"]}`))
+				ctx := httpRequest.Context()
+				ctx = context.WithValue(ctx, middleware.TraceIDKey, "123")
+				ctx = context.WithValue(ctx, authentication.AuthKey, jwt.RegisteredClaims{Subject: "8"})
+				httpRequest = httpRequest.WithContext(ctx)
+				c.Params = append(c.Params, gin.Param{Key: "id", Value: "3"})
+				c.Request = httpRequest
+
+				mc := gomock.NewController(t)
+				mr := service.NewMockApplicationReviewService(mc)
+
+				mr.EXPECT().TagApplication(uint(8), uint(3), model.NewApplicationTags{Tags: []string{"backend"}}).Return(model.ApplicationReview{}, errors.New("error"))
+
+				return c, rr, mr
+			},
+			expectedStatusCode: http.StatusBadRequest,
+			expectedResponse:   `{"error":"Bad Request"}`,
+		},
+		{
+			name: "success",
+			setup: func() (*gin.Context, *httptest.ResponseRecorder, service.ApplicationReviewService) {
+				rr := httptest.NewRecorder()
+				c, _ := gin.CreateTestContext(rr)
+				httpRequest, _ := http.NewRequest(http.MethodPut, "http://test.com", strings.NewReader(`{"tags":["backend"]}`))
+				ctx := httpRequest.Context()
+				ctx = context.WithValue(ctx, middleware.TraceIDKey, "123")
+				ctx = context.WithValue(ctx, authentication.AuthKey, jwt.RegisteredClaims{Subject: "8"})
+				httpRequest = httpRequest.WithContext(ctx)
+				c.Params = append(c.Params, gin.Param{Key: "id", Value: "3"})
+				c.Request = httpRequest
+
+				mc := gomock.NewController(t)
+				mr := service.NewMockApplicationReviewService(mc)
+
+				mr.EXPECT().TagApplication(uint(8), uint(3), model.NewApplicationTags{Tags: []string{"backend"}}).Return(model.ApplicationReview{ApplicationID: 3, Rating: &model.ApplicationRating{ApplicationID: 3, Rating: 4, RatedBy: 8}, Tags: []string{"backend"}, Notes: []model.ApplicationNote{model.ApplicationNote{ID: 1, ApplicationID: 3, AuthorID: 8, AuthorName: "ravi", Body: "strong on go"}}}, nil)
+
+				return c, rr, mr
+			},
+			expectedStatusCode: http.StatusOK,
+			expectedResponse:   `{"application_id":3,"rating":{"application_id":3,"rating":4,"rated_by":8,"rated_at":"0001-01-01T00:00:00Z"},"tags":["backend"],"notes":[{"id":1,"application_id":3,"author_id":8,"author_name":"ravi","body":"strong on go","created_at":"0001-01-01T00:00:00Z"}]}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gin.SetMode(gin.TestMode)
+			c, rr, mr := tt.setup()
+			h := Handler{
+				serviceReview: mr,
+			}
+			h.TagApplication(c)
+			assert.Equal(t, tt.expectedStatusCode, rr.Code)
+			assert.Equal(t, tt.expectedResponse, rr.Body.String())
+		})
+	}
+}
+
+func TestHandler_RateApplication(t *testing.T) {
+	tests := []struct {
+		name               string
+		setup              func() (*gin.Context, *httptest.ResponseRecorder, service.ApplicationReviewService)
+		expectedStatusCode int
+		expectedResponse   string
+	}{
+		{
+			name: "missing trace id",
+			setup: func() (*gin.Context, *httptest.ResponseRecorder, service.ApplicationReviewService) {
+				rr := httptest.NewRecorder()
+				c, _ := gin.CreateTestContext(rr)
+				httpRequest, _ := http.NewRequest(http.MethodPut, "http://test.com", nil)
+				c.Request = httpRequest
+
+				return c, rr, nil
+			},
+			expectedStatusCode: http.StatusInternalServerError,
+			expectedResponse:   `{"error":"Internal Server Error"}`,
+		},
+		{
+			name: "missing jwt claims",
+			setup: func() (*gin.Context, *httptest.ResponseRecorder, service.ApplicationReviewService) {
+				rr := httptest.NewRecorder()
+				c, _ := gin.CreateTestContext(rr)
+				httpRequest, _ := http.NewRequest(http.MethodPut, "http://test.com", nil)
+				ctx := httpRequest.Context()
+				ctx = context.WithValue(ctx, middleware.TraceIDKey, "123")
+				httpRequest = httpRequest.WithContext(ctx)
+				c.Request = httpRequest
+
+				return c, rr, nil
+			},
+			expectedStatusCode: http.StatusUnauthorized,
+			expectedResponse:   `{"error":"Unauthorized"}`,
+		},
+		{
+			name: "invalid user id in token",
+			setup: func() (*gin.Context, *httptest.ResponseRecorder, service.ApplicationReviewService) {
+				rr := httptest.NewRecorder()
+				c, _ := gin.CreateTestContext(rr)
+				httpRequest, _ := http.NewRequest(http.MethodPut, "http://test.com", strings.NewReader(`{"rating":4}`))
+				ctx := httpRequest.Context()
+				ctx = context.WithValue(ctx, middleware.TraceIDKey, "123")
+				ctx = context.WithValue(ctx, authentication.AuthKey, jwt.RegisteredClaims{Subject: "admin"})
+				httpRequest = httpRequest.WithContext(ctx)
+				c.Params = append(c.Params, gin.Param{Key: "id", Value: "3"})
+				c.Request = httpRequest
+
+				return c, rr, nil
+			},
+			expectedStatusCode: http.StatusUnauthorized,
+			expectedResponse:   `{"error":"Unauthorized"}`,
+		},
+		{
+			name: "invalid application id",
+			setup: func() (*gin.Context, *httptest.ResponseRecorder, service.ApplicationReviewService) {
+				rr := httptest.NewRecorder()
+				c, _ := gin.CreateTestContext(rr)
+				httpRequest, _ := http.NewRequest(http.MethodPut, "http://test.com", strings.NewReader(`{"rating":4}`))
+				ctx := httpRequest.Context()
+				ctx = context.WithValue(ctx, middleware.TraceIDKey, "123")
+				ctx = context.WithValue(ctx, authentication.AuthKey, jwt.RegisteredClaims{Subject: "8"})
+				httpRequest = httpRequest.WithContext(ctx)
+				c.Params = append(c.Params, gin.Param{Key: "id", Value: "abc"})
+				c.Request = httpRequest
+
+				return c, rr, nil
+			},
+			expectedStatusCode: http.StatusBadRequest,
+			expectedResponse:   `{"error":"Bad Request"}`,
+		},
+		{
+			name: "invalid body",
+			setup: func() (*gin.Context, *httptest.ResponseRecorder, service.ApplicationReviewService) {
+				rr := httptest.NewRecorder()
+				c, _ := gin.CreateTestContext(rr)
+				httpRequest, _ := http.NewRequest(http.MethodPut, "http://test.com", strings.NewReader(`{"rating":`))
+				ctx := httpRequest.Context()
+				ctx = context.WithValue(ctx, middleware.TraceIDKey, "123")
+				ctx = context.WithValue(ctx, authentication.AuthKey, jwt.RegisteredClaims{Subject: "8"})
+				httpRequest = httpRequest.WithContext(ctx)
+				c.Params = append(c.Params, gin.Param{Key: "id", Value: "3"})
+				c.Request = httpRequest
+
+				return c, rr, nil
+			},
+			expectedStatusCode: http.StatusBadRequest,
+			expectedResponse:   `{"error":"Bad Request"}`,
+		},
+		{
+			name: "validation failure",
+			setup: func() (*gin.Context, *httptest.ResponseRecorder, service.ApplicationReviewService) {
+				rr := httptest.NewRecorder()
+				c, _ := gin.CreateTestContext(rr)
+				httpRequest, _ := http.NewRequest(http.MethodPut, "http://test.com", strings.NewReader(`{"rating":6}`))
+				ctx := httpRequest.Context()
+				ctx = context.WithValue(ctx, middleware.TraceIDKey, "123")
+				ctx = context.WithValue(ctx, authentication.AuthKey, jwt.RegisteredClaims{Subject: "8"})
+				httpRequest = httpRequest.WithContext(ctx)
+				c.Params = append(c.Params, gin.Param{Key: "id", Value: "3"})
+				c.Request = httpRequest
+
+				return c, rr, nil
+			},
+			expectedStatusCode: http.StatusBadRequest,
+			expectedResponse:   `{"error":"Bad Request"}`,
+		},
+		{
+			name: "not the recruiter",
+			setup: func() (*gin.Context, *httptest.ResponseRecorder, service.ApplicationReviewService) {
+				rr := httptest.NewRecorder()
+				c, _ := gin.CreateTestContext(rr)
+				httpRequest, _ := http.NewRequest(http.MethodPut, "http://test.com", strings.NewReader(`{"rating":4}`))
+				ctx := httpRequest.Context()
+				ctx = context.WithValue(ctx, middleware.TraceIDKey, "123")
+				ctx = context.WithValue(ctx, authentication.AuthKey, jwt.RegisteredClaims{Subject: "8"})
+				httpRequest = httpRequest.WithContext(ctx)
+				c.Params = append(c.Params, gin.Param{Key: "id", Value: "3"})
+				c.Request = httpRequest
+
+				mc := gomock.NewController(t)
+				mr := service.NewMockApplicationReviewService(mc)
+
+				mr.EXPECT().RateApplication(uint(8), uint(3), model.NewApplicationRating{Rating: 4}).Return(model.ApplicationReview{}, service.ErrForbidden)
+
+				return c, rr, mr
+			},
+			expectedStatusCode: http.StatusForbidden,
+			expectedResponse:   `{"error":"Forbidden"}`,
+		},
+		{
+			name: "failure",
+			setup: func() (*gin.Context, *httptest.ResponseRecorder, service.ApplicationReviewService) {
+				rr := httptest.NewRecorder()
+				c, _ := gin.CreateTestContext(rr)
+				httpRequest, _ := http.NewRequest(http.MethodPut, "http://test.com", strings.NewReader(`{"rating":4}`))
+				ctx := httpRequest.Context()
+				ctx = context.WithValue(ctx, middleware.TraceIDKey, "123")
+				ctx = context.WithValue(ctx, authentication.AuthKey, jwt.RegisteredClaims{Subject: "8"})
+				httpRequest = httpRequest.WithContext(ctx)
+				c.Params = append(c.Params, gin.Param{Key: "id", Value: "3"})
+				c.Request = httpRequest
+
+				mc := gomock.NewController(t)
+				mr := service.NewMockApplicationReviewService(mc)
+
+				mr.EXPECT().RateApplication(uint(8), uint(3), model.NewApplicationRating{Rating: 4}).Return(model.ApplicationReview{}, errors.New("error"))
+
+				return c, rr, mr
+			},
+			expectedStatusCode: http.StatusBadRequest,
+			expectedResponse:   `{"error":"Bad Request"}`,
+		},
+		{
+			name: "success",
+			setup: func() (*gin.Context, *httptest.ResponseRecorder, service.ApplicationReviewService) {
+				rr := httptest.NewRecorder()
+				c, _ := gin.CreateTestContext(rr)
+				httpRequest, _ := http.NewRequest(http.MethodPut, "http://test.com", strings.NewReader(`{"rating":4}`))
+				ctx := httpRequest.Context()
+				ctx = context.WithValue(ctx, middleware.TraceIDKey, "123")
+				ctx = context.WithValue(ctx, authentication.AuthKey, jwt.RegisteredClaims{Subject: "8"})
+				httpRequest = httpRequest.WithContext(ctx)
+				c.Params = append(c.Params, gin.Param{Key: "id", Value: "3"})
+				c.Request = httpRequest
+
+				mc := gomock.NewController(t)
+				mr := service.NewMockApplicationReviewService(mc)
+
+				mr.EXPECT().RateApplication(uint(8), uint(3), model.NewApplicationRating{Rating: 4}).Return(model.ApplicationReview{ApplicationID: 3, Rating: &model.ApplicationRating{ApplicationID: 3, Rating: 4, RatedBy: 8}, Tags: []string{"backend"}, Notes: []model.ApplicationNote{model.ApplicationNote{ID: 1, ApplicationID: 3, AuthorID: 8, AuthorName: "ravi", Body: "strong on go"}}}, nil)
+
+				return c, rr, mr
+			},
+			expectedStatusCode: http.StatusOK,
+			expectedResponse:   `{"application_id":3,"rating":{"application_id":3,"rating":4,"rated_by":8,"rated_at":"0001-01-01T00:00:00Z"},"tags":["backend"],"notes":[{"id":1,"application_id":3,"author_id":8,"author_name":"ravi","body":"strong on go","created_at":"0001-01-01T00:00:00Z"}]}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gin.SetMode(gin.TestMode)
+			c, rr, mr := tt.setup()
+			h := Handler{
+				serviceReview: mr,
+			}
+			h.RateApplication(c)
+			assert.Equal(t, tt.expectedStatusCode, rr.Code)
+			assert.Equal(t, tt.expectedResponse, rr.Body.String())
+		})
+	}
+}
+
+func TestHandler_FilterApplications(t *testing.T) {
+	tests := []struct {
+		name               string
+		setup              func() (*gin.Context, *httptest.ResponseRecorder, service.ApplicationReviewService)
+		expectedStatusCode int
+		expectedResponse   string
+	}{
+		{
+			name: "missing trace id",
+			setup: func() (*gin.Context, *httptest.ResponseRecorder, service.ApplicationReviewService) {
+				rr := httptest.NewRecorder()
+				c, _ := gin.CreateTestContext(rr)
+				httpRequest, _ := http.NewRequest(http.MethodGet, "http://test.com", nil)
+				c.Request = httpRequest
+
+				return c, rr, nil
+			},
+			expectedStatusCode: http.StatusInternalServerError,
+			expectedResponse:   `{"error":"Internal Server Error"}`,
+		},
+		{
+			name: "missing jwt claims",
+			setup: func() (*gin.Context, *httptest.ResponseRecorder, service.ApplicationReviewService) {
+				rr := httptest.NewRecorder()
+				c, _ := gin.CreateTestContext(rr)
+				httpRequest, _ := http.NewRequest(http.MethodGet, "http://test.com", nil)
+				ctx := httpRequest.Context()
+				ctx = context.WithValue(ctx, middleware.TraceIDKey, "123")
+				httpRequest = httpRequest.WithContext(ctx)
+				c.Request = httpRequest
+
+				return c, rr, nil
+			},
+			expectedStatusCode: http.StatusUnauthorized,
+			expectedResponse:   `{"error":"Unauthorized"}`,
+		},
+		{
+			name: "invalid user id in token",
+			setup: func() (*gin.Context, *httptest.ResponseRecorder, service.ApplicationReviewService) {
+				rr := httptest.NewRecorder()
+				c, _ := gin.CreateTestContext(rr)
+				httpRequest, _ := http.NewRequest(http.MethodGet, "http://test.com?tag=backend&min_rating=3", nil)
+				ctx := httpRequest.Context()
+				ctx = context.WithValue(ctx, middleware.TraceIDKey, "123")
+				ctx = context.WithValue(ctx, authentication.AuthKey, jwt.RegisteredClaims{Subject: "admin"})
+				httpRequest = httpRequest.WithContext(ctx)
+				c.Params = append(c.Params, gin.Param{Key: "id", Value: "2"})
+				c.Request = httpRequest
+
+				return c, rr, nil
+			},
+			expectedStatusCode: http.StatusUnauthorized,
+			expectedResponse:   `{"error":"Unauthorized"}`,
+		},
+		{
+			name: "invalid job id",
+			setup: func() (*gin.Context, *httptest.ResponseRecorder, service.ApplicationReviewService) {
+				rr := httptest.NewRecorder()
+				c, _ := gin.CreateTestContext(rr)
+				httpRequest, _ := http.NewRequest(http.MethodGet, "http://test.com?tag=backend&min_rating=3", nil)
+				ctx := httpRequest.Context()
+				ctx = context.WithValue(ctx, middleware.TraceIDKey, "123")
+				ctx = context.WithValue(ctx, authentication.AuthKey, jwt.RegisteredClaims{Subject: "8"})
+				httpRequest = httpRequest.WithContext(ctx)
+				c.Params = append(c.Params, gin.Param{Key: "id", Value: "abc"})
+				c.Request = httpRequest
+
+				return c, rr, nil
+			},
+			expectedStatusCode: http.StatusBadRequest,
+			expectedResponse:   `{"error":"Bad Request"}`,
+		},
+		{
+			name: "invalid min rating",
+			setup: func() (*gin.Context, *httptest.ResponseRecorder, service.ApplicationReviewService) {
+				rr := httptest.NewRecorder()
+				c, _ := gin.CreateTestContext(rr)
+				httpRequest, _ := http.NewRequest(http.MethodGet, "http://test.com?min_rating=high", nil)
+				ctx := httpRequest.Context()
+				ctx = context.WithValue(ctx, middleware.TraceIDKey, "123")
+				ctx = context.WithValue(ctx, authentication.AuthKey, jwt.RegisteredClaims{Subject: "8"})
+				httpRequest = httpRequest.WithContext(ctx)
+				c.Params = append(c.Params, gin.Param{Key: "id", Value: "2"})
+				c.Request = httpRequest
+
+				return c, rr, nil
+			},
+			expectedStatusCode: http.StatusBadRequest,
+			expectedResponse:   `{"error":"Bad Request"}`,
+		},
+		{
+			name: "not the recruiter",
+			setup: func() (*gin.Context, *httptest.ResponseRecorder, service.ApplicationReviewService) {
+				rr := httptest.NewRecorder()
+				c, _ := gin.CreateTestContext(rr)
+				httpRequest, _ := http.NewRequest(http.MethodGet, "http://test.com?tag=backend&min_rating=3", nil)
+				ctx := httpRequest.Context()
+				ctx = context.WithValue(ctx, middleware.TraceIDKey, "123")
+				ctx = context.WithValue(ctx, authentication.AuthKey, jwt.RegisteredClaims{Subject: "8"})
+				httpRequest = httpRequest.WithContext(ctx)
+				c.Params = append(c.Params, gin.Param{Key: "id", Value: "2"})
+				c.Request = httpRequest
+
+				mc := gomock.NewController(t)
+				mr := service.NewMockApplicationReviewService(mc)
+
+				mr.EXPECT().FilterApplications(uint(8), uint(2), "backend", 3).Return(nil, service.ErrForbidden)
+
+				return c, rr, mr
+			},
+			expectedStatusCode: http.StatusForbidden,
+			expectedResponse:   `{"error":"Forbidden"}`,
+		},
+		{
+			name: "failure",
+			setup: func() (*gin.Context, *httptest.ResponseRecorder, service.ApplicationReviewService) {
+				rr := httptest.NewRecorder()
+				c, _ := gin.CreateTestContext(rr)
+				httpRequest, _ := http.NewRequest(http.MethodGet, "http://test.com?tag=backend&min_rating=3", nil)
+				ctx := httpRequest.Context()
+				ctx = context.WithValue(ctx, middleware.TraceIDKey, "123")
+				ctx = context.WithValue(ctx, authentication.AuthKey, jwt.RegisteredClaims{Subject: "8"})
+				httpRequest = httpRequest.WithContext(ctx)
+				c.Params = append(c.Params, gin.Param{Key: "id", Value: "2"})
+				c.Request = httpRequest
+
+				mc := gomock.NewController(t)
+				mr := service.NewMockApplicationReviewService(mc)
+
+				mr.EXPECT().FilterApplications(uint(8), uint(2), "backend", 3).Return(nil, errors.New("error"))
+
+				return c, rr, mr
+			},
+			expectedStatusCode: http.StatusBadRequest,
+			expectedResponse:   `{"error":"Bad Request"}`,
+		},
+		{
+			name: "without filters",
+			setup: func() (*gin.Context, *httptest.ResponseRecorder, service.ApplicationReviewService) {
+				rr := httptest.NewRecorder()
+				c, _ := gin.CreateTestContext(rr)
+				httpRequest, _ := http.NewRequest(http.MethodGet, "http://test.com", nil)
+				ctx := httpRequest.Context()
+				ctx = context.WithValue(ctx, middleware.TraceIDKey, "123")
+				ctx = context.WithValue(ctx, authentication.AuthKey, jwt.RegisteredClaims{Subject: "8"})
+				httpRequest = httpRequest.WithContext(ctx)
+				c.Params = append(c.Params, gin.Param{Key: "id", Value: "2"})
+				c.Request = httpRequest
+
+				mc := gomock.NewController(t)
+				mr := service.NewMockApplicationReviewService(mc)
+
+				mr.EXPECT().FilterApplications(uint(8), uint(2), "", 0).Return([]model.Application{}, nil)
+
+				return c, rr, mr
+			},
+			expectedStatusCode: http.StatusOK,
+			expectedResponse:   `[]`,
+		},
+		{
+			name: "success",
+			setup: func() (*gin.Context, *httptest.ResponseRecorder, service.ApplicationReviewService) {
+				rr := httptest.NewRecorder()
+				c, _ := gin.CreateTestContext(rr)
+				httpRequest, _ := http.NewRequest(http.MethodGet, "http://test.com?tag=backend&min_rating=3", nil)
+				ctx := httpRequest.Context()
+				ctx = context.WithValue(ctx, middleware.TraceIDKey, "123")
+				ctx = context.WithValue(ctx, authentication.AuthKey, jwt.RegisteredClaims{Subject: "8"})
+				httpRequest = httpRequest.WithContext(ctx)
+				c.Params = append(c.Params, gin.Param{Key: "id", Value: "2"})
+				c.Request = httpRequest
+
+				mc := gomock.NewController(t)
+				mr := service.NewMockApplicationReviewService(mc)
+
+				mr.EXPECT().FilterApplications(uint(8), uint(2), "backend", 3).Return([]model.Application{model.Application{Model: gorm.Model{ID: 4}, UserID: 1, JobID: 2, Name: "asha", Age: "25", Stage: model.ApplicationStageApplied}}, nil)
+
+				return c, rr, mr
+			},
+			expectedStatusCode: http.StatusOK,
+			expectedResponse:   `[{"ID":4,"CreatedAt":"0001-01-01T00:00:00Z","UpdatedAt":"0001-01-01T00:00:00Z","DeletedAt":null,"user_id":1,"job_id":2,"name":"asha","age":"25","details":{"noticePeriod":0,"location":null,"technologyStack":null,"experience":0,"qualifications":null,"shifts":null,"jobtype":null,"skills":null},"accepted":false,"screening":{"accepted":false,"score":0,"max_score":0,"threshold":0,"knocked_out":false,"criteria":null},"screened_at":"0001-01-01T00:00:00Z","stage":"applied","stage_changed_at":"0001-01-01T00:00:00Z"}]`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gin.SetMode(gin.TestMode)
+			c, rr, mr := tt.setup()
+			h := Handler{
+				serviceReview: mr,
+			}
+			h.FilterApplications(c)
+			assert.Equal(t, tt.expectedStatusCode, rr.Code)
+			assert.Equal(t, tt.expectedResponse, rr.Body.String())
+		})
+	}
+}
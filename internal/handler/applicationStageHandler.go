@@ -0,0 +1,168 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"job-portal-api/internal/authentication"
+	"job-portal-api/internal/middleware"
+	"job-portal-api/internal/model"
+	"job-portal-api/internal/service"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/rs/zerolog/log"
+)
+
+func (h *Handler) ChangeApplicationStage(c *gin.Context) {
+	ctx := c.Request.Context()
+	traceId, ok := ctx.Value(middleware.TraceIDKey).(string)
+	if !ok {
+		log.Info().Msg("missing trace id")
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"error": http.StatusText(http.StatusInternalServerError)})
+		return
+	}
+
+	claims, ok := ctx.Value(authentication.AuthKey).(jwt.RegisteredClaims)
+	if !ok {
+		log.Info().Str("trace id : ", traceId).Msg("login first")
+		c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": http.StatusText(http.StatusUnauthorized)})
+		return
+	}
+
+	uID, err := strconv.ParseUint(claims.Subject, 10, 64)
+	if err != nil {
+		log.Error().Err(err).Str("trace id : ", traceId).Msg("error invalid user id in token")
+		c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": http.StatusText(http.StatusUnauthorized)})
+		return
+	}
+
+	aID, err := strconv.ParseUint(c.Param("id"), 10, 64)
+	if err != nil {
+		log.Error().Err(err).Str("trace id : ", traceId).Msg("error invalid application id")
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": http.StatusText(http.StatusBadRequest)})
+		return
+	}
+
+	var stageData model.ApplicationStageChange
+	err = json.NewDecoder(c.Request.Body).Decode(&stageData)
+	if err != nil {
+		log.Error().Err(err).Str("trace id : ", traceId).Msg("error in decoding")
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": http.StatusText(http.StatusBadRequest)})
+		return
+	}
+
+	validate := validator.New()
+	err = validate.Struct(stageData)
+	if err != nil {
+		log.Error().Err(err).Str("trace id : ", traceId).Msg("error in validating application stage")
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": http.StatusText(http.StatusBadRequest)})
+		return
+	}
+
+	application, err := h.serviceApplication.ChangeApplicationStage(uint(aID), uint(uID), stageData.Stage)
+	if errors.Is(err, service.ErrForbidden) {
+		log.Error().Err(err).Str("trace id : ", traceId).Msg("error user does not recruit for the job")
+		c.AbortWithStatusJSON(http.StatusForbidden, gin.H{"error": http.StatusText(http.StatusForbidden)})
+		return
+	}
+	if err != nil {
+		log.Error().Err(err).Str("trace id : ", traceId).Msg("error in changing application stage")
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": http.StatusText(http.StatusBadRequest)})
+		return
+	}
+
+	c.JSON(http.StatusOK, application)
+}
+
+func (h *Handler) ViewApplicationPipeline(c *gin.Context) {
+	ctx := c.Request.Context()
+	traceId, ok := ctx.Value(middleware.TraceIDKey).(string)
+	if !ok {
+		log.Info().Msg("missing trace id")
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"error": http.StatusText(http.StatusInternalServerError)})
+		return
+	}
+
+	claims, ok := ctx.Value(authentication.AuthKey).(jwt.RegisteredClaims)
+	if !ok {
+		log.Info().Str("trace id : ", traceId).Msg("login first")
+		c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": http.StatusText(http.StatusUnauthorized)})
+		return
+	}
+
+	uID, err := strconv.ParseUint(claims.Subject, 10, 64)
+	if err != nil {
+		log.Error().Err(err).Str("trace id : ", traceId).Msg("error invalid user id in token")
+		c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": http.StatusText(http.StatusUnauthorized)})
+		return
+	}
+
+	jID, err := strconv.ParseUint(c.Param("id"), 10, 64)
+	if err != nil {
+		log.Error().Err(err).Str("trace id : ", traceId).Msg("error invalid job id")
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": http.StatusText(http.StatusBadRequest)})
+		return
+	}
+
+	pipeline, err := h.serviceApplication.ViewApplicationPipeline(uint(uID), uint(jID))
+	if errors.Is(err, service.ErrForbidden) {
+		log.Error().Err(err).Str("trace id : ", traceId).Msg("error user does not recruit for the job")
+		c.AbortWithStatusJSON(http.StatusForbidden, gin.H{"error": http.StatusText(http.StatusForbidden)})
+		return
+	}
+	if err != nil {
+		log.Error().Err(err).Str("trace id : ", traceId).Msg("error in fetching application pipeline")
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": http.StatusText(http.StatusBadRequest)})
+		return
+	}
+
+	c.JSON(http.StatusOK, pipeline)
+}
+
+func (h *Handler) ViewApplicationStageHistory(c *gin.Context) {
+	ctx := c.Request.Context()
+	traceId, ok := ctx.Value(middleware.TraceIDKey).(string)
+	if !ok {
+		log.Info().Msg("missing trace id")
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"error": http.StatusText(http.StatusInternalServerError)})
+		return
+	}
+
+	claims, ok := ctx.Value(authentication.AuthKey).(jwt.RegisteredClaims)
+	if !ok {
+		log.Info().Str("trace id : ", traceId).Msg("login first")
+		c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": http.StatusText(http.StatusUnauthorized)})
+		return
+	}
+
+	uID, err := strconv.ParseUint(claims.Subject, 10, 64)
+	if err != nil {
+		log.Error().Err(err).Str("trace id : ", traceId).Msg("error invalid user id in token")
+		c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": http.StatusText(http.StatusUnauthorized)})
+		return
+	}
+
+	aID, err := strconv.ParseUint(c.Param("id"), 10, 64)
+	if err != nil {
+		log.Error().Err(err).Str("trace id : ", traceId).Msg("error invalid application id")
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": http.StatusText(http.StatusBadRequest)})
+		return
+	}
+
+	history, err := h.serviceApplication.ViewApplicationStageHistory(uint(uID), uint(aID))
+	if errors.Is(err, service.ErrForbidden) {
+		log.Error().Err(err).Str("trace id : ", traceId).Msg("error reading another user's application")
+		c.AbortWithStatusJSON(http.StatusForbidden, gin.H{"error": http.StatusText(http.StatusForbidden)})
+		return
+	}
+	if err != nil {
+		log.Error().Err(err).Str("trace id : ", traceId).Msg("error in fetching application stage history")
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": http.StatusText(http.StatusBadRequest)})
+		return
+	}
+
+	c.JSON(http.StatusOK, history)
+}
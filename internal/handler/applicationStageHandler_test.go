@@ -0,0 +1,524 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"job-portal-api/internal/authentication"
+	"job-portal-api/internal/middleware"
+	"job-portal-api/internal/model"
+	"job-portal-api/internal/service"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang-jwt/jwt/v5"
+	"go.uber.org/mock/gomock"
+	"gopkg.in/go-playground/assert.v1"
+	"gorm.io/gorm"
+)
+
+func TestHandler_ChangeApplicationStage(t *testing.T) {
+	tests := []struct {
+		name               string
+		setup              func() (*gin.Context, *httptest.ResponseRecorder, service.ApplicationService)
+		expectedStatusCode int
+		expectedResponse   string
+	}{
+		{
+			name: "missing trace id",
+			setup: func() (*gin.Context, *httptest.ResponseRecorder, service.ApplicationService) {
+				rr := httptest.NewRecorder()
+				c, _ := gin.CreateTestContext(rr)
+				httpRequest, _ := http.NewRequest(http.MethodPatch, "http://test.com", nil)
+				c.Request = httpRequest
+
+				return c, rr, nil
+			},
+			expectedStatusCode: http.StatusInternalServerError,
+			expectedResponse:   `{"error":"Internal Server Error"}`,
+		},
+		{
+			name: "missing jwt claims",
+			setup: func() (*gin.Context, *httptest.ResponseRecorder, service.ApplicationService) {
+				rr := httptest.NewRecorder()
+				c, _ := gin.CreateTestContext(rr)
+				httpRequest, _ := http.NewRequest(http.MethodPatch, "http://test.com", nil)
+				ctx := httpRequest.Context()
+				ctx = context.WithValue(ctx, middleware.TraceIDKey, "123")
+				httpRequest = httpRequest.WithContext(ctx)
+				c.Request = httpRequest
+
+				return c, rr, nil
+			},
+			expectedStatusCode: http.StatusUnauthorized,
+			expectedResponse:   `{"error":"Unauthorized"}`,
+		},
+		{
+			name: "invalid user id in token",
+			setup: func() (*gin.Context, *httptest.ResponseRecorder, service.ApplicationService) {
+				rr := httptest.NewRecorder()
+				c, _ := gin.CreateTestContext(rr)
+				httpRequest, _ := http.NewRequest(http.MethodPatch, "http://test.com", strings.NewReader(`{"stage":"screening"}`))
+				ctx := httpRequest.Context()
+				ctx = context.WithValue(ctx, middleware.TraceIDKey, "123")
+				ctx = context.WithValue(ctx, authentication.AuthKey, jwt.RegisteredClaims{})
+				httpRequest = httpRequest.WithContext(ctx)
+				c.Params = append(c.Params, gin.Param{Key: "id", Value: "1"})
+				c.Request = httpRequest
+
+				return c, rr, nil
+			},
+			expectedStatusCode: http.StatusUnauthorized,
+			expectedResponse:   `{"error":"Unauthorized"}`,
+		},
+		{
+			name: "invalid application id",
+			setup: func() (*gin.Context, *httptest.ResponseRecorder, service.ApplicationService) {
+				rr := httptest.NewRecorder()
+				c, _ := gin.CreateTestContext(rr)
+				httpRequest, _ := http.NewRequest(http.MethodPatch, "http://test.com", strings.NewReader(`{"stage":"screening"}`))
+				ctx := httpRequest.Context()
+				ctx = context.WithValue(ctx, middleware.TraceIDKey, "123")
+				ctx = context.WithValue(ctx, authentication.AuthKey, jwt.RegisteredClaims{Subject: "7"})
+				httpRequest = httpRequest.WithContext(ctx)
+				c.Params = append(c.Params, gin.Param{Key: "id", Value: "abc"})
+				c.Request = httpRequest
+
+				return c, rr, nil
+			},
+			expectedStatusCode: http.StatusBadRequest,
+			expectedResponse:   `{"error":"Bad Request"}`,
+		},
+		{
+			name: "invalid body",
+			setup: func() (*gin.Context, *httptest.ResponseRecorder, service.ApplicationService) {
+				rr := httptest.NewRecorder()
+				c, _ := gin.CreateTestContext(rr)
+				httpRequest, _ := http.NewRequest(http.MethodPatch, "http://test.com", strings.NewReader(`{"stage":`))
+				ctx := httpRequest.Context()
+				ctx = context.WithValue(ctx, middleware.TraceIDKey, "123")
+				ctx = context.WithValue(ctx, authentication.AuthKey, jwt.RegisteredClaims{Subject: "7"})
+				httpRequest = httpRequest.WithContext(ctx)
+				c.Params = append(c.Params, gin.Param{Key: "id", Value: "1"})
+				c.Request = httpRequest
+
+				return c, rr, nil
+			},
+			expectedStatusCode: http.StatusBadRequest,
+			expectedResponse:   `{"error":"Bad Request"}`,
+		},
+		{
+			name: "unknown stage",
+			setup: func() (*gin.Context, *httptest.ResponseRecorder, service.ApplicationService) {
+				rr := httptest.NewRecorder()
+				c, _ := gin.CreateTestContext(rr)
+				httpRequest, _ := http.NewRequest(http.MethodPatch, "http://test.com", strings.NewReader(`{"stage":"onboarding"}`))
+				ctx := httpRequest.Context()
+				ctx = context.WithValue(ctx, middleware.TraceIDKey, "123")
+				ctx = context.WithValue(ctx, authentication.AuthKey, jwt.RegisteredClaims{Subject: "7"})
+				httpRequest = httpRequest.WithContext(ctx)
+				c.Params = append(c.Params, gin.Param{Key: "id", Value: "1"})
+				c.Request = httpRequest
+
+				return c, rr, nil
+			},
+			expectedStatusCode: http.StatusBadRequest,
+			expectedResponse:   `{"error":"Bad Request"}`,
+		},
+		{
+			name: "not the company owner",
+			setup: func() (*gin.Context, *httptest.ResponseRecorder, service.ApplicationService) {
+				rr := httptest.NewRecorder()
+				c, _ := gin.CreateTestContext(rr)
+				httpRequest, _ := http.NewRequest(http.MethodPatch, "http://test.com", strings.NewReader(`{"stage":"hired"}`))
+				ctx := httpRequest.Context()
+				ctx = context.WithValue(ctx, middleware.TraceIDKey, "123")
+				ctx = context.WithValue(ctx, authentication.AuthKey, jwt.RegisteredClaims{Subject: "7"})
+				httpRequest = httpRequest.WithContext(ctx)
+				c.Params = append(c.Params, gin.Param{Key: "id", Value: "1"})
+				c.Request = httpRequest
+
+				mc := gomock.NewController(t)
+				ma := service.NewMockApplicationService(mc)
+
+				ma.EXPECT().ChangeApplicationStage(uint(1), uint(7), "hired").Return(model.Application{}, service.ErrForbidden)
+
+				return c, rr, ma
+			},
+			expectedStatusCode: http.StatusForbidden,
+			expectedResponse:   `{"error":"Forbidden"}`,
+		},
+		{
+			name: "failure",
+			setup: func() (*gin.Context, *httptest.ResponseRecorder, service.ApplicationService) {
+				rr := httptest.NewRecorder()
+				c, _ := gin.CreateTestContext(rr)
+				httpRequest, _ := http.NewRequest(http.MethodPatch, "http://test.com", strings.NewReader(`{"stage":"hired"}`))
+				ctx := httpRequest.Context()
+				ctx = context.WithValue(ctx, middleware.TraceIDKey, "123")
+				ctx = context.WithValue(ctx, authentication.AuthKey, jwt.RegisteredClaims{Subject: "7"})
+				httpRequest = httpRequest.WithContext(ctx)
+				c.Params = append(c.Params, gin.Param{Key: "id", Value: "1"})
+				c.Request = httpRequest
+
+				mc := gomock.NewController(t)
+				ma := service.NewMockApplicationService(mc)
+
+				ma.EXPECT().ChangeApplicationStage(uint(1), uint(7), "hired").Return(model.Application{}, errors.New("error"))
+
+				return c, rr, ma
+			},
+			expectedStatusCode: http.StatusBadRequest,
+			expectedResponse:   `{"error":"Bad Request"}`,
+		},
+		{
+			name: "success",
+			setup: func() (*gin.Context, *httptest.ResponseRecorder, service.ApplicationService) {
+				rr := httptest.NewRecorder()
+				c, _ := gin.CreateTestContext(rr)
+				httpRequest, _ := http.NewRequest(http.MethodPatch, "http://test.com", strings.NewReader(`{"stage":"screening"}`))
+				ctx := httpRequest.Context()
+				ctx = context.WithValue(ctx, middleware.TraceIDKey, "123")
+				ctx = context.WithValue(ctx, authentication.AuthKey, jwt.RegisteredClaims{Subject: "7"})
+				httpRequest = httpRequest.WithContext(ctx)
+				c.Params = append(c.Params, gin.Param{Key: "id", Value: "1"})
+				c.Request = httpRequest
+
+				mc := gomock.NewController(t)
+				ma := service.NewMockApplicationService(mc)
+
+				ma.EXPECT().ChangeApplicationStage(uint(1), uint(7), "screening").Return(model.Application{Model: gorm.Model{ID: 1}, JobID: 2, Stage: model.ApplicationStageScreening}, nil)
+
+				return c, rr, ma
+			},
+			expectedStatusCode: http.StatusOK,
+			expectedResponse:   `{"ID":1,"CreatedAt":"0001-01-01T00:00:00Z","UpdatedAt":"0001-01-01T00:00:00Z","DeletedAt":null,"user_id":0,"job_id":2,"name":"","age":"","details":{"noticePeriod":0,"location":null,"technologyStack":null,"experience":0,"qualifications":null,"shifts":null,"jobtype":null,"skills":null},"accepted":false,"screening":{"accepted":false,"score":0,"max_score":0,"threshold":0,"knocked_out":false,"criteria":null},"screened_at":"0001-01-01T00:00:00Z","stage":"screening","stage_changed_at":"0001-01-01T00:00:00Z"}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gin.SetMode(gin.TestMode)
+			c, rr, ma := tt.setup()
+			h := Handler{
+				serviceApplication: ma,
+			}
+			h.ChangeApplicationStage(c)
+			assert.Equal(t, tt.expectedStatusCode, rr.Code)
+			assert.Equal(t, tt.expectedResponse, rr.Body.String())
+		})
+	}
+}
+
+func TestHandler_ViewApplicationPipeline(t *testing.T) {
+	tests := []struct {
+		name               string
+		setup              func() (*gin.Context, *httptest.ResponseRecorder, service.ApplicationService)
+		expectedStatusCode int
+		expectedResponse   string
+	}{
+		{
+			name: "missing trace id",
+			setup: func() (*gin.Context, *httptest.ResponseRecorder, service.ApplicationService) {
+				rr := httptest.NewRecorder()
+				c, _ := gin.CreateTestContext(rr)
+				httpRequest, _ := http.NewRequest(http.MethodGet, "http://test.com", nil)
+				c.Request = httpRequest
+
+				return c, rr, nil
+			},
+			expectedStatusCode: http.StatusInternalServerError,
+			expectedResponse:   `{"error":"Internal Server Error"}`,
+		},
+		{
+			name: "missing jwt claims",
+			setup: func() (*gin.Context, *httptest.ResponseRecorder, service.ApplicationService) {
+				rr := httptest.NewRecorder()
+				c, _ := gin.CreateTestContext(rr)
+				httpRequest, _ := http.NewRequest(http.MethodGet, "http://test.com", nil)
+				ctx := httpRequest.Context()
+				ctx = context.WithValue(ctx, middleware.TraceIDKey, "123")
+				httpRequest = httpRequest.WithContext(ctx)
+				c.Request = httpRequest
+
+				return c, rr, nil
+			},
+			expectedStatusCode: http.StatusUnauthorized,
+			expectedResponse:   `{"error":"Unauthorized"}`,
+		},
+		{
+			name: "invalid user id in token",
+			setup: func() (*gin.Context, *httptest.ResponseRecorder, service.ApplicationService) {
+				rr := httptest.NewRecorder()
+				c, _ := gin.CreateTestContext(rr)
+				httpRequest, _ := http.NewRequest(http.MethodGet, "http://test.com", nil)
+				ctx := httpRequest.Context()
+				ctx = context.WithValue(ctx, middleware.TraceIDKey, "123")
+				ctx = context.WithValue(ctx, authentication.AuthKey, jwt.RegisteredClaims{Subject: "admin"})
+				httpRequest = httpRequest.WithContext(ctx)
+				c.Params = append(c.Params, gin.Param{Key: "id", Value: "2"})
+				c.Request = httpRequest
+
+				return c, rr, nil
+			},
+			expectedStatusCode: http.StatusUnauthorized,
+			expectedResponse:   `{"error":"Unauthorized"}`,
+		},
+		{
+			name: "invalid job id",
+			setup: func() (*gin.Context, *httptest.ResponseRecorder, service.ApplicationService) {
+				rr := httptest.NewRecorder()
+				c, _ := gin.CreateTestContext(rr)
+				httpRequest, _ := http.NewRequest(http.MethodGet, "http://test.com", nil)
+				ctx := httpRequest.Context()
+				ctx = context.WithValue(ctx, middleware.TraceIDKey, "123")
+				ctx = context.WithValue(ctx, authentication.AuthKey, jwt.RegisteredClaims{Subject: "8"})
+				httpRequest = httpRequest.WithContext(ctx)
+				c.Params = append(c.Params, gin.Param{Key: "id", Value: "abc"})
+				c.Request = httpRequest
+
+				return c, rr, nil
+			},
+			expectedStatusCode: http.StatusBadRequest,
+			expectedResponse:   `{"error":"Bad Request"}`,
+		},
+		{
+			name: "not the company owner",
+			setup: func() (*gin.Context, *httptest.ResponseRecorder, service.ApplicationService) {
+				rr := httptest.NewRecorder()
+				c, _ := gin.CreateTestContext(rr)
+				httpRequest, _ := http.NewRequest(http.MethodGet, "http://test.com", nil)
+				ctx := httpRequest.Context()
+				ctx = context.WithValue(ctx, middleware.TraceIDKey, "123")
+				ctx = context.WithValue(ctx, authentication.AuthKey, jwt.RegisteredClaims{Subject: "8"})
+				httpRequest = httpRequest.WithContext(ctx)
+				c.Params = append(c.Params, gin.Param{Key: "id", Value: "2"})
+				c.Request = httpRequest
+
+				mc := gomock.NewController(t)
+				ma := service.NewMockApplicationService(mc)
+
+				ma.EXPECT().ViewApplicationPipeline(uint(8), uint(2)).Return(model.ApplicationPipeline{}, service.ErrForbidden)
+
+				return c, rr, ma
+			},
+			expectedStatusCode: http.StatusForbidden,
+			expectedResponse:   `{"error":"Forbidden"}`,
+		},
+		{
+			name: "failure",
+			setup: func() (*gin.Context, *httptest.ResponseRecorder, service.ApplicationService) {
+				rr := httptest.NewRecorder()
+				c, _ := gin.CreateTestContext(rr)
+				httpRequest, _ := http.NewRequest(http.MethodGet, "http://test.com", nil)
+				ctx := httpRequest.Context()
+				ctx = context.WithValue(ctx, middleware.TraceIDKey, "123")
+				ctx = context.WithValue(ctx, authentication.AuthKey, jwt.RegisteredClaims{Subject: "8"})
+				httpRequest = httpRequest.WithContext(ctx)
+				c.Params = append(c.Params, gin.Param{Key: "id", Value: "2"})
+				c.Request = httpRequest
+
+				mc := gomock.NewController(t)
+				ma := service.NewMockApplicationService(mc)
+
+				ma.EXPECT().ViewApplicationPipeline(uint(8), uint(2)).Return(model.ApplicationPipeline{}, errors.New("error"))
+
+				return c, rr, ma
+			},
+			expectedStatusCode: http.StatusBadRequest,
+			expectedResponse:   `{"error":"Bad Request"}`,
+		},
+		{
+			name: "success",
+			setup: func() (*gin.Context, *httptest.ResponseRecorder, service.ApplicationService) {
+				rr := httptest.NewRecorder()
+				c, _ := gin.CreateTestContext(rr)
+				httpRequest, _ := http.NewRequest(http.MethodGet, "http://test.com", nil)
+				ctx := httpRequest.Context()
+				ctx = context.WithValue(ctx, middleware.TraceIDKey, "123")
+				ctx = context.WithValue(ctx, authentication.AuthKey, jwt.RegisteredClaims{Subject: "8"})
+				httpRequest = httpRequest.WithContext(ctx)
+				c.Params = append(c.Params, gin.Param{Key: "id", Value: "2"})
+				c.Request = httpRequest
+
+				mc := gomock.NewController(t)
+				ma := service.NewMockApplicationService(mc)
+
+				ma.EXPECT().ViewApplicationPipeline(uint(8), uint(2)).Return(model.ApplicationPipeline{JobID: 2, Stages: []model.ApplicationStageGroup{{Stage: model.ApplicationStageApplied, Applications: []model.Application{}}}}, nil)
+
+				return c, rr, ma
+			},
+			expectedStatusCode: http.StatusOK,
+			expectedResponse:   `{"job_id":2,"stages":[{"stage":"applied","applications":[]}]}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gin.SetMode(gin.TestMode)
+			c, rr, ma := tt.setup()
+			h := Handler{
+				serviceApplication: ma,
+			}
+			h.ViewApplicationPipeline(c)
+			assert.Equal(t, tt.expectedStatusCode, rr.Code)
+			assert.Equal(t, tt.expectedResponse, rr.Body.String())
+		})
+	}
+}
+
+func TestHandler_ViewApplicationStageHistory(t *testing.T) {
+	tests := []struct {
+		name               string
+		setup              func() (*gin.Context, *httptest.ResponseRecorder, service.ApplicationService)
+		expectedStatusCode int
+		expectedResponse   string
+	}{
+		{
+			name: "missing trace id",
+			setup: func() (*gin.Context, *httptest.ResponseRecorder, service.ApplicationService) {
+				rr := httptest.NewRecorder()
+				c, _ := gin.CreateTestContext(rr)
+				httpRequest, _ := http.NewRequest(http.MethodGet, "http://test.com", nil)
+				c.Request = httpRequest
+
+				return c, rr, nil
+			},
+			expectedStatusCode: http.StatusInternalServerError,
+			expectedResponse:   `{"error":"Internal Server Error"}`,
+		},
+		{
+			name: "missing jwt claims",
+			setup: func() (*gin.Context, *httptest.ResponseRecorder, service.ApplicationService) {
+				rr := httptest.NewRecorder()
+				c, _ := gin.CreateTestContext(rr)
+				httpRequest, _ := http.NewRequest(http.MethodGet, "http://test.com", nil)
+				ctx := httpRequest.Context()
+				ctx = context.WithValue(ctx, middleware.TraceIDKey, "123")
+				httpRequest = httpRequest.WithContext(ctx)
+				c.Request = httpRequest
+
+				return c, rr, nil
+			},
+			expectedStatusCode: http.StatusUnauthorized,
+			expectedResponse:   `{"error":"Unauthorized"}`,
+		},
+		{
+			name: "invalid user id in token",
+			setup: func() (*gin.Context, *httptest.ResponseRecorder, service.ApplicationService) {
+				rr := httptest.NewRecorder()
+				c, _ := gin.CreateTestContext(rr)
+				httpRequest, _ := http.NewRequest(http.MethodGet, "http://test.com", nil)
+				ctx := httpRequest.Context()
+				ctx = context.WithValue(ctx, middleware.TraceIDKey, "123")
+				ctx = context.WithValue(ctx, authentication.AuthKey, jwt.RegisteredClaims{Subject: "admin"})
+				httpRequest = httpRequest.WithContext(ctx)
+				c.Params = append(c.Params, gin.Param{Key: "id", Value: "1"})
+				c.Request = httpRequest
+
+				return c, rr, nil
+			},
+			expectedStatusCode: http.StatusUnauthorized,
+			expectedResponse:   `{"error":"Unauthorized"}`,
+		},
+		{
+			name: "invalid application id",
+			setup: func() (*gin.Context, *httptest.ResponseRecorder, service.ApplicationService) {
+				rr := httptest.NewRecorder()
+				c, _ := gin.CreateTestContext(rr)
+				httpRequest, _ := http.NewRequest(http.MethodGet, "http://test.com", nil)
+				ctx := httpRequest.Context()
+				ctx = context.WithValue(ctx, middleware.TraceIDKey, "123")
+				ctx = context.WithValue(ctx, authentication.AuthKey, jwt.RegisteredClaims{Subject: "3"})
+				httpRequest = httpRequest.WithContext(ctx)
+				c.Params = append(c.Params, gin.Param{Key: "id", Value: "abc"})
+				c.Request = httpRequest
+
+				return c, rr, nil
+			},
+			expectedStatusCode: http.StatusBadRequest,
+			expectedResponse:   `{"error":"Bad Request"}`,
+		},
+		{
+			name: "another user's application",
+			setup: func() (*gin.Context, *httptest.ResponseRecorder, service.ApplicationService) {
+				rr := httptest.NewRecorder()
+				c, _ := gin.CreateTestContext(rr)
+				httpRequest, _ := http.NewRequest(http.MethodGet, "http://test.com", nil)
+				ctx := httpRequest.Context()
+				ctx = context.WithValue(ctx, middleware.TraceIDKey, "123")
+				ctx = context.WithValue(ctx, authentication.AuthKey, jwt.RegisteredClaims{Subject: "3"})
+				httpRequest = httpRequest.WithContext(ctx)
+				c.Params = append(c.Params, gin.Param{Key: "id", Value: "1"})
+				c.Request = httpRequest
+
+				mc := gomock.NewController(t)
+				ma := service.NewMockApplicationService(mc)
+
+				ma.EXPECT().ViewApplicationStageHistory(uint(3), uint(1)).Return(nil, service.ErrForbidden)
+
+				return c, rr, ma
+			},
+			expectedStatusCode: http.StatusForbidden,
+			expectedResponse:   `{"error":"Forbidden"}`,
+		},
+		{
+			name: "failure",
+			setup: func() (*gin.Context, *httptest.ResponseRecorder, service.ApplicationService) {
+				rr := httptest.NewRecorder()
+				c, _ := gin.CreateTestContext(rr)
+				httpRequest, _ := http.NewRequest(http.MethodGet, "http://test.com", nil)
+				ctx := httpRequest.Context()
+				ctx = context.WithValue(ctx, middleware.TraceIDKey, "123")
+				ctx = context.WithValue(ctx, authentication.AuthKey, jwt.RegisteredClaims{Subject: "3"})
+				httpRequest = httpRequest.WithContext(ctx)
+				c.Params = append(c.Params, gin.Param{Key: "id", Value: "1"})
+				c.Request = httpRequest
+
+				mc := gomock.NewController(t)
+				ma := service.NewMockApplicationService(mc)
+
+				ma.EXPECT().ViewApplicationStageHistory(uint(3), uint(1)).Return(nil, errors.New("error"))
+
+				return c, rr, ma
+			},
+			expectedStatusCode: http.StatusBadRequest,
+			expectedResponse:   `{"error":"Bad Request"}`,
+		},
+		{
+			name: "success",
+			setup: func() (*gin.Context, *httptest.ResponseRecorder, service.ApplicationService) {
+				rr := httptest.NewRecorder()
+				c, _ := gin.CreateTestContext(rr)
+				httpRequest, _ := http.NewRequest(http.MethodGet, "http://test.com", nil)
+				ctx := httpRequest.Context()
+				ctx = context.WithValue(ctx, middleware.TraceIDKey, "123")
+				ctx = context.WithValue(ctx, authentication.AuthKey, jwt.RegisteredClaims{Subject: "3"})
+				httpRequest = httpRequest.WithContext(ctx)
+				c.Params = append(c.Params, gin.Param{Key: "id", Value: "1"})
+				c.Request = httpRequest
+
+				mc := gomock.NewController(t)
+				ma := service.NewMockApplicationService(mc)
+
+				ma.EXPECT().ViewApplicationStageHistory(uint(3), uint(1)).Return([]model.ApplicationStageHistory{{ID: 3, ApplicationID: 1, From: "applied", To: "screening", ChangedBy: 7}}, nil)
+
+				return c, rr, ma
+			},
+			expectedStatusCode: http.StatusOK,
+			expectedResponse:   `[{"id":3,"application_id":1,"from":"applied","to":"screening","changed_by":7,"changed_at":"0001-01-01T00:00:00Z"}]`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gin.SetMode(gin.TestMode)
+			c, rr, ma := tt.setup()
+			h := Handler{
+				serviceApplication: ma,
+			}
+			h.ViewApplicationStageHistory(c)
+			assert.Equal(t, tt.expectedStatusCode, rr.Code)
+			assert.Equal(t, tt.expectedResponse, rr.Body.String())
+		})
+	}
+}
@@ -0,0 +1,211 @@
+package handler
+
+import (
+	"errors"
+	"job-portal-api/internal/authentication"
+	"job-portal-api/internal/middleware"
+	"job-portal-api/internal/service"
+	"mime"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/rs/zerolog/log"
+)
+
+// maxUploadRequestSize bounds the whole multipart request, the attachment
+// size itself is checked by the service.
+const maxUploadRequestSize = 32 << 20
+
+type AttachmentHandler interface {
+	UploadAttachment(c *gin.Context)
+	ViewAttachments(c *gin.Context)
+	DownloadAttachment(c *gin.Context)
+}
+
+func NewAttachmentHandler(serviceAttachment service.AttachmentService) (AttachmentHandler, error) {
+	if serviceAttachment == nil {
+		log.Info().Msg("attachment service cannot be nil")
+		return nil, errors.New("attachment service cannot be nil")
+	}
+	return &Handler{
+		serviceAttachment: serviceAttachment,
+	}, nil
+}
+
+// attachmentErrorStatus maps the access and upload errors of the
+// attachment and resume services, anything else is a bad request.
+func attachmentErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, service.ErrForbidden):
+		return http.StatusForbidden
+	case errors.Is(err, service.ErrAttachmentTooLarge):
+		return http.StatusRequestEntityTooLarge
+	case errors.Is(err, service.ErrAttachmentUnsupported):
+		return http.StatusUnsupportedMediaType
+	case errors.Is(err, service.ErrResumeUnreadable):
+		return http.StatusUnprocessableEntity
+	}
+	return http.StatusBadRequest
+}
+
+// UploadAttachment takes a multipart form with the file in "file" and its
+// kind, resume, cover_letter or other, in "kind".
+func (h *Handler) UploadAttachment(c *gin.Context) {
+	ctx := c.Request.Context()
+	traceId, ok := ctx.Value(middleware.TraceIDKey).(string)
+	if !ok {
+		log.Info().Msg("missing trace id")
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"error": http.StatusText(http.StatusInternalServerError)})
+		return
+	}
+
+	claims, ok := ctx.Value(authentication.AuthKey).(jwt.RegisteredClaims)
+	if !ok {
+		log.Info().Str("trace id : ", traceId).Msg("login first")
+		c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": http.StatusText(http.StatusUnauthorized)})
+		return
+	}
+
+	uID, err := strconv.ParseUint(claims.Subject, 10, 64)
+	if err != nil {
+		log.Error().Err(err).Str("trace id : ", traceId).Msg("error invalid user id in token")
+		c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": http.StatusText(http.StatusUnauthorized)})
+		return
+	}
+
+	aID, err := strconv.ParseUint(c.Param("id"), 10, 64)
+	if err != nil {
+		log.Error().Err(err).Str("trace id : ", traceId).Msg("error invalid application id")
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": http.StatusText(http.StatusBadRequest)})
+		return
+	}
+
+	c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, maxUploadRequestSize)
+	kind := c.PostForm("kind")
+	err = validator.New().Var(kind, "required,oneof=resume cover_letter other")
+	if err != nil {
+		log.Error().Err(err).Str("trace id : ", traceId).Msg("error invalid attachment kind")
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": http.StatusText(http.StatusBadRequest)})
+		return
+	}
+
+	fileHeader, err := c.FormFile("file")
+	var maxBytesErr *http.MaxBytesError
+	if errors.As(err, &maxBytesErr) {
+		log.Error().Err(err).Str("trace id : ", traceId).Msg("error upload request too large")
+		c.AbortWithStatusJSON(http.StatusRequestEntityTooLarge, gin.H{"error": http.StatusText(http.StatusRequestEntityTooLarge)})
+		return
+	}
+	if err != nil {
+		log.Error().Err(err).Str("trace id : ", traceId).Msg("error in reading uploaded file")
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": http.StatusText(http.StatusBadRequest)})
+		return
+	}
+
+	file, err := fileHeader.Open()
+	if err != nil {
+		log.Error().Err(err).Str("trace id : ", traceId).Msg("error in opening uploaded file")
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": http.StatusText(http.StatusBadRequest)})
+		return
+	}
+	defer file.Close()
+
+	attachment, err := h.serviceAttachment.UploadAttachment(ctx, uint(uID), uint(aID), kind, fileHeader.Filename, fileHeader.Size, file)
+	if err != nil {
+		log.Error().Err(err).Str("trace id : ", traceId).Msg("error in uploading attachment")
+		status := attachmentErrorStatus(err)
+		c.AbortWithStatusJSON(status, gin.H{"error": http.StatusText(status)})
+		return
+	}
+
+	c.JSON(http.StatusOK, attachment)
+}
+
+func (h *Handler) ViewAttachments(c *gin.Context) {
+	ctx := c.Request.Context()
+	traceId, ok := ctx.Value(middleware.TraceIDKey).(string)
+	if !ok {
+		log.Info().Msg("missing trace id")
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"error": http.StatusText(http.StatusInternalServerError)})
+		return
+	}
+
+	claims, ok := ctx.Value(authentication.AuthKey).(jwt.RegisteredClaims)
+	if !ok {
+		log.Info().Str("trace id : ", traceId).Msg("login first")
+		c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": http.StatusText(http.StatusUnauthorized)})
+		return
+	}
+
+	uID, err := strconv.ParseUint(claims.Subject, 10, 64)
+	if err != nil {
+		log.Error().Err(err).Str("trace id : ", traceId).Msg("error invalid user id in token")
+		c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": http.StatusText(http.StatusUnauthorized)})
+		return
+	}
+
+	aID, err := strconv.ParseUint(c.Param("id"), 10, 64)
+	if err != nil {
+		log.Error().Err(err).Str("trace id : ", traceId).Msg("error invalid application id")
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": http.StatusText(http.StatusBadRequest)})
+		return
+	}
+
+	attachments, err := h.serviceAttachment.ViewAttachments(uint(uID), uint(aID))
+	if err != nil {
+		log.Error().Err(err).Str("trace id : ", traceId).Msg("error in fetching attachments")
+		status := attachmentErrorStatus(err)
+		c.AbortWithStatusJSON(status, gin.H{"error": http.StatusText(status)})
+		return
+	}
+
+	c.JSON(http.StatusOK, attachments)
+}
+
+func (h *Handler) DownloadAttachment(c *gin.Context) {
+	ctx := c.Request.Context()
+	traceId, ok := ctx.Value(middleware.TraceIDKey).(string)
+	if !ok {
+		log.Info().Msg("missing trace id")
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"error": http.StatusText(http.StatusInternalServerError)})
+		return
+	}
+
+	claims, ok := ctx.Value(authentication.AuthKey).(jwt.RegisteredClaims)
+	if !ok {
+		log.Info().Str("trace id : ", traceId).Msg("login first")
+		c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": http.StatusText(http.StatusUnauthorized)})
+		return
+	}
+
+	uID, err := strconv.ParseUint(claims.Subject, 10, 64)
+	if err != nil {
+		log.Error().Err(err).Str("trace id : ", traceId).Msg("error invalid user id in token")
+		c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": http.StatusText(http.StatusUnauthorized)})
+		return
+	}
+
+	atID, err := strconv.ParseUint(c.Param("id"), 10, 64)
+	if err != nil {
+		log.Error().Err(err).Str("trace id : ", traceId).Msg("error invalid attachment id")
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": http.StatusText(http.StatusBadRequest)})
+		return
+	}
+
+	attachment, data, err := h.serviceAttachment.DownloadAttachment(ctx, uint(uID), uint(atID))
+	if err != nil {
+		log.Error().Err(err).Str("trace id : ", traceId).Msg("error in downloading attachment")
+		status := attachmentErrorStatus(err)
+		c.AbortWithStatusJSON(status, gin.H{"error": http.StatusText(status)})
+		return
+	}
+	defer data.Close()
+
+	c.DataFromReader(http.StatusOK, attachment.Size, attachment.ContentType, data, map[string]string{
+		"Content-Disposition":    mime.FormatMediaType("attachment", map[string]string{"filename": attachment.FileName}),
+		"X-Content-Type-Options": "nosniff",
+	})
+}
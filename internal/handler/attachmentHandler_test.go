@@ -0,0 +1,550 @@
+package handler
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"job-portal-api/internal/authentication"
+	"job-portal-api/internal/middleware"
+	"job-portal-api/internal/model"
+	"job-portal-api/internal/service"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang-jwt/jwt/v5"
+	"go.uber.org/mock/gomock"
+	"gopkg.in/go-playground/assert.v1"
+)
+
+// uploadRequest builds a multipart upload with the kind field and, when
+// fileName is set, one file.
+func uploadRequest(kind string, fileName string, content string) (*http.Request, error) {
+	body := new(bytes.Buffer)
+	w := multipart.NewWriter(body)
+	w.WriteField("kind", kind)
+	if fileName != "" {
+		part, err := w.CreateFormFile("file", fileName)
+		if err != nil {
+			return nil, err
+		}
+		io.WriteString(part, content)
+	}
+	w.Close()
+
+	httpRequest, err := http.NewRequest(http.MethodPost, "http://test.com", body)
+	if err != nil {
+		return nil, err
+	}
+	httpRequest.Header.Set("Content-Type", w.FormDataContentType())
+	return httpRequest, nil
+}
+
+func TestHandler_UploadAttachment(t *testing.T) {
+	tests := []struct {
+		name               string
+		setup              func() (*gin.Context, *httptest.ResponseRecorder, service.AttachmentService)
+		expectedStatusCode int
+		expectedResponse   string
+	}{
+		{
+			name: "missing trace id",
+			setup: func() (*gin.Context, *httptest.ResponseRecorder, service.AttachmentService) {
+				rr := httptest.NewRecorder()
+				c, _ := gin.CreateTestContext(rr)
+				httpRequest, _ := http.NewRequest(http.MethodPost, "http://test.com", nil)
+				c.Request = httpRequest
+
+				return c, rr, nil
+			},
+			expectedStatusCode: http.StatusInternalServerError,
+			expectedResponse:   `{"error":"Internal Server Error"}`,
+		},
+		{
+			name: "missing jwt claims",
+			setup: func() (*gin.Context, *httptest.ResponseRecorder, service.AttachmentService) {
+				rr := httptest.NewRecorder()
+				c, _ := gin.CreateTestContext(rr)
+				httpRequest, _ := http.NewRequest(http.MethodPost, "http://test.com", nil)
+				ctx := httpRequest.Context()
+				ctx = context.WithValue(ctx, middleware.TraceIDKey, "123")
+				httpRequest = httpRequest.WithContext(ctx)
+				c.Request = httpRequest
+
+				return c, rr, nil
+			},
+			expectedStatusCode: http.StatusUnauthorized,
+			expectedResponse:   `{"error":"Unauthorized"}`,
+		},
+		{
+			name: "invalid user id in token",
+			setup: func() (*gin.Context, *httptest.ResponseRecorder, service.AttachmentService) {
+				rr := httptest.NewRecorder()
+				c, _ := gin.CreateTestContext(rr)
+				httpRequest, _ := uploadRequest("resume", "resume.pdf", "%PDF-1.4")
+				ctx := httpRequest.Context()
+				ctx = context.WithValue(ctx, middleware.TraceIDKey, "123")
+				ctx = context.WithValue(ctx, authentication.AuthKey, jwt.RegisteredClaims{Subject: "admin"})
+				httpRequest = httpRequest.WithContext(ctx)
+				c.Params = append(c.Params, gin.Param{Key: "id", Value: "3"})
+				c.Request = httpRequest
+
+				return c, rr, nil
+			},
+			expectedStatusCode: http.StatusUnauthorized,
+			expectedResponse:   `{"error":"Unauthorized"}`,
+		},
+		{
+			name: "invalid application id",
+			setup: func() (*gin.Context, *httptest.ResponseRecorder, service.AttachmentService) {
+				rr := httptest.NewRecorder()
+				c, _ := gin.CreateTestContext(rr)
+				httpRequest, _ := uploadRequest("resume", "resume.pdf", "%PDF-1.4")
+				ctx := httpRequest.Context()
+				ctx = context.WithValue(ctx, middleware.TraceIDKey, "123")
+				ctx = context.WithValue(ctx, authentication.AuthKey, jwt.RegisteredClaims{Subject: "1"})
+				httpRequest = httpRequest.WithContext(ctx)
+				c.Params = append(c.Params, gin.Param{Key: "id", Value: "abc"})
+				c.Request = httpRequest
+
+				return c, rr, nil
+			},
+			expectedStatusCode: http.StatusBadRequest,
+			expectedResponse:   `{"error":"Bad Request"}`,
+		},
+		{
+			name: "invalid kind",
+			setup: func() (*gin.Context, *httptest.ResponseRecorder, service.AttachmentService) {
+				rr := httptest.NewRecorder()
+				c, _ := gin.CreateTestContext(rr)
+				httpRequest, _ := uploadRequest("photo", "resume.pdf", "%PDF-1.4")
+				ctx := httpRequest.Context()
+				ctx = context.WithValue(ctx, middleware.TraceIDKey, "123")
+				ctx = context.WithValue(ctx, authentication.AuthKey, jwt.RegisteredClaims{Subject: "1"})
+				httpRequest = httpRequest.WithContext(ctx)
+				c.Params = append(c.Params, gin.Param{Key: "id", Value: "3"})
+				c.Request = httpRequest
+
+				return c, rr, nil
+			},
+			expectedStatusCode: http.StatusBadRequest,
+			expectedResponse:   `{"error":"Bad Request"}`,
+		},
+		{
+			name: "missing file",
+			setup: func() (*gin.Context, *httptest.ResponseRecorder, service.AttachmentService) {
+				rr := httptest.NewRecorder()
+				c, _ := gin.CreateTestContext(rr)
+				httpRequest, _ := uploadRequest("resume", "", "")
+				ctx := httpRequest.Context()
+				ctx = context.WithValue(ctx, middleware.TraceIDKey, "123")
+				ctx = context.WithValue(ctx, authentication.AuthKey, jwt.RegisteredClaims{Subject: "1"})
+				httpRequest = httpRequest.WithContext(ctx)
+				c.Params = append(c.Params, gin.Param{Key: "id", Value: "3"})
+				c.Request = httpRequest
+
+				return c, rr, nil
+			},
+			expectedStatusCode: http.StatusBadRequest,
+			expectedResponse:   `{"error":"Bad Request"}`,
+		},
+		{
+			name: "not the candidate",
+			setup: func() (*gin.Context, *httptest.ResponseRecorder, service.AttachmentService) {
+				rr := httptest.NewRecorder()
+				c, _ := gin.CreateTestContext(rr)
+				httpRequest, _ := uploadRequest("resume", "resume.pdf", "%PDF-1.4")
+				ctx := httpRequest.Context()
+				ctx = context.WithValue(ctx, middleware.TraceIDKey, "123")
+				ctx = context.WithValue(ctx, authentication.AuthKey, jwt.RegisteredClaims{Subject: "1"})
+				httpRequest = httpRequest.WithContext(ctx)
+				c.Params = append(c.Params, gin.Param{Key: "id", Value: "3"})
+				c.Request = httpRequest
+
+				mc := gomock.NewController(t)
+				mat := service.NewMockAttachmentService(mc)
+
+				mat.EXPECT().UploadAttachment(gomock.Any(), uint(1), uint(3), "resume", "resume.pdf", int64(8), gomock.Any()).Return(model.Attachment{}, service.ErrForbidden)
+
+				return c, rr, mat
+			},
+			expectedStatusCode: http.StatusForbidden,
+			expectedResponse:   `{"error":"Forbidden"}`,
+		},
+		{
+			name: "too large",
+			setup: func() (*gin.Context, *httptest.ResponseRecorder, service.AttachmentService) {
+				rr := httptest.NewRecorder()
+				c, _ := gin.CreateTestContext(rr)
+				httpRequest, _ := uploadRequest("resume", "resume.pdf", "%PDF-1.4")
+				ctx := httpRequest.Context()
+				ctx = context.WithValue(ctx, middleware.TraceIDKey, "123")
+				ctx = context.WithValue(ctx, authentication.AuthKey, jwt.RegisteredClaims{Subject: "1"})
+				httpRequest = httpRequest.WithContext(ctx)
+				c.Params = append(c.Params, gin.Param{Key: "id", Value: "3"})
+				c.Request = httpRequest
+
+				mc := gomock.NewController(t)
+				mat := service.NewMockAttachmentService(mc)
+
+				mat.EXPECT().UploadAttachment(gomock.Any(), uint(1), uint(3), "resume", "resume.pdf", int64(8), gomock.Any()).Return(model.Attachment{}, service.ErrAttachmentTooLarge)
+
+				return c, rr, mat
+			},
+			expectedStatusCode: http.StatusRequestEntityTooLarge,
+			expectedResponse:   `{"error":"Request Entity Too Large"}`,
+		},
+		{
+			name: "unsupported type",
+			setup: func() (*gin.Context, *httptest.ResponseRecorder, service.AttachmentService) {
+				rr := httptest.NewRecorder()
+				c, _ := gin.CreateTestContext(rr)
+				httpRequest, _ := uploadRequest("resume", "resume.pdf", "%PDF-1.4")
+				ctx := httpRequest.Context()
+				ctx = context.WithValue(ctx, middleware.TraceIDKey, "123")
+				ctx = context.WithValue(ctx, authentication.AuthKey, jwt.RegisteredClaims{Subject: "1"})
+				httpRequest = httpRequest.WithContext(ctx)
+				c.Params = append(c.Params, gin.Param{Key: "id", Value: "3"})
+				c.Request = httpRequest
+
+				mc := gomock.NewController(t)
+				mat := service.NewMockAttachmentService(mc)
+
+				mat.EXPECT().UploadAttachment(gomock.Any(), uint(1), uint(3), "resume", "resume.pdf", int64(8), gomock.Any()).Return(model.Attachment{}, service.ErrAttachmentUnsupported)
+
+				return c, rr, mat
+			},
+			expectedStatusCode: http.StatusUnsupportedMediaType,
+			expectedResponse:   `{"error":"Unsupported Media Type"}`,
+		},
+		{
+			name: "success",
+			setup: func() (*gin.Context, *httptest.ResponseRecorder, service.AttachmentService) {
+				rr := httptest.NewRecorder()
+				c, _ := gin.CreateTestContext(rr)
+				httpRequest, _ := uploadRequest("resume", "resume.pdf", "%PDF-1.4")
+				ctx := httpRequest.Context()
+				ctx = context.WithValue(ctx, middleware.TraceIDKey, "123")
+				ctx = context.WithValue(ctx, authentication.AuthKey, jwt.RegisteredClaims{Subject: "1"})
+				httpRequest = httpRequest.WithContext(ctx)
+				c.Params = append(c.Params, gin.Param{Key: "id", Value: "3"})
+				c.Request = httpRequest
+
+				mc := gomock.NewController(t)
+				mat := service.NewMockAttachmentService(mc)
+
+				mat.EXPECT().UploadAttachment(gomock.Any(), uint(1), uint(3), "resume", "resume.pdf", int64(8), gomock.Any()).Return(model.Attachment{ID: 7, ApplicationID: 3, Kind: model.AttachmentKindResume, FileName: "resume.pdf", ContentType: "application/pdf", Size: 8, UploadedBy: 1, CreatedAt: time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)}, nil)
+
+				return c, rr, mat
+			},
+			expectedStatusCode: http.StatusOK,
+			expectedResponse:   `{"id":7,"application_id":3,"kind":"resume","file_name":"resume.pdf","content_type":"application/pdf","size":8,"uploaded_by":1,"created_at":"2024-01-02T03:04:05Z"}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gin.SetMode(gin.TestMode)
+			c, rr, mat := tt.setup()
+			h := Handler{
+				serviceAttachment: mat,
+			}
+			h.UploadAttachment(c)
+			assert.Equal(t, tt.expectedStatusCode, rr.Code)
+			assert.Equal(t, tt.expectedResponse, rr.Body.String())
+		})
+	}
+}
+
+func TestHandler_ViewAttachments(t *testing.T) {
+	tests := []struct {
+		name               string
+		setup              func() (*gin.Context, *httptest.ResponseRecorder, service.AttachmentService)
+		expectedStatusCode int
+		expectedResponse   string
+	}{
+		{
+			name: "missing trace id",
+			setup: func() (*gin.Context, *httptest.ResponseRecorder, service.AttachmentService) {
+				rr := httptest.NewRecorder()
+				c, _ := gin.CreateTestContext(rr)
+				httpRequest, _ := http.NewRequest(http.MethodGet, "http://test.com", nil)
+				c.Request = httpRequest
+
+				return c, rr, nil
+			},
+			expectedStatusCode: http.StatusInternalServerError,
+			expectedResponse:   `{"error":"Internal Server Error"}`,
+		},
+		{
+			name: "missing jwt claims",
+			setup: func() (*gin.Context, *httptest.ResponseRecorder, service.AttachmentService) {
+				rr := httptest.NewRecorder()
+				c, _ := gin.CreateTestContext(rr)
+				httpRequest, _ := http.NewRequest(http.MethodGet, "http://test.com", nil)
+				ctx := httpRequest.Context()
+				ctx = context.WithValue(ctx, middleware.TraceIDKey, "123")
+				httpRequest = httpRequest.WithContext(ctx)
+				c.Request = httpRequest
+
+				return c, rr, nil
+			},
+			expectedStatusCode: http.StatusUnauthorized,
+			expectedResponse:   `{"error":"Unauthorized"}`,
+		},
+		{
+			name: "invalid user id in token",
+			setup: func() (*gin.Context, *httptest.ResponseRecorder, service.AttachmentService) {
+				rr := httptest.NewRecorder()
+				c, _ := gin.CreateTestContext(rr)
+				httpRequest, _ := http.NewRequest(http.MethodGet, "http://test.com", nil)
+				ctx := httpRequest.Context()
+				ctx = context.WithValue(ctx, middleware.TraceIDKey, "123")
+				ctx = context.WithValue(ctx, authentication.AuthKey, jwt.RegisteredClaims{Subject: "admin"})
+				httpRequest = httpRequest.WithContext(ctx)
+				c.Params = append(c.Params, gin.Param{Key: "id", Value: "3"})
+				c.Request = httpRequest
+
+				return c, rr, nil
+			},
+			expectedStatusCode: http.StatusUnauthorized,
+			expectedResponse:   `{"error":"Unauthorized"}`,
+		},
+		{
+			name: "invalid application id",
+			setup: func() (*gin.Context, *httptest.ResponseRecorder, service.AttachmentService) {
+				rr := httptest.NewRecorder()
+				c, _ := gin.CreateTestContext(rr)
+				httpRequest, _ := http.NewRequest(http.MethodGet, "http://test.com", nil)
+				ctx := httpRequest.Context()
+				ctx = context.WithValue(ctx, middleware.TraceIDKey, "123")
+				ctx = context.WithValue(ctx, authentication.AuthKey, jwt.RegisteredClaims{Subject: "1"})
+				httpRequest = httpRequest.WithContext(ctx)
+				c.Params = append(c.Params, gin.Param{Key: "id", Value: "abc"})
+				c.Request = httpRequest
+
+				return c, rr, nil
+			},
+			expectedStatusCode: http.StatusBadRequest,
+			expectedResponse:   `{"error":"Bad Request"}`,
+		},
+		{
+			name: "another user",
+			setup: func() (*gin.Context, *httptest.ResponseRecorder, service.AttachmentService) {
+				rr := httptest.NewRecorder()
+				c, _ := gin.CreateTestContext(rr)
+				httpRequest, _ := http.NewRequest(http.MethodGet, "http://test.com", nil)
+				ctx := httpRequest.Context()
+				ctx = context.WithValue(ctx, middleware.TraceIDKey, "123")
+				ctx = context.WithValue(ctx, authentication.AuthKey, jwt.RegisteredClaims{Subject: "1"})
+				httpRequest = httpRequest.WithContext(ctx)
+				c.Params = append(c.Params, gin.Param{Key: "id", Value: "3"})
+				c.Request = httpRequest
+
+				mc := gomock.NewController(t)
+				mat := service.NewMockAttachmentService(mc)
+
+				mat.EXPECT().ViewAttachments(uint(1), uint(3)).Return(nil, service.ErrForbidden)
+
+				return c, rr, mat
+			},
+			expectedStatusCode: http.StatusForbidden,
+			expectedResponse:   `{"error":"Forbidden"}`,
+		},
+		{
+			name: "success",
+			setup: func() (*gin.Context, *httptest.ResponseRecorder, service.AttachmentService) {
+				rr := httptest.NewRecorder()
+				c, _ := gin.CreateTestContext(rr)
+				httpRequest, _ := http.NewRequest(http.MethodGet, "http://test.com", nil)
+				ctx := httpRequest.Context()
+				ctx = context.WithValue(ctx, middleware.TraceIDKey, "123")
+				ctx = context.WithValue(ctx, authentication.AuthKey, jwt.RegisteredClaims{Subject: "1"})
+				httpRequest = httpRequest.WithContext(ctx)
+				c.Params = append(c.Params, gin.Param{Key: "id", Value: "3"})
+				c.Request = httpRequest
+
+				mc := gomock.NewController(t)
+				mat := service.NewMockAttachmentService(mc)
+
+				mat.EXPECT().ViewAttachments(uint(1), uint(3)).Return([]model.Attachment{model.Attachment{ID: 7, ApplicationID: 3, Kind: model.AttachmentKindResume, FileName: "resume.pdf", ContentType: "application/pdf", Size: 8, UploadedBy: 1, CreatedAt: time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)}}, nil)
+
+				return c, rr, mat
+			},
+			expectedStatusCode: http.StatusOK,
+			expectedResponse:   `[{"id":7,"application_id":3,"kind":"resume","file_name":"resume.pdf","content_type":"application/pdf","size":8,"uploaded_by":1,"created_at":"2024-01-02T03:04:05Z"}]`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gin.SetMode(gin.TestMode)
+			c, rr, mat := tt.setup()
+			h := Handler{
+				serviceAttachment: mat,
+			}
+			h.ViewAttachments(c)
+			assert.Equal(t, tt.expectedStatusCode, rr.Code)
+			assert.Equal(t, tt.expectedResponse, rr.Body.String())
+		})
+	}
+}
+
+func TestHandler_DownloadAttachment(t *testing.T) {
+	tests := []struct {
+		name               string
+		setup              func() (*gin.Context, *httptest.ResponseRecorder, service.AttachmentService)
+		expectedStatusCode int
+		expectedResponse   string
+	}{
+		{
+			name: "missing trace id",
+			setup: func() (*gin.Context, *httptest.ResponseRecorder, service.AttachmentService) {
+				rr := httptest.NewRecorder()
+				c, _ := gin.CreateTestContext(rr)
+				httpRequest, _ := http.NewRequest(http.MethodGet, "http://test.com", nil)
+				c.Request = httpRequest
+
+				return c, rr, nil
+			},
+			expectedStatusCode: http.StatusInternalServerError,
+			expectedResponse:   `{"error":"Internal Server Error"}`,
+		},
+		{
+			name: "missing jwt claims",
+			setup: func() (*gin.Context, *httptest.ResponseRecorder, service.AttachmentService) {
+				rr := httptest.NewRecorder()
+				c, _ := gin.CreateTestContext(rr)
+				httpRequest, _ := http.NewRequest(http.MethodGet, "http://test.com", nil)
+				ctx := httpRequest.Context()
+				ctx = context.WithValue(ctx, middleware.TraceIDKey, "123")
+				httpRequest = httpRequest.WithContext(ctx)
+				c.Request = httpRequest
+
+				return c, rr, nil
+			},
+			expectedStatusCode: http.StatusUnauthorized,
+			expectedResponse:   `{"error":"Unauthorized"}`,
+		},
+		{
+			name: "invalid user id in token",
+			setup: func() (*gin.Context, *httptest.ResponseRecorder, service.AttachmentService) {
+				rr := httptest.NewRecorder()
+				c, _ := gin.CreateTestContext(rr)
+				httpRequest, _ := http.NewRequest(http.MethodGet, "http://test.com", nil)
+				ctx := httpRequest.Context()
+				ctx = context.WithValue(ctx, middleware.TraceIDKey, "123")
+				ctx = context.WithValue(ctx, authentication.AuthKey, jwt.RegisteredClaims{Subject: "admin"})
+				httpRequest = httpRequest.WithContext(ctx)
+				c.Params = append(c.Params, gin.Param{Key: "id", Value: "7"})
+				c.Request = httpRequest
+
+				return c, rr, nil
+			},
+			expectedStatusCode: http.StatusUnauthorized,
+			expectedResponse:   `{"error":"Unauthorized"}`,
+		},
+		{
+			name: "invalid attachment id",
+			setup: func() (*gin.Context, *httptest.ResponseRecorder, service.AttachmentService) {
+				rr := httptest.NewRecorder()
+				c, _ := gin.CreateTestContext(rr)
+				httpRequest, _ := http.NewRequest(http.MethodGet, "http://test.com", nil)
+				ctx := httpRequest.Context()
+				ctx = context.WithValue(ctx, middleware.TraceIDKey, "123")
+				ctx = context.WithValue(ctx, authentication.AuthKey, jwt.RegisteredClaims{Subject: "1"})
+				httpRequest = httpRequest.WithContext(ctx)
+				c.Params = append(c.Params, gin.Param{Key: "id", Value: "abc"})
+				c.Request = httpRequest
+
+				return c, rr, nil
+			},
+			expectedStatusCode: http.StatusBadRequest,
+			expectedResponse:   `{"error":"Bad Request"}`,
+		},
+		{
+			name: "another user",
+			setup: func() (*gin.Context, *httptest.ResponseRecorder, service.AttachmentService) {
+				rr := httptest.NewRecorder()
+				c, _ := gin.CreateTestContext(rr)
+				httpRequest, _ := http.NewRequest(http.MethodGet, "http://test.com", nil)
+				ctx := httpRequest.Context()
+				ctx = context.WithValue(ctx, middleware.TraceIDKey, "123")
+				ctx = context.WithValue(ctx, authentication.AuthKey, jwt.RegisteredClaims{Subject: "1"})
+				httpRequest = httpRequest.WithContext(ctx)
+				c.Params = append(c.Params, gin.Param{Key: "id", Value: "7"})
+				c.Request = httpRequest
+
+				mc := gomock.NewController(t)
+				mat := service.NewMockAttachmentService(mc)
+
+				mat.EXPECT().DownloadAttachment(gomock.Any(), uint(1), uint(7)).Return(model.Attachment{}, nil, service.ErrForbidden)
+
+				return c, rr, mat
+			},
+			expectedStatusCode: http.StatusForbidden,
+			expectedResponse:   `{"error":"Forbidden"}`,
+		},
+		{
+			name: "failure",
+			setup: func() (*gin.Context, *httptest.ResponseRecorder, service.AttachmentService) {
+				rr := httptest.NewRecorder()
+				c, _ := gin.CreateTestContext(rr)
+				httpRequest, _ := http.NewRequest(http.MethodGet, "http://test.com", nil)
+				ctx := httpRequest.Context()
+				ctx = context.WithValue(ctx, middleware.TraceIDKey, "123")
+				ctx = context.WithValue(ctx, authentication.AuthKey, jwt.RegisteredClaims{Subject: "1"})
+				httpRequest = httpRequest.WithContext(ctx)
+				c.Params = append(c.Params, gin.Param{Key: "id", Value: "7"})
+				c.Request = httpRequest
+
+				mc := gomock.NewController(t)
+				mat := service.NewMockAttachmentService(mc)
+
+				mat.EXPECT().DownloadAttachment(gomock.Any(), uint(1), uint(7)).Return(model.Attachment{}, nil, errors.New("error"))
+
+				return c, rr, mat
+			},
+			expectedStatusCode: http.StatusBadRequest,
+			expectedResponse:   `{"error":"Bad Request"}`,
+		},
+		{
+			name: "success",
+			setup: func() (*gin.Context, *httptest.ResponseRecorder, service.AttachmentService) {
+				rr := httptest.NewRecorder()
+				c, _ := gin.CreateTestContext(rr)
+				httpRequest, _ := http.NewRequest(http.MethodGet, "http://test.com", nil)
+				ctx := httpRequest.Context()
+				ctx = context.WithValue(ctx, middleware.TraceIDKey, "123")
+				ctx = context.WithValue(ctx, authentication.AuthKey, jwt.RegisteredClaims{Subject: "1"})
+				httpRequest = httpRequest.WithContext(ctx)
+				c.Params = append(c.Params, gin.Param{Key: "id", Value: "7"})
+				c.Request = httpRequest
+
+				mc := gomock.NewController(t)
+				mat := service.NewMockAttachmentService(mc)
+
+				mat.EXPECT().DownloadAttachment(gomock.Any(), uint(1), uint(7)).Return(model.Attachment{ID: 7, ApplicationID: 3, Kind: model.AttachmentKindResume, FileName: "resume.pdf", ContentType: "application/pdf", Size: 8, UploadedBy: 1, CreatedAt: time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)}, io.NopCloser(strings.NewReader("%PDF-1.4")), nil)
+
+				return c, rr, mat
+			},
+			expectedStatusCode: http.StatusOK,
+			expectedResponse:   `%PDF-1.4`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gin.SetMode(gin.TestMode)
+			c, rr, mat := tt.setup()
+			h := Handler{
+				serviceAttachment: mat,
+			}
+			h.DownloadAttachment(c)
+			assert.Equal(t, tt.expectedStatusCode, rr.Code)
+			assert.Equal(t, tt.expectedResponse, rr.Body.String())
+		})
+	}
+}
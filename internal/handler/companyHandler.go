@@ -21,6 +21,7 @@ type CompanyHandler interface {
 	AddCompany(c *gin.Context)
 	ViewCompanyByID(c *gin.Context)
 	ViewAllComapny(c *gin.Context)
+	ViewCompanyNotifications(c *gin.Context)
 }
 
 func NewCompanyHandler(companyService service.ComapnyService) (CompanyHandler, error) {
@@ -44,7 +45,7 @@ func (h *Handler) AddCompany(c *gin.Context) {
 		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"error ": http.StatusText(http.StatusInternalServerError)})
 		return
 	}
-	_, ok = ctx.Value(authentication.AuthKey).(jwt.RegisteredClaims)
+	claims, ok := ctx.Value(authentication.AuthKey).(jwt.RegisteredClaims)
 	if !ok {
 		log.Info().Str("trace Id : ", traceId).Msg("login not success")
 		c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error ": http.StatusText(http.StatusUnauthorized)})
@@ -69,7 +70,14 @@ func (h *Handler) AddCompany(c *gin.Context) {
 		return
 	}
 
-	company, err := h.serviceComapny.AddingCompany(companyData)
+	uID, err := strconv.ParseUint(claims.Subject, 10, 64)
+	if err != nil {
+		log.Error().Err(err).Str("trace Id : ", traceId).Msg("error invalid user id in token")
+		c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error ": http.StatusText(http.StatusUnauthorized)})
+		return
+	}
+
+	company, err := h.serviceComapny.AddingCompany(companyData, uint(uID))
 	if err != nil {
 		log.Error().Err(err).Msg("error in creating company")
 		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"error ": http.StatusText(http.StatusInternalServerError)})
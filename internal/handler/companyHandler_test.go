@@ -101,6 +101,29 @@ func TestHandler_AddCompany(t *testing.T) {
 			expectedStatusCode: http.StatusBadRequest,
 			expectedResponse:   `{"error ":"Bad Request"}`,
 		},
+		{
+			name: "invalid user id in token",
+			setup: func() (*gin.Context, *httptest.ResponseRecorder, service.ComapnyService) {
+				rr := httptest.NewRecorder()
+				c, _ := gin.CreateTestContext(rr)
+				httpRequest, _ := http.NewRequest(http.MethodGet, "http://test.com", strings.NewReader(
+					`{
+						"companyName":"TEK",
+						"address":"Bellandur",
+						"domain":"Software"
+					}`,
+				))
+				ctx := httpRequest.Context()
+				ctx = context.WithValue(ctx, middleware.TraceIDKey, "123")
+				ctx = context.WithValue(ctx, authentication.AuthKey, jwt.RegisteredClaims{Subject: "admin"})
+				httpRequest = httpRequest.WithContext(ctx)
+				c.Request = httpRequest
+
+				return c, rr, nil
+			},
+			expectedStatusCode: http.StatusUnauthorized,
+			expectedResponse:   `{"error ":"Unauthorized"}`,
+		},
 		{
 			name: "failure",
 			setup: func() (*gin.Context, *httptest.ResponseRecorder, service.ComapnyService) {
@@ -115,14 +138,14 @@ func TestHandler_AddCompany(t *testing.T) {
 				))
 				ctx := httpRequest.Context()
 				ctx = context.WithValue(ctx, middleware.TraceIDKey, "123")
-				ctx = context.WithValue(ctx, authentication.AuthKey, jwt.RegisteredClaims{})
+				ctx = context.WithValue(ctx, authentication.AuthKey, jwt.RegisteredClaims{Subject: "1"})
 				httpRequest = httpRequest.WithContext(ctx)
 				c.Request = httpRequest
 
 				mc := gomock.NewController(t)
 				mcom := service.NewMockComapnyService(mc)
 
-				mcom.EXPECT().AddingCompany(gomock.Any()).Return(model.Company{}, errors.New("error"))
+				mcom.EXPECT().AddingCompany(gomock.Any(), uint(1)).Return(model.Company{}, errors.New("error"))
 
 				return c, rr, mcom
 			},
@@ -143,19 +166,19 @@ func TestHandler_AddCompany(t *testing.T) {
 				))
 				ctx := httpRequest.Context()
 				ctx = context.WithValue(ctx, middleware.TraceIDKey, "123")
-				ctx = context.WithValue(ctx, authentication.AuthKey, jwt.RegisteredClaims{})
+				ctx = context.WithValue(ctx, authentication.AuthKey, jwt.RegisteredClaims{Subject: "1"})
 				httpRequest = httpRequest.WithContext(ctx)
 				c.Request = httpRequest
 
 				mc := gomock.NewController(t)
 				mcom := service.NewMockComapnyService(mc)
 
-				mcom.EXPECT().AddingCompany(gomock.Any()).Return(model.Company{}, nil)
+				mcom.EXPECT().AddingCompany(gomock.Any(), uint(1)).Return(model.Company{}, nil)
 
 				return c, rr, mcom
 			},
 			expectedStatusCode: http.StatusOK,
-			expectedResponse:   `{"ID":0,"CreatedAt":"0001-01-01T00:00:00Z","UpdatedAt":"0001-01-01T00:00:00Z","DeletedAt":null,"companyName":"","address":"","domain":""}`,
+			expectedResponse:   `{"ID":0,"CreatedAt":"0001-01-01T00:00:00Z","UpdatedAt":"0001-01-01T00:00:00Z","DeletedAt":null,"companyName":"","address":"","domain":"","owner_id":0}`,
 		},
 	}
 	for _, tt := range tests {
@@ -271,7 +294,7 @@ func TestHandler_ViewCompanyByID(t *testing.T) {
 				return c, rr, mcom
 			},
 			expectedStatusCode: http.StatusOK,
-			expectedResponse:   `{"ID":0,"CreatedAt":"0001-01-01T00:00:00Z","UpdatedAt":"0001-01-01T00:00:00Z","DeletedAt":null,"companyName":"","address":"","domain":""}`,
+			expectedResponse:   `{"ID":0,"CreatedAt":"0001-01-01T00:00:00Z","UpdatedAt":"0001-01-01T00:00:00Z","DeletedAt":null,"companyName":"","address":"","domain":"","owner_id":0}`,
 		},
 	}
 	for _, tt := range tests {
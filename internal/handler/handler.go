@@ -13,12 +13,19 @@ import (
 )
 
 type Handler struct {
-	serviceUser    service.UserService
-	serviceComapny service.ComapnyService
-	serviceJob     service.JobService
+	serviceUser           service.UserService
+	serviceComapny        service.ComapnyService
+	serviceJob            service.JobService
+	serviceTaxonomy       service.TaxonomyService
+	serviceApplication    service.ApplicationService
+	serviceScreeningBatch service.ScreeningBatchService
+	serviceAttachment     service.AttachmentService
+	serviceResume         service.ResumeService
+	serviceProfile        service.CandidateProfileService
+	serviceReview         service.ApplicationReviewService
 }
 
-func SetupApi(auth authentication.Authenticaton, userService service.UserService, comapnyService service.ComapnyService, jobService service.JobService) *gin.Engine {
+func SetupApi(auth authentication.Authenticaton, userService service.UserService, comapnyService service.ComapnyService, jobService service.JobService, taxonomyService service.TaxonomyService, applicationService service.ApplicationService, screeningBatchService service.ScreeningBatchService, attachmentService service.AttachmentService, resumeService service.ResumeService, profileService service.CandidateProfileService, reviewService service.ApplicationReviewService) *gin.Engine {
 
 	router := gin.New()
 
@@ -42,6 +49,41 @@ func SetupApi(auth authentication.Authenticaton, userService service.UserService
 		log.Panic("job handlers are not set")
 	}
 
+	taxonomyHandler, err := NewTaxonomyHandler(taxonomyService)
+	if err != nil {
+		log.Panic("taxonomy handlers are not set")
+	}
+
+	applicationHandler, err := NewApplicationHandler(applicationService)
+	if err != nil {
+		log.Panic("application handlers are not set")
+	}
+
+	screeningBatchHandler, err := NewScreeningBatchHandler(screeningBatchService)
+	if err != nil {
+		log.Panic("screening batch handlers are not set")
+	}
+
+	attachmentHandler, err := NewAttachmentHandler(attachmentService)
+	if err != nil {
+		log.Panic("attachment handlers are not set")
+	}
+
+	resumeHandler, err := NewResumeHandler(resumeService)
+	if err != nil {
+		log.Panic("resume handlers are not set")
+	}
+
+	profileHandler, err := NewCandidateProfileHandler(profileService)
+	if err != nil {
+		log.Panic("candidate profile handlers are not set")
+	}
+
+	reviewHandler, err := NewApplicationReviewHandler(reviewService)
+	if err != nil {
+		log.Panic("application review handlers are not set")
+	}
+
 	router.Use(mid.Log(), gin.Recovery())
 
 	router.GET("/api/check", check)
@@ -52,12 +94,58 @@ func SetupApi(auth authentication.Authenticaton, userService service.UserService
 	router.POST("/api/create_comapny", mid.Authentication(companyHandler.AddCompany))
 	router.GET("/api/get_company/:id", mid.Authentication(companyHandler.ViewCompanyByID))
 	router.GET("/api/get_companies", mid.Authentication(companyHandler.ViewAllComapny))
+	router.GET("/api/get_company_notifications/:id", mid.Authentication(companyHandler.ViewCompanyNotifications))
 
 	router.POST("/api/addjob/companyID/:id", mid.Authentication(jobHandler.CreateJobByCompanyID))
+	router.POST("/api/import_jobs/companyID/:id", mid.Authentication(jobHandler.ImportJobs))
 	router.GET("/api/get_job_by_company_id/:id", mid.Authentication(jobHandler.ViewJobByCompanyId))
 	router.GET("/api/get_job_by_job_id/:id", mid.Authentication(jobHandler.ViewJobByJobID))
 	router.GET("/api/get_jobs", mid.Authentication(jobHandler.ViewAllJobs))
+	router.GET("/api/search_jobs", mid.Authentication(jobHandler.SearchJobs))
+	router.PUT("/api/update_job/:id", mid.Authentication(jobHandler.ReplaceJob))
+	router.PATCH("/api/update_job/:id", mid.Authentication(jobHandler.UpdateJob))
+	router.DELETE("/api/delete_job/:id", mid.Authentication(jobHandler.DeleteJob))
+	router.PATCH("/api/update_job_status/:id", mid.Authentication(jobHandler.ChangeJobStatus))
+	router.GET("/api/get_job_revisions/:id", mid.Authentication(jobHandler.ViewJobRevisions))
+	router.GET("/api/diff_job_revisions/:id", mid.Authentication(jobHandler.DiffJobRevisions))
+	router.POST("/api/restore_job_revision/:id/:revision", mid.Authentication(jobHandler.RestoreJobRevision))
 	router.GET("/api/process_application", mid.Authentication(jobHandler.ProcessJobApplication))
+	router.POST("/api/process_application_stream", mid.Authentication(jobHandler.ProcessJobApplicationStream))
+	router.POST("/api/submit_screening_batch", mid.Authentication(screeningBatchHandler.SubmitScreeningBatch))
+	router.GET("/api/get_screening_batch/:id", mid.Authentication(screeningBatchHandler.ViewScreeningBatch))
+	router.GET("/api/get_screening_batch_items/:id", mid.Authentication(screeningBatchHandler.ViewScreeningBatchItems))
+
+	router.POST("/api/submit_application", mid.Authentication(applicationHandler.SubmitApplication))
+	router.PUT("/api/resubmit_application", mid.Authentication(applicationHandler.ResubmitApplication))
+	router.GET("/api/get_application/:id", mid.Authentication(applicationHandler.ViewApplication))
+	router.GET("/api/get_applications_by_job_id/:id", mid.Authentication(applicationHandler.ViewApplicationsByJobID))
+	router.PATCH("/api/update_application_stage/:id", mid.Authentication(applicationHandler.ChangeApplicationStage))
+	router.GET("/api/get_application_pipeline/:id", mid.Authentication(applicationHandler.ViewApplicationPipeline))
+	router.GET("/api/get_application_stage_history/:id", mid.Authentication(applicationHandler.ViewApplicationStageHistory))
+	router.GET("/api/get_shortlist/:id", mid.Authentication(applicationHandler.ViewShortlist))
+	router.GET("/api/get_my_applications", mid.Authentication(applicationHandler.ViewMyApplications))
+	router.PATCH("/api/withdraw_application/:id", mid.Authentication(applicationHandler.WithdrawApplication))
+	router.POST("/api/add_application_note/:id", mid.Authentication(reviewHandler.AddApplicationNote))
+	router.GET("/api/get_application_review/:id", mid.Authentication(reviewHandler.ViewApplicationReview))
+	router.PUT("/api/update_application_tags/:id", mid.Authentication(reviewHandler.TagApplication))
+	router.PUT("/api/rate_application/:id", mid.Authentication(reviewHandler.RateApplication))
+	router.GET("/api/filter_applications/:id", mid.Authentication(reviewHandler.FilterApplications))
+
+	router.GET("/api/get_profile", mid.Authentication(profileHandler.ViewCandidateProfile))
+	router.PUT("/api/update_profile", mid.Authentication(profileHandler.UpdateCandidateProfile))
+	router.POST("/api/apply_with_profile", mid.Authentication(profileHandler.ApplyWithProfile))
+
+	router.POST("/api/upload_attachment/:id", mid.Authentication(attachmentHandler.UploadAttachment))
+	router.GET("/api/get_attachments/:id", mid.Authentication(attachmentHandler.ViewAttachments))
+	router.GET("/api/download_attachment/:id", mid.Authentication(attachmentHandler.DownloadAttachment))
+	router.POST("/api/draft_application", mid.Authentication(resumeHandler.DraftApplication))
+	router.GET("/api/draft_application_from_attachment/:id", mid.Authentication(resumeHandler.DraftApplicationFromAttachment))
+
+	router.POST("/api/create_taxonomy/:kind", mid.Authentication(taxonomyHandler.AddTaxonomy))
+	router.GET("/api/get_taxonomies/:kind", mid.Authentication(taxonomyHandler.ViewTaxonomies))
+	router.PATCH("/api/rename_taxonomy/:kind/:id", mid.Authentication(taxonomyHandler.RenameTaxonomy))
+	router.DELETE("/api/retire_taxonomy/:kind/:id", mid.Authentication(taxonomyHandler.RetireTaxonomy))
+	router.PATCH("/api/set_location_coordinates/:id", mid.Authentication(taxonomyHandler.SetLocationCoordinates))
 
 	router.POST("/api/otp_genereation", userHandler.GeneratingOTP)
 	router.POST("/api/verify_otp", userHandler.VerifyOTP)
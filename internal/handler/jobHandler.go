@@ -9,6 +9,7 @@ import (
 	"job-portal-api/internal/service"
 	"net/http"
 	"strconv"
+	"strings"
 
 	"github.com/gin-gonic/gin"
 	"github.com/go-playground/validator/v10"
@@ -21,7 +22,17 @@ type JobHandler interface {
 	ViewJobByCompanyId(c *gin.Context)
 	ViewJobByJobID(c *gin.Context)
 	ViewAllJobs(c *gin.Context)
+	SearchJobs(c *gin.Context)
 	ProcessJobApplication(c *gin.Context)
+	ProcessJobApplicationStream(c *gin.Context)
+	UpdateJob(c *gin.Context)
+	ReplaceJob(c *gin.Context)
+	DeleteJob(c *gin.Context)
+	ChangeJobStatus(c *gin.Context)
+	ViewJobRevisions(c *gin.Context)
+	DiffJobRevisions(c *gin.Context)
+	RestoreJobRevision(c *gin.Context)
+	ImportJobs(c *gin.Context)
 }
 
 func NewJobHandler(serviceJob service.JobService) (JobHandler, error) {
@@ -46,13 +57,20 @@ func (h *Handler) CreateJobByCompanyID(c *gin.Context) {
 		return
 	}
 
-	_, ok = ctx.Value(authentication.AuthKey).(jwt.RegisteredClaims)
+	claims, ok := ctx.Value(authentication.AuthKey).(jwt.RegisteredClaims)
 	if !ok {
 		log.Info().Str("trace Id : ", traceId).Msg("login first")
 		c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error ": http.StatusText(http.StatusUnauthorized)})
 		return
 	}
 
+	uID, err := strconv.ParseUint(claims.Subject, 10, 64)
+	if err != nil {
+		log.Error().Err(err).Str("trace Id : ", traceId).Msg("error invalid user id in token")
+		c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error ": http.StatusText(http.StatusUnauthorized)})
+		return
+	}
+
 	id := c.Param("id")
 
 	cId, err := strconv.ParseUint(id, 10, 64)
@@ -80,7 +98,18 @@ func (h *Handler) CreateJobByCompanyID(c *gin.Context) {
 		return
 	}
 
-	jodResponse, err := h.serviceJob.CreateJobByCompanyId(jobData, uint(cId))
+	jodResponse, err := h.serviceJob.CreateJobByCompanyId(uint(uID), jobData, uint(cId))
+	if errors.Is(err, service.ErrForbidden) {
+		log.Error().Err(err).Str("trace id :", traceId).Msg("error user does not act for the company")
+		c.AbortWithStatusJSON(http.StatusForbidden, gin.H{"error ": http.StatusText(http.StatusForbidden)})
+		return
+	}
+	var validationErr *service.ValidationError
+	if errors.As(err, &validationErr) {
+		log.Error().Err(err).Str("trace id :", traceId).Msg("error invalid job")
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"errors": validationErr.Errors})
+		return
+	}
 	if err != nil {
 		log.Error().Err(err).Str("trace id :", traceId).Msg("error in job creation")
 		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"error ": http.StatusText(http.StatusInternalServerError)})
@@ -183,7 +212,14 @@ func (h *Handler) ViewAllJobs(c *gin.Context) {
 		return
 	}
 
-	jobsData, err := h.serviceJob.ViewAllJobs()
+	filter, err := parseJobFilter(c)
+	if err != nil {
+		log.Error().Err(err).Str("trace id : ", traceId).Msg("error in parsing job filters")
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": http.StatusText(http.StatusBadRequest)})
+		return
+	}
+
+	jobsData, err := h.serviceJob.ViewAllJobs(filter)
 	if err != nil {
 		log.Error().Err(err).Str("tracr id : ", traceId)
 		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": http.StatusText(http.StatusBadRequest)})
@@ -192,6 +228,56 @@ func (h *Handler) ViewAllJobs(c *gin.Context) {
 	c.JSON(http.StatusOK, jobsData)
 }
 
+func (h *Handler) SearchJobs(c *gin.Context) {
+	ctx := c.Request.Context()
+	traceId, ok := ctx.Value(middleware.TraceIDKey).(string)
+	if !ok {
+		log.Info().Msg("error missing trace id")
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"error": http.StatusText(http.StatusInternalServerError)})
+		return
+	}
+
+	_, ok = ctx.Value(authentication.AuthKey).(jwt.RegisteredClaims)
+	if !ok {
+		log.Info().Str("trace id : ", traceId).Msg("login first")
+		c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": http.StatusText(http.StatusUnauthorized)})
+		return
+	}
+
+	query := c.Query("q")
+	if query == "" {
+		log.Info().Str("trace id : ", traceId).Msg("missing search query")
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": http.StatusText(http.StatusBadRequest)})
+		return
+	}
+
+	filter, err := parseJobFilter(c)
+	if err != nil {
+		log.Error().Err(err).Str("trace id : ", traceId).Msg("error in parsing job filters")
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": http.StatusText(http.StatusBadRequest)})
+		return
+	}
+
+	offset := 0
+	if v := c.Query("offset"); v != "" {
+		offset, err = strconv.Atoi(v)
+		if err != nil {
+			log.Error().Err(err).Str("trace id : ", traceId).Msg("error in parsing offset")
+			c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": http.StatusText(http.StatusBadRequest)})
+			return
+		}
+	}
+
+	searchData, err := h.serviceJob.SearchJobs(query, filter, offset)
+	if err != nil {
+		log.Error().Err(err).Str("trace id : ", traceId).Msg("error in searching jobs")
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": http.StatusText(http.StatusBadRequest)})
+		return
+	}
+
+	c.JSON(http.StatusOK, searchData)
+}
+
 func (h *Handler) ProcessJobApplication(c *gin.Context) {
 
 	ctx := c.Request.Context()
@@ -230,13 +316,414 @@ func (h *Handler) ProcessJobApplication(c *gin.Context) {
 		}
 	}
 
-	jobApplication := h.serviceJob.ProcessApplication(applications)
+	jobApplication := h.serviceJob.ProcessApplication(ctx, applications)
 	if jobApplication == nil {
-		log.Info().Str("trace id : ", traceId).Msg("all applications rejected")
-		c.JSON(http.StatusBadRequest, gin.H{"error all applications rejected ": http.StatusText(http.StatusBadRequest)})
+		log.Info().Str("trace id : ", traceId).Msg("no application could be screened")
+		c.JSON(http.StatusBadRequest, gin.H{"error no application could be screened ": http.StatusText(http.StatusBadRequest)})
 		return
 	}
 
 	c.JSON(http.StatusOK, jobApplication)
 
 }
+
+func (h *Handler) UpdateJob(c *gin.Context) {
+	ctx := c.Request.Context()
+	traceId, ok := ctx.Value(middleware.TraceIDKey).(string)
+	if !ok {
+		log.Info().Msg("missing trace id")
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"error": http.StatusText(http.StatusInternalServerError)})
+		return
+	}
+
+	claims, ok := ctx.Value(authentication.AuthKey).(jwt.RegisteredClaims)
+	if !ok {
+		log.Info().Str("trace id : ", traceId).Msg("login first")
+		c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": http.StatusText(http.StatusUnauthorized)})
+		return
+	}
+
+	uID, err := strconv.ParseUint(claims.Subject, 10, 64)
+	if err != nil {
+		log.Error().Err(err).Str("trace id : ", traceId).Msg("error invalid user id in token")
+		c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": http.StatusText(http.StatusUnauthorized)})
+		return
+	}
+
+	id := c.Param("id")
+	jID, err := strconv.ParseUint(id, 10, 64)
+	if err != nil {
+		log.Error().Err(err).Str("trace id : ", traceId).Msg("error invalid job id")
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": http.StatusText(http.StatusBadRequest)})
+		return
+	}
+
+	var jobData model.UpdateJob
+	err = json.NewDecoder(c.Request.Body).Decode(&jobData)
+	if err != nil {
+		log.Error().Err(err).Str("trace id : ", traceId).Msg("error in decoding")
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": http.StatusText(http.StatusBadRequest)})
+		return
+	}
+
+	validate := validator.New()
+	err = validate.Struct(jobData)
+	if err != nil {
+		log.Error().Err(err).Str("trace id : ", traceId).Msg("error in validating job")
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": http.StatusText(http.StatusBadRequest)})
+		return
+	}
+
+	jobResponse, err := h.serviceJob.UpdateJobByJobID(uint(uID), uint(jID), jobData)
+	if errors.Is(err, service.ErrForbidden) {
+		log.Error().Err(err).Str("trace id : ", traceId).Msg("error user does not act for the company")
+		c.AbortWithStatusJSON(http.StatusForbidden, gin.H{"error": http.StatusText(http.StatusForbidden)})
+		return
+	}
+	var validationErr *service.ValidationError
+	if errors.As(err, &validationErr) {
+		log.Error().Err(err).Str("trace id : ", traceId).Msg("error invalid job")
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"errors": validationErr.Errors})
+		return
+	}
+	if err != nil {
+		log.Error().Err(err).Str("trace id : ", traceId).Msg("error in updating job")
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": http.StatusText(http.StatusBadRequest)})
+		return
+	}
+
+	c.JSON(http.StatusOK, jobResponse)
+}
+
+func (h *Handler) ReplaceJob(c *gin.Context) {
+	ctx := c.Request.Context()
+	traceId, ok := ctx.Value(middleware.TraceIDKey).(string)
+	if !ok {
+		log.Info().Msg("missing trace id")
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"error": http.StatusText(http.StatusInternalServerError)})
+		return
+	}
+
+	claims, ok := ctx.Value(authentication.AuthKey).(jwt.RegisteredClaims)
+	if !ok {
+		log.Info().Str("trace id : ", traceId).Msg("login first")
+		c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": http.StatusText(http.StatusUnauthorized)})
+		return
+	}
+
+	uID, err := strconv.ParseUint(claims.Subject, 10, 64)
+	if err != nil {
+		log.Error().Err(err).Str("trace id : ", traceId).Msg("error invalid user id in token")
+		c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": http.StatusText(http.StatusUnauthorized)})
+		return
+	}
+
+	id := c.Param("id")
+	jID, err := strconv.ParseUint(id, 10, 64)
+	if err != nil {
+		log.Error().Err(err).Str("trace id : ", traceId).Msg("error invalid job id")
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": http.StatusText(http.StatusBadRequest)})
+		return
+	}
+
+	var jobData model.NewJobs
+	err = json.NewDecoder(c.Request.Body).Decode(&jobData)
+	if err != nil {
+		log.Error().Err(err).Str("trace id : ", traceId).Msg("error in decoding")
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": http.StatusText(http.StatusBadRequest)})
+		return
+	}
+
+	validate := validator.New()
+	err = validate.Struct(jobData)
+	if err != nil {
+		log.Error().Err(err).Str("trace id : ", traceId).Msg("error in validating job")
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": http.StatusText(http.StatusBadRequest)})
+		return
+	}
+
+	//a PUT replaces every field, including the association lists and an
+	//expiry date left out of the body
+	jobResponse, err := h.serviceJob.UpdateJobByJobID(uint(uID), uint(jID), model.UpdateJob{
+		Jobname:           &jobData.Jobname,
+		MinNoticePeriod:   &jobData.MinNoticePeriod,
+		MaxNoticePeriod:   &jobData.MaxNoticePeriod,
+		Location:          &jobData.Location,
+		TechnologyStack:   &jobData.TechnologyStack,
+		Description:       &jobData.Description,
+		MinExperience:     &jobData.MinExperience,
+		MaxExperience:     &jobData.MaxExperience,
+		Qualifications:    &jobData.Qualifications,
+		Shift:             &jobData.Shift,
+		Jobtype:           &jobData.Jobtype,
+		ExpiresAt:         jobData.ExpiresAt,
+		WorkMode:          &jobData.WorkMode,
+		MinSalary:         &jobData.MinSalary,
+		MaxSalary:         &jobData.MaxSalary,
+		Currency:          &jobData.Currency,
+		PayPeriod:         &jobData.PayPeriod,
+		SalaryUndisclosed: &jobData.SalaryUndisclosed,
+		SkillRequirements: &jobData.SkillRequirements,
+		ScoringPolicy:     &jobData.ScoringPolicy,
+		ClearExpiresAt:    true,
+	})
+	if errors.Is(err, service.ErrForbidden) {
+		log.Error().Err(err).Str("trace id : ", traceId).Msg("error user does not act for the company")
+		c.AbortWithStatusJSON(http.StatusForbidden, gin.H{"error": http.StatusText(http.StatusForbidden)})
+		return
+	}
+	var validationErr *service.ValidationError
+	if errors.As(err, &validationErr) {
+		log.Error().Err(err).Str("trace id : ", traceId).Msg("error invalid job")
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"errors": validationErr.Errors})
+		return
+	}
+	if err != nil {
+		log.Error().Err(err).Str("trace id : ", traceId).Msg("error in replacing job")
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": http.StatusText(http.StatusBadRequest)})
+		return
+	}
+
+	c.JSON(http.StatusOK, jobResponse)
+}
+
+func (h *Handler) DeleteJob(c *gin.Context) {
+	ctx := c.Request.Context()
+	traceId, ok := ctx.Value(middleware.TraceIDKey).(string)
+	if !ok {
+		log.Info().Msg("missing trace id")
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"error": http.StatusText(http.StatusInternalServerError)})
+		return
+	}
+
+	claims, ok := ctx.Value(authentication.AuthKey).(jwt.RegisteredClaims)
+	if !ok {
+		log.Info().Str("trace id : ", traceId).Msg("login first")
+		c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": http.StatusText(http.StatusUnauthorized)})
+		return
+	}
+
+	uID, err := strconv.ParseUint(claims.Subject, 10, 64)
+	if err != nil {
+		log.Error().Err(err).Str("trace id : ", traceId).Msg("error invalid user id in token")
+		c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": http.StatusText(http.StatusUnauthorized)})
+		return
+	}
+
+	id := c.Param("id")
+	jID, err := strconv.ParseUint(id, 10, 64)
+	if err != nil {
+		log.Error().Err(err).Str("trace id : ", traceId).Msg("error invalid job id")
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": http.StatusText(http.StatusBadRequest)})
+		return
+	}
+
+	err = h.serviceJob.DeleteJobByJobID(uint(uID), uint(jID))
+	if errors.Is(err, service.ErrForbidden) {
+		log.Error().Err(err).Str("trace id : ", traceId).Msg("error user does not act for the company")
+		c.AbortWithStatusJSON(http.StatusForbidden, gin.H{"error": http.StatusText(http.StatusForbidden)})
+		return
+	}
+	if err != nil {
+		log.Error().Err(err).Str("trace id : ", traceId).Msg("error in deleting job")
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": http.StatusText(http.StatusBadRequest)})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"msg": "job deleted"})
+}
+
+func (h *Handler) ChangeJobStatus(c *gin.Context) {
+	ctx := c.Request.Context()
+	traceId, ok := ctx.Value(middleware.TraceIDKey).(string)
+	if !ok {
+		log.Info().Msg("missing trace id")
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"error": http.StatusText(http.StatusInternalServerError)})
+		return
+	}
+
+	claims, ok := ctx.Value(authentication.AuthKey).(jwt.RegisteredClaims)
+	if !ok {
+		log.Info().Str("trace id : ", traceId).Msg("login first")
+		c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": http.StatusText(http.StatusUnauthorized)})
+		return
+	}
+
+	uID, err := strconv.ParseUint(claims.Subject, 10, 64)
+	if err != nil {
+		log.Error().Err(err).Str("trace id : ", traceId).Msg("error invalid user id in token")
+		c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": http.StatusText(http.StatusUnauthorized)})
+		return
+	}
+
+	id := c.Param("id")
+	jID, err := strconv.ParseUint(id, 10, 64)
+	if err != nil {
+		log.Error().Err(err).Str("trace id : ", traceId).Msg("error invalid job id")
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": http.StatusText(http.StatusBadRequest)})
+		return
+	}
+
+	var statusData model.JobStatusChange
+	err = json.NewDecoder(c.Request.Body).Decode(&statusData)
+	if err != nil {
+		log.Error().Err(err).Str("trace id : ", traceId).Msg("error in decoding")
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": http.StatusText(http.StatusBadRequest)})
+		return
+	}
+
+	validate := validator.New()
+	err = validate.Struct(statusData)
+	if err != nil {
+		log.Error().Err(err).Str("trace id : ", traceId).Msg("error in validating job status")
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": http.StatusText(http.StatusBadRequest)})
+		return
+	}
+
+	jobResponse, err := h.serviceJob.ChangeJobStatus(uint(uID), uint(jID), statusData.Status)
+	if errors.Is(err, service.ErrForbidden) {
+		log.Error().Err(err).Str("trace id : ", traceId).Msg("error user does not act for the company")
+		c.AbortWithStatusJSON(http.StatusForbidden, gin.H{"error": http.StatusText(http.StatusForbidden)})
+		return
+	}
+	if err != nil {
+		log.Error().Err(err).Str("trace id : ", traceId).Msg("error in changing job status")
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": http.StatusText(http.StatusBadRequest)})
+		return
+	}
+
+	c.JSON(http.StatusOK, jobResponse)
+}
+
+// parseJobFilter reads the job listing filters from the query string. List
+// parameters accept either repeated keys or comma separated values, e.g.
+// ?location=1,2&location=3.
+func parseJobFilter(c *gin.Context) (model.JobFilter, error) {
+	var filter model.JobFilter
+	var err error
+
+	if v := c.Query("company_id"); v != "" {
+		cID, err := strconv.ParseUint(v, 10, 64)
+		if err != nil {
+			return model.JobFilter{}, err
+		}
+		filter.CompanyID = uint(cID)
+	}
+
+	filter.Location, err = parseUintList(c.QueryArray("location"))
+	if err != nil {
+		return model.JobFilter{}, err
+	}
+	filter.TechnologyStack, err = parseUintList(c.QueryArray("technology_stack"))
+	if err != nil {
+		return model.JobFilter{}, err
+	}
+	filter.Jobtype, err = parseUintList(c.QueryArray("job_type"))
+	if err != nil {
+		return model.JobFilter{}, err
+	}
+	filter.Shift, err = parseUintList(c.QueryArray("shift"))
+	if err != nil {
+		return model.JobFilter{}, err
+	}
+
+	filter.MinExperience, err = parseOptionalInt(c.Query("min_experience"))
+	if err != nil {
+		return model.JobFilter{}, err
+	}
+	filter.MaxExperience, err = parseOptionalInt(c.Query("max_experience"))
+	if err != nil {
+		return model.JobFilter{}, err
+	}
+	filter.NoticePeriod, err = parseOptionalInt(c.Query("notice_period"))
+	if err != nil {
+		return model.JobFilter{}, err
+	}
+	filter.MinSalary, err = parseOptionalFloat(c.Query("min_salary"))
+	if err != nil {
+		return model.JobFilter{}, err
+	}
+	filter.MaxSalary, err = parseOptionalFloat(c.Query("max_salary"))
+	if err != nil {
+		return model.JobFilter{}, err
+	}
+
+	for _, v := range c.QueryArray("work_mode") {
+		for _, mode := range strings.Split(v, ",") {
+			mode = strings.TrimSpace(mode)
+			if mode != "" {
+				filter.WorkMode = append(filter.WorkMode, mode)
+			}
+		}
+	}
+	filter.Latitude, err = parseOptionalFloat(c.Query("latitude"))
+	if err != nil {
+		return model.JobFilter{}, err
+	}
+	filter.Longitude, err = parseOptionalFloat(c.Query("longitude"))
+	if err != nil {
+		return model.JobFilter{}, err
+	}
+	filter.RadiusKm, err = parseOptionalFloat(c.Query("radius_km"))
+	if err != nil {
+		return model.JobFilter{}, err
+	}
+	if v := c.Query("include_remote"); v != "" {
+		filter.IncludeRemote, err = strconv.ParseBool(v)
+		if err != nil {
+			return model.JobFilter{}, err
+		}
+	}
+
+	if v := c.Query("limit"); v != "" {
+		filter.Limit, err = strconv.Atoi(v)
+		if err != nil {
+			return model.JobFilter{}, err
+		}
+	}
+
+	filter.Sort = c.Query("sort")
+	filter.Cursor = c.Query("cursor")
+
+	return filter, nil
+}
+
+func parseUintList(values []string) ([]uint, error) {
+	var ids []uint
+	for _, v := range values {
+		for _, part := range strings.Split(v, ",") {
+			part = strings.TrimSpace(part)
+			if part == "" {
+				continue
+			}
+			id, err := strconv.ParseUint(part, 10, 64)
+			if err != nil {
+				return nil, err
+			}
+			ids = append(ids, uint(id))
+		}
+	}
+	return ids, nil
+}
+
+func parseOptionalInt(value string) (*int, error) {
+	if value == "" {
+		return nil, nil
+	}
+	v, err := strconv.Atoi(value)
+	if err != nil {
+		return nil, err
+	}
+	return &v, nil
+}
+
+func parseOptionalFloat(value string) (*float64, error) {
+	if value == "" {
+		return nil, nil
+	}
+	v, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return nil, err
+	}
+	return &v, nil
+}
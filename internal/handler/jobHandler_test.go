@@ -55,6 +55,38 @@ func TestHandler_CreateJobByCompanyID(t *testing.T) {
 			expectedStatusCode: http.StatusUnauthorized,
 			expectedResponse:   `{"error ":"Unauthorized"}`,
 		},
+		{
+			name: "invalid user id in token",
+			setup: func() (*gin.Context, *httptest.ResponseRecorder, service.JobService) {
+				rr := httptest.NewRecorder()
+				c, _ := gin.CreateTestContext(rr)
+				httpRequest, _ := http.NewRequest(http.MethodGet, "http://test.com", strings.NewReader(
+					`{
+						"jobName": "software testing",
+						"minNoticePeriod": 1,
+						"maxNoticePeriod": 50,
+						"location": [1,2],
+						"technologyStack": [1, 2],
+						"description": "Exciting job opportunity for a software Developer...",
+						"minExperience": 1,
+						"maxExperience": 6,
+						"qualifications": [1, 2],
+						"shifts": [1,2],
+						"jobtype": [1,2]
+					  }`,
+				))
+				ctx := httpRequest.Context()
+				ctx = context.WithValue(ctx, middleware.TraceIDKey, "123")
+				ctx = context.WithValue(ctx, authentication.AuthKey, jwt.RegisteredClaims{Subject: "admin"})
+				httpRequest = httpRequest.WithContext(ctx)
+				c.Params = append(c.Params, gin.Param{Key: "id", Value: "1"})
+				c.Request = httpRequest
+
+				return c, rr, nil
+			},
+			expectedStatusCode: http.StatusUnauthorized,
+			expectedResponse:   `{"error ":"Unauthorized"}`,
+		},
 		{
 			name: "invalid id",
 			setup: func() (*gin.Context, *httptest.ResponseRecorder, service.JobService) {
@@ -77,7 +109,7 @@ func TestHandler_CreateJobByCompanyID(t *testing.T) {
 				))
 				ctx := httpRequest.Context()
 				ctx = context.WithValue(ctx, middleware.TraceIDKey, "123")
-				ctx = context.WithValue(ctx, authentication.AuthKey, jwt.RegisteredClaims{})
+				ctx = context.WithValue(ctx, authentication.AuthKey, jwt.RegisteredClaims{Subject: "8"})
 				httpRequest = httpRequest.WithContext(ctx)
 				c.Params = append(c.Params, gin.Param{Key: "id", Value: "abc"})
 				c.Request = httpRequest
@@ -109,7 +141,7 @@ func TestHandler_CreateJobByCompanyID(t *testing.T) {
 				))
 				ctx := httpRequest.Context()
 				ctx = context.WithValue(ctx, middleware.TraceIDKey, "123")
-				ctx = context.WithValue(ctx, authentication.AuthKey, jwt.RegisteredClaims{})
+				ctx = context.WithValue(ctx, authentication.AuthKey, jwt.RegisteredClaims{Subject: "8"})
 				httpRequest = httpRequest.WithContext(ctx)
 				c.Params = append(c.Params, gin.Param{Key: "id", Value: "1"})
 				c.Request = httpRequest
@@ -141,7 +173,7 @@ func TestHandler_CreateJobByCompanyID(t *testing.T) {
 				))
 				ctx := httpRequest.Context()
 				ctx = context.WithValue(ctx, middleware.TraceIDKey, "123")
-				ctx = context.WithValue(ctx, authentication.AuthKey, jwt.RegisteredClaims{})
+				ctx = context.WithValue(ctx, authentication.AuthKey, jwt.RegisteredClaims{Subject: "8"})
 				httpRequest = httpRequest.WithContext(ctx)
 				c.Params = append(c.Params, gin.Param{Key: "id", Value: "1"})
 				c.Request = httpRequest
@@ -173,7 +205,7 @@ func TestHandler_CreateJobByCompanyID(t *testing.T) {
 				))
 				ctx := httpRequest.Context()
 				ctx = context.WithValue(ctx, middleware.TraceIDKey, "123")
-				ctx = context.WithValue(ctx, authentication.AuthKey, jwt.RegisteredClaims{})
+				ctx = context.WithValue(ctx, authentication.AuthKey, jwt.RegisteredClaims{Subject: "8"})
 				httpRequest = httpRequest.WithContext(ctx)
 				c.Params = append(c.Params, gin.Param{Key: "id", Value: "abc"})
 				c.Request = httpRequest
@@ -183,6 +215,43 @@ func TestHandler_CreateJobByCompanyID(t *testing.T) {
 			expectedStatusCode: http.StatusBadRequest,
 			expectedResponse:   `{"error ":"Bad Request"}`,
 		},
+		{
+			name: "not the company owner",
+			setup: func() (*gin.Context, *httptest.ResponseRecorder, service.JobService) {
+				rr := httptest.NewRecorder()
+				c, _ := gin.CreateTestContext(rr)
+				httpRequest, _ := http.NewRequest(http.MethodGet, "http://test.com", strings.NewReader(
+					`{
+						"jobName": "software testing",
+						"minNoticePeriod": 1,
+						"maxNoticePeriod": 50,
+						"location": [1,2],
+						"technologyStack": [1, 2],
+						"description": "Exciting job opportunity for a software Developer...",
+						"minExperience": 1,
+						"maxExperience": 6,
+						"qualifications": [1, 2],
+						"shifts": [1,2],
+						"jobtype": [1,2]
+					  }`,
+				))
+				ctx := httpRequest.Context()
+				ctx = context.WithValue(ctx, middleware.TraceIDKey, "123")
+				ctx = context.WithValue(ctx, authentication.AuthKey, jwt.RegisteredClaims{Subject: "8"})
+				httpRequest = httpRequest.WithContext(ctx)
+				c.Params = append(c.Params, gin.Param{Key: "id", Value: "1"})
+				c.Request = httpRequest
+
+				mc := gomock.NewController(t)
+				mj := service.NewMockJobService(mc)
+
+				mj.EXPECT().CreateJobByCompanyId(uint(8), gomock.Any(), gomock.Any()).Return(model.Response{}, service.ErrForbidden).AnyTimes()
+
+				return c, rr, mj
+			},
+			expectedStatusCode: http.StatusForbidden,
+			expectedResponse:   `{"error ":"Forbidden"}`,
+		},
 		{
 			name: "failure",
 			setup: func() (*gin.Context, *httptest.ResponseRecorder, service.JobService) {
@@ -205,7 +274,7 @@ func TestHandler_CreateJobByCompanyID(t *testing.T) {
 				))
 				ctx := httpRequest.Context()
 				ctx = context.WithValue(ctx, middleware.TraceIDKey, "123")
-				ctx = context.WithValue(ctx, authentication.AuthKey, jwt.RegisteredClaims{})
+				ctx = context.WithValue(ctx, authentication.AuthKey, jwt.RegisteredClaims{Subject: "8"})
 				httpRequest = httpRequest.WithContext(ctx)
 				c.Params = append(c.Params, gin.Param{Key: "id", Value: "1"})
 				c.Request = httpRequest
@@ -213,13 +282,53 @@ func TestHandler_CreateJobByCompanyID(t *testing.T) {
 				mc := gomock.NewController(t)
 				mj := service.NewMockJobService(mc)
 
-				mj.EXPECT().CreateJobByCompanyId(gomock.Any(), gomock.Any()).Return(model.Response{}, errors.New("error")).AnyTimes()
+				mj.EXPECT().CreateJobByCompanyId(uint(8), gomock.Any(), gomock.Any()).Return(model.Response{}, errors.New("error")).AnyTimes()
 
 				return c, rr, mj
 			},
 			expectedStatusCode: http.StatusInternalServerError,
 			expectedResponse:   `{"error ":"Internal Server Error"}`,
 		},
+		{
+			name: "invalid job details",
+			setup: func() (*gin.Context, *httptest.ResponseRecorder, service.JobService) {
+				rr := httptest.NewRecorder()
+				c, _ := gin.CreateTestContext(rr)
+				httpRequest, _ := http.NewRequest(http.MethodGet, "http://test.com", strings.NewReader(
+					`{
+						"jobName": "software testing",
+						"minNoticePeriod": 1,
+						"maxNoticePeriod": 50,
+						"location": [1,99],
+						"technologyStack": [1, 2],
+						"description": "Exciting job opportunity for a software Developer...",
+						"minExperience": 8,
+						"maxExperience": 6,
+						"qualifications": [1, 2],
+						"shifts": [1,2],
+						"jobtype": [1,2]
+					  }`,
+				))
+				ctx := httpRequest.Context()
+				ctx = context.WithValue(ctx, middleware.TraceIDKey, "123")
+				ctx = context.WithValue(ctx, authentication.AuthKey, jwt.RegisteredClaims{Subject: "8"})
+				httpRequest = httpRequest.WithContext(ctx)
+				c.Params = append(c.Params, gin.Param{Key: "id", Value: "1"})
+				c.Request = httpRequest
+
+				mc := gomock.NewController(t)
+				mj := service.NewMockJobService(mc)
+
+				mj.EXPECT().CreateJobByCompanyId(uint(8), gomock.Any(), gomock.Any()).Return(model.Response{}, &service.ValidationError{Errors: []model.FieldError{
+					{Field: "minExperience", Message: "cannot be greater than maxExperience"},
+					{Field: "location", Message: "unknown ids [99]"},
+				}})
+
+				return c, rr, mj
+			},
+			expectedStatusCode: http.StatusBadRequest,
+			expectedResponse:   `{"errors":[{"field":"minExperience","message":"cannot be greater than maxExperience"},{"field":"location","message":"unknown ids [99]"}]}`,
+		},
 		{
 			name: "success",
 			setup: func() (*gin.Context, *httptest.ResponseRecorder, service.JobService) {
@@ -242,7 +351,7 @@ func TestHandler_CreateJobByCompanyID(t *testing.T) {
 				))
 				ctx := httpRequest.Context()
 				ctx = context.WithValue(ctx, middleware.TraceIDKey, "1")
-				ctx = context.WithValue(ctx, authentication.AuthKey, jwt.RegisteredClaims{})
+				ctx = context.WithValue(ctx, authentication.AuthKey, jwt.RegisteredClaims{Subject: "8"})
 				httpRequest = httpRequest.WithContext(ctx)
 				c.Params = append(c.Params, gin.Param{Key: "id", Value: "1"})
 				c.Request = httpRequest
@@ -250,7 +359,7 @@ func TestHandler_CreateJobByCompanyID(t *testing.T) {
 				mc := gomock.NewController(t)
 				mj := service.NewMockJobService(mc)
 
-				mj.EXPECT().CreateJobByCompanyId(gomock.Any(), gomock.Any()).Return(model.Response{}, nil).AnyTimes()
+				mj.EXPECT().CreateJobByCompanyId(uint(8), gomock.Any(), gomock.Any()).Return(model.Response{}, nil).AnyTimes()
 
 				return c, rr, mj
 			},
@@ -556,7 +665,7 @@ func TestHandler_ViewAllJobs(t *testing.T) {
 				mc := gomock.NewController(t)
 				mj := service.NewMockJobService(mc)
 
-				mj.EXPECT().ViewAllJobs().Return(nil, errors.New("error"))
+				mj.EXPECT().ViewAllJobs(gomock.Any()).Return(model.JobPage{}, errors.New("error"))
 
 				return c, rr, mj
 			},
@@ -578,12 +687,155 @@ func TestHandler_ViewAllJobs(t *testing.T) {
 				mc := gomock.NewController(t)
 				mj := service.NewMockJobService(mc)
 
-				mj.EXPECT().ViewAllJobs().Return([]model.Job{}, nil)
+				mj.EXPECT().ViewAllJobs(model.JobFilter{}).Return(model.JobPage{Jobs: []model.Job{}}, nil)
 
 				return c, rr, mj
 			},
 			expectedStatusCode: http.StatusOK,
-			expectedResponse:   `[]`,
+			expectedResponse:   `{"jobs":[]}`,
+		},
+		{
+			name: "invalid filter",
+			setup: func() (*gin.Context, *httptest.ResponseRecorder, service.JobService) {
+				rr := httptest.NewRecorder()
+				c, _ := gin.CreateTestContext(rr)
+				httpRequest, _ := http.NewRequest(http.MethodGet, "http://test.com/api/get_jobs?location=1,abc", nil)
+				ctx := httpRequest.Context()
+				ctx = context.WithValue(ctx, middleware.TraceIDKey, "123")
+				ctx = context.WithValue(ctx, authentication.AuthKey, jwt.RegisteredClaims{})
+				httpRequest = httpRequest.WithContext(ctx)
+				c.Request = httpRequest
+
+				return c, rr, nil
+			},
+			expectedStatusCode: http.StatusBadRequest,
+			expectedResponse:   `{"error":"Bad Request"}`,
+		},
+		{
+			name: "success with filters",
+			setup: func() (*gin.Context, *httptest.ResponseRecorder, service.JobService) {
+				rr := httptest.NewRecorder()
+				c, _ := gin.CreateTestContext(rr)
+				httpRequest, _ := http.NewRequest(http.MethodGet, "http://test.com/api/get_jobs?company_id=4&location=1,2&location=3&technology_stack=5&min_experience=2&notice_period=30&sort=experience&limit=10&cursor=abc", nil)
+				ctx := httpRequest.Context()
+				ctx = context.WithValue(ctx, middleware.TraceIDKey, "123")
+				ctx = context.WithValue(ctx, authentication.AuthKey, jwt.RegisteredClaims{})
+				httpRequest = httpRequest.WithContext(ctx)
+				c.Request = httpRequest
+
+				mc := gomock.NewController(t)
+				mj := service.NewMockJobService(mc)
+
+				minExperience := 2
+				noticePeriod := 30
+				mj.EXPECT().ViewAllJobs(model.JobFilter{
+					CompanyID:       4,
+					Location:        []uint{1, 2, 3},
+					TechnologyStack: []uint{5},
+					MinExperience:   &minExperience,
+					NoticePeriod:    &noticePeriod,
+					Sort:            model.JobSortExperience,
+					Cursor:          "abc",
+					Limit:           10,
+				}).Return(model.JobPage{Jobs: []model.Job{}, NextCursor: "def"}, nil)
+
+				return c, rr, mj
+			},
+			expectedStatusCode: http.StatusOK,
+			expectedResponse:   `{"jobs":[],"next_cursor":"def"}`,
+		},
+		{
+			name: "success with salary filters",
+			setup: func() (*gin.Context, *httptest.ResponseRecorder, service.JobService) {
+				rr := httptest.NewRecorder()
+				c, _ := gin.CreateTestContext(rr)
+				httpRequest, _ := http.NewRequest(http.MethodGet, "http://test.com/api/get_jobs?min_salary=600000&max_salary=1500000.5&sort=salary", nil)
+				ctx := httpRequest.Context()
+				ctx = context.WithValue(ctx, middleware.TraceIDKey, "123")
+				ctx = context.WithValue(ctx, authentication.AuthKey, jwt.RegisteredClaims{})
+				httpRequest = httpRequest.WithContext(ctx)
+				c.Request = httpRequest
+
+				mc := gomock.NewController(t)
+				mj := service.NewMockJobService(mc)
+
+				minSalary := 600000.0
+				maxSalary := 1500000.5
+				mj.EXPECT().ViewAllJobs(model.JobFilter{
+					MinSalary: &minSalary,
+					MaxSalary: &maxSalary,
+					Sort:      model.JobSortSalary,
+				}).Return(model.JobPage{Jobs: []model.Job{}}, nil)
+
+				return c, rr, mj
+			},
+			expectedStatusCode: http.StatusOK,
+			expectedResponse:   `{"jobs":[]}`,
+		},
+		{
+			name: "success with radius filters",
+			setup: func() (*gin.Context, *httptest.ResponseRecorder, service.JobService) {
+				rr := httptest.NewRecorder()
+				c, _ := gin.CreateTestContext(rr)
+				httpRequest, _ := http.NewRequest(http.MethodGet, "http://test.com/api/get_jobs?latitude=12.97&longitude=77.59&radius_km=25&include_remote=true&work_mode=remote,hybrid", nil)
+				ctx := httpRequest.Context()
+				ctx = context.WithValue(ctx, middleware.TraceIDKey, "123")
+				ctx = context.WithValue(ctx, authentication.AuthKey, jwt.RegisteredClaims{})
+				httpRequest = httpRequest.WithContext(ctx)
+				c.Request = httpRequest
+
+				mc := gomock.NewController(t)
+				mj := service.NewMockJobService(mc)
+
+				latitude := 12.97
+				longitude := 77.59
+				radius := 25.0
+				mj.EXPECT().ViewAllJobs(model.JobFilter{
+					WorkMode:      []string{model.WorkModeRemote, model.WorkModeHybrid},
+					Latitude:      &latitude,
+					Longitude:     &longitude,
+					RadiusKm:      &radius,
+					IncludeRemote: true,
+				}).Return(model.JobPage{Jobs: []model.Job{}}, nil)
+
+				return c, rr, mj
+			},
+			expectedStatusCode: http.StatusOK,
+			expectedResponse:   `{"jobs":[]}`,
+		},
+		{
+			name: "invalid include remote flag",
+			setup: func() (*gin.Context, *httptest.ResponseRecorder, service.JobService) {
+				rr := httptest.NewRecorder()
+				c, _ := gin.CreateTestContext(rr)
+				httpRequest, _ := http.NewRequest(http.MethodGet, "http://test.com/api/get_jobs?include_remote=maybe", nil)
+				ctx := httpRequest.Context()
+				ctx = context.WithValue(ctx, middleware.TraceIDKey, "123")
+				ctx = context.WithValue(ctx, authentication.AuthKey, jwt.RegisteredClaims{})
+				httpRequest = httpRequest.WithContext(ctx)
+				c.Request = httpRequest
+
+				return c, rr, nil
+			},
+			expectedStatusCode: http.StatusBadRequest,
+			expectedResponse:   `{"error":"Bad Request"}`,
+		},
+		{
+			name: "invalid salary filter",
+			setup: func() (*gin.Context, *httptest.ResponseRecorder, service.JobService) {
+				rr := httptest.NewRecorder()
+				c, _ := gin.CreateTestContext(rr)
+				httpRequest, _ := http.NewRequest(http.MethodGet, "http://test.com/api/get_jobs?min_salary=lots", nil)
+				ctx := httpRequest.Context()
+				ctx = context.WithValue(ctx, middleware.TraceIDKey, "123")
+				ctx = context.WithValue(ctx, authentication.AuthKey, jwt.RegisteredClaims{})
+				httpRequest = httpRequest.WithContext(ctx)
+				c.Request = httpRequest
+
+				return c, rr, nil
+			},
+			expectedStatusCode: http.StatusBadRequest,
+			expectedResponse:   `{"error":"Bad Request"}`,
 		},
 	}
 	for _, tt := range tests {
@@ -600,7 +852,7 @@ func TestHandler_ViewAllJobs(t *testing.T) {
 	}
 }
 
-func TestHandler_ProcessJobApplication(t *testing.T) {
+func TestHandler_SearchJobs(t *testing.T) {
 	tests := []struct {
 		name               string
 		setup              func() (*gin.Context, *httptest.ResponseRecorder, service.JobService)
@@ -610,12 +862,12 @@ func TestHandler_ProcessJobApplication(t *testing.T) {
 		{
 			name: "missing trace id",
 			setup: func() (*gin.Context, *httptest.ResponseRecorder, service.JobService) {
-				hr := httptest.NewRecorder()
-				c, _ := gin.CreateTestContext(hr)
+				rr := httptest.NewRecorder()
+				c, _ := gin.CreateTestContext(rr)
 				httpRequest, _ := http.NewRequest(http.MethodGet, "http://test.com", nil)
 				c.Request = httpRequest
 
-				return c, hr, nil
+				return c, rr, nil
 			},
 			expectedStatusCode: http.StatusInternalServerError,
 			expectedResponse:   `{"error":"Internal Server Error"}`,
@@ -628,7 +880,6 @@ func TestHandler_ProcessJobApplication(t *testing.T) {
 				httpRequest, _ := http.NewRequest(http.MethodGet, "http://test.com", nil)
 				ctx := httpRequest.Context()
 				ctx = context.WithValue(ctx, middleware.TraceIDKey, "123")
-
 				httpRequest = httpRequest.WithContext(ctx)
 				c.Request = httpRequest
 
@@ -638,58 +889,188 @@ func TestHandler_ProcessJobApplication(t *testing.T) {
 			expectedResponse:   `{"error":"Unauthorized"}`,
 		},
 		{
-			name: "error in decoding",
+			name: "missing search query",
 			setup: func() (*gin.Context, *httptest.ResponseRecorder, service.JobService) {
 				rr := httptest.NewRecorder()
 				c, _ := gin.CreateTestContext(rr)
-				httpRequest, _ := http.NewRequest(http.MethodGet, "http://test.com", strings.NewReader(
-					`
-										[
-						{
-							"name": "John Doe 1",
-							"age": "30",
-							"jid": 7,
-							"job_application": {
-								"noticePeriod": 100,
-								"location": [
-									1,
-									3
-								],
-								"technologyStack": [
-									1,
-									3
-								],
-								"experience": 90,
-								"qualifications": [
-									1,
-									2
-								],
-								"shifts": [
-									1,
-									2
-								],
-								"jobtype": [
-									1,
-									2
-								]
-							}
-						},
-						{
-							"name": "John Doe 2",
-							"age": "30",
-							"jid": 8,
-							"job_application": {
-								"noticePeriod": 30,
-								"location": [
-									1,
-									2
-								],
-								"technologyStack": [
-									1,
-									2
-								],
-								"experience": 5,
-								"qualifications": [
+				httpRequest, _ := http.NewRequest(http.MethodGet, "http://test.com/api/search_jobs", nil)
+				ctx := httpRequest.Context()
+				ctx = context.WithValue(ctx, middleware.TraceIDKey, "123")
+				ctx = context.WithValue(ctx, authentication.AuthKey, jwt.RegisteredClaims{})
+				httpRequest = httpRequest.WithContext(ctx)
+				c.Request = httpRequest
+
+				return c, rr, nil
+			},
+			expectedStatusCode: http.StatusBadRequest,
+			expectedResponse:   `{"error":"Bad Request"}`,
+		},
+		{
+			name: "invalid offset",
+			setup: func() (*gin.Context, *httptest.ResponseRecorder, service.JobService) {
+				rr := httptest.NewRecorder()
+				c, _ := gin.CreateTestContext(rr)
+				httpRequest, _ := http.NewRequest(http.MethodGet, "http://test.com/api/search_jobs?q=golang&offset=abc", nil)
+				ctx := httpRequest.Context()
+				ctx = context.WithValue(ctx, middleware.TraceIDKey, "123")
+				ctx = context.WithValue(ctx, authentication.AuthKey, jwt.RegisteredClaims{})
+				httpRequest = httpRequest.WithContext(ctx)
+				c.Request = httpRequest
+
+				return c, rr, nil
+			},
+			expectedStatusCode: http.StatusBadRequest,
+			expectedResponse:   `{"error":"Bad Request"}`,
+		},
+		{
+			name: "failure",
+			setup: func() (*gin.Context, *httptest.ResponseRecorder, service.JobService) {
+				rr := httptest.NewRecorder()
+				c, _ := gin.CreateTestContext(rr)
+				httpRequest, _ := http.NewRequest(http.MethodGet, "http://test.com/api/search_jobs?q=golang", nil)
+				ctx := httpRequest.Context()
+				ctx = context.WithValue(ctx, middleware.TraceIDKey, "123")
+				ctx = context.WithValue(ctx, authentication.AuthKey, jwt.RegisteredClaims{})
+				httpRequest = httpRequest.WithContext(ctx)
+				c.Request = httpRequest
+
+				mc := gomock.NewController(t)
+				mj := service.NewMockJobService(mc)
+
+				mj.EXPECT().SearchJobs("golang", gomock.Any(), 0).Return(model.JobSearchPage{}, errors.New("error"))
+
+				return c, rr, mj
+			},
+			expectedStatusCode: http.StatusBadRequest,
+			expectedResponse:   `{"error":"Bad Request"}`,
+		},
+		{
+			name: "success",
+			setup: func() (*gin.Context, *httptest.ResponseRecorder, service.JobService) {
+				rr := httptest.NewRecorder()
+				c, _ := gin.CreateTestContext(rr)
+				httpRequest, _ := http.NewRequest(http.MethodGet, "http://test.com/api/search_jobs?q=golang+developer&location=2&offset=20", nil)
+				ctx := httpRequest.Context()
+				ctx = context.WithValue(ctx, middleware.TraceIDKey, "123")
+				ctx = context.WithValue(ctx, authentication.AuthKey, jwt.RegisteredClaims{})
+				httpRequest = httpRequest.WithContext(ctx)
+				c.Request = httpRequest
+
+				mc := gomock.NewController(t)
+				mj := service.NewMockJobService(mc)
+
+				mj.EXPECT().SearchJobs("golang developer", model.JobFilter{Location: []uint{2}}, 20).Return(model.JobSearchPage{Results: []model.JobSearchResult{}}, nil)
+
+				return c, rr, mj
+			},
+			expectedStatusCode: http.StatusOK,
+			expectedResponse:   `{"results":[]}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gin.SetMode(gin.TestMode)
+			c, rr, mj := tt.setup()
+			h := Handler{
+				serviceJob: mj,
+			}
+			h.SearchJobs(c)
+			assert.Equal(t, tt.expectedStatusCode, rr.Code)
+			assert.Equal(t, tt.expectedResponse, rr.Body.String())
+		})
+	}
+}
+
+func TestHandler_ProcessJobApplication(t *testing.T) {
+	tests := []struct {
+		name               string
+		setup              func() (*gin.Context, *httptest.ResponseRecorder, service.JobService)
+		expectedStatusCode int
+		expectedResponse   string
+	}{
+		{
+			name: "missing trace id",
+			setup: func() (*gin.Context, *httptest.ResponseRecorder, service.JobService) {
+				hr := httptest.NewRecorder()
+				c, _ := gin.CreateTestContext(hr)
+				httpRequest, _ := http.NewRequest(http.MethodGet, "http://test.com", nil)
+				c.Request = httpRequest
+
+				return c, hr, nil
+			},
+			expectedStatusCode: http.StatusInternalServerError,
+			expectedResponse:   `{"error":"Internal Server Error"}`,
+		},
+		{
+			name: "missing jwt claims",
+			setup: func() (*gin.Context, *httptest.ResponseRecorder, service.JobService) {
+				rr := httptest.NewRecorder()
+				c, _ := gin.CreateTestContext(rr)
+				httpRequest, _ := http.NewRequest(http.MethodGet, "http://test.com", nil)
+				ctx := httpRequest.Context()
+				ctx = context.WithValue(ctx, middleware.TraceIDKey, "123")
+
+				httpRequest = httpRequest.WithContext(ctx)
+				c.Request = httpRequest
+
+				return c, rr, nil
+			},
+			expectedStatusCode: http.StatusUnauthorized,
+			expectedResponse:   `{"error":"Unauthorized"}`,
+		},
+		{
+			name: "error in decoding",
+			setup: func() (*gin.Context, *httptest.ResponseRecorder, service.JobService) {
+				rr := httptest.NewRecorder()
+				c, _ := gin.CreateTestContext(rr)
+				httpRequest, _ := http.NewRequest(http.MethodGet, "http://test.com", strings.NewReader(
+					`
+										[
+						{
+							"name": "John Doe 1",
+							"age": "30",
+							"jid": 7,
+							"job_application": {
+								"noticePeriod": 100,
+								"location": [
+									1,
+									3
+								],
+								"technologyStack": [
+									1,
+									3
+								],
+								"experience": 90,
+								"qualifications": [
+									1,
+									2
+								],
+								"shifts": [
+									1,
+									2
+								],
+								"jobtype": [
+									1,
+									2
+								]
+							}
+						},
+						{
+							"name": "John Doe 2",
+							"age": "30",
+							"jid": 8,
+							"job_application": {
+								"noticePeriod": 30,
+								"location": [
+									1,
+									2
+								],
+								"technologyStack": [
+									1,
+									2
+								],
+								"experience": 5,
+								"qualifications": [
 									1,
 									2
 								],
@@ -795,13 +1176,13 @@ func TestHandler_ProcessJobApplication(t *testing.T) {
 				mc := gomock.NewController(t)
 				mj := service.NewMockJobService(mc)
 
-				mj.EXPECT().ProcessApplication(gomock.Any()).Return(nil).AnyTimes()
+				mj.EXPECT().ProcessApplication(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
 
 				return c, rr, mj
 
 			},
 			expectedStatusCode: http.StatusBadRequest,
-			expectedResponse:   `{"error all applications rejected ":"Bad Request"}`,
+			expectedResponse:   `{"error no application could be screened ":"Bad Request"}`,
 		},
 		{
 			name: "error in decoding",
@@ -881,12 +1262,12 @@ func TestHandler_ProcessJobApplication(t *testing.T) {
 				mc := gomock.NewController(t)
 				mj := service.NewMockJobService(mc)
 
-				mj.EXPECT().ProcessApplication(gomock.Any()).Return(nil).AnyTimes()
+				mj.EXPECT().ProcessApplication(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
 
 				return c, rr, mj
 			},
 			expectedStatusCode: http.StatusBadRequest,
-			expectedResponse:   `{"error all applications rejected ":"Bad Request"}`,
+			expectedResponse:   `{"error no application could be screened ":"Bad Request"}`,
 		},
 		{
 			name: "error in decoding",
@@ -966,12 +1347,24 @@ func TestHandler_ProcessJobApplication(t *testing.T) {
 				mc := gomock.NewController(t)
 				mj := service.NewMockJobService(mc)
 
-				mj.EXPECT().ProcessApplication(gomock.Any()).Return([]model.NewUserApplication{}).AnyTimes()
+				mj.EXPECT().ProcessApplication(gomock.Any(), gomock.Any()).Return([]model.ScreenedApplication{
+					{
+						NewUserApplication: model.NewUserApplication{Name: "John Doe 1", Age: "30", Jid: 7},
+						Screening: model.ScreeningResult{
+							Score:     1,
+							MaxScore:  7,
+							Threshold: 3.5,
+							Criteria: []model.CriterionResult{
+								{Criterion: model.CriterionLocation, Matched: true, Weight: 1, Score: 1, Reason: "matches [1] of [1 2]"},
+							},
+						},
+					},
+				}).AnyTimes()
 
 				return c, rr, mj
 			},
 			expectedStatusCode: http.StatusOK,
-			expectedResponse:   `[]`,
+			expectedResponse:   `[{"name":"John Doe 1","age":"30","jid":7,"job_application":{"noticePeriod":0,"location":null,"technologyStack":null,"experience":0,"qualifications":null,"shifts":null,"jobtype":null,"skills":null},"screening":{"accepted":false,"score":1,"max_score":7,"threshold":3.5,"knocked_out":false,"criteria":[{"criterion":"location","matched":true,"weight":1,"score":1,"reason":"matches [1] of [1 2]"}]}}]`,
 		},
 	}
 	for _, tt := range tests {
@@ -987,3 +1380,712 @@ func TestHandler_ProcessJobApplication(t *testing.T) {
 		})
 	}
 }
+
+func TestHandler_UpdateJob(t *testing.T) {
+	tests := []struct {
+		name               string
+		setup              func() (*gin.Context, *httptest.ResponseRecorder, service.JobService)
+		expectedStatusCode int
+		expectedResponse   string
+	}{
+		{
+			name: "missing trace id",
+			setup: func() (*gin.Context, *httptest.ResponseRecorder, service.JobService) {
+				rr := httptest.NewRecorder()
+				c, _ := gin.CreateTestContext(rr)
+				httpRequest, _ := http.NewRequest(http.MethodPatch, "http://test.com", nil)
+				c.Request = httpRequest
+
+				return c, rr, nil
+			},
+			expectedStatusCode: http.StatusInternalServerError,
+			expectedResponse:   `{"error":"Internal Server Error"}`,
+		},
+		{
+			name: "missing jwt claims",
+			setup: func() (*gin.Context, *httptest.ResponseRecorder, service.JobService) {
+				rr := httptest.NewRecorder()
+				c, _ := gin.CreateTestContext(rr)
+				httpRequest, _ := http.NewRequest(http.MethodPatch, "http://test.com", nil)
+				ctx := httpRequest.Context()
+				ctx = context.WithValue(ctx, middleware.TraceIDKey, "123")
+				httpRequest = httpRequest.WithContext(ctx)
+				c.Request = httpRequest
+
+				return c, rr, nil
+			},
+			expectedStatusCode: http.StatusUnauthorized,
+			expectedResponse:   `{"error":"Unauthorized"}`,
+		},
+		{
+			name: "invalid user id in token",
+			setup: func() (*gin.Context, *httptest.ResponseRecorder, service.JobService) {
+				rr := httptest.NewRecorder()
+				c, _ := gin.CreateTestContext(rr)
+				httpRequest, _ := http.NewRequest(http.MethodPatch, "http://test.com", strings.NewReader(`{"jobName": "golang developer"}`))
+				ctx := httpRequest.Context()
+				ctx = context.WithValue(ctx, middleware.TraceIDKey, "123")
+				ctx = context.WithValue(ctx, authentication.AuthKey, jwt.RegisteredClaims{Subject: "admin"})
+				httpRequest = httpRequest.WithContext(ctx)
+				c.Params = append(c.Params, gin.Param{Key: "id", Value: "1"})
+				c.Request = httpRequest
+
+				return c, rr, nil
+			},
+			expectedStatusCode: http.StatusUnauthorized,
+			expectedResponse:   `{"error":"Unauthorized"}`,
+		},
+		{
+			name: "invalid job id",
+			setup: func() (*gin.Context, *httptest.ResponseRecorder, service.JobService) {
+				rr := httptest.NewRecorder()
+				c, _ := gin.CreateTestContext(rr)
+				httpRequest, _ := http.NewRequest(http.MethodPatch, "http://test.com", strings.NewReader(`{"jobName": "golang developer"}`))
+				ctx := httpRequest.Context()
+				ctx = context.WithValue(ctx, middleware.TraceIDKey, "123")
+				ctx = context.WithValue(ctx, authentication.AuthKey, jwt.RegisteredClaims{Subject: "8"})
+				httpRequest = httpRequest.WithContext(ctx)
+				c.Params = append(c.Params, gin.Param{Key: "id", Value: "abc"})
+				c.Request = httpRequest
+
+				return c, rr, nil
+			},
+			expectedStatusCode: http.StatusBadRequest,
+			expectedResponse:   `{"error":"Bad Request"}`,
+		},
+		{
+			name: "error in decoding",
+			setup: func() (*gin.Context, *httptest.ResponseRecorder, service.JobService) {
+				rr := httptest.NewRecorder()
+				c, _ := gin.CreateTestContext(rr)
+				httpRequest, _ := http.NewRequest(http.MethodPatch, "http://test.com", strings.NewReader(`{jobName": "golang developer"}`))
+				ctx := httpRequest.Context()
+				ctx = context.WithValue(ctx, middleware.TraceIDKey, "123")
+				ctx = context.WithValue(ctx, authentication.AuthKey, jwt.RegisteredClaims{Subject: "8"})
+				httpRequest = httpRequest.WithContext(ctx)
+				c.Params = append(c.Params, gin.Param{Key: "id", Value: "1"})
+				c.Request = httpRequest
+
+				return c, rr, nil
+			},
+			expectedStatusCode: http.StatusBadRequest,
+			expectedResponse:   `{"error":"Bad Request"}`,
+		},
+		{
+			name: "error in validating",
+			setup: func() (*gin.Context, *httptest.ResponseRecorder, service.JobService) {
+				rr := httptest.NewRecorder()
+				c, _ := gin.CreateTestContext(rr)
+				httpRequest, _ := http.NewRequest(http.MethodPatch, "http://test.com", strings.NewReader(`{"workMode": "office", "payPeriod": "weekly"}`))
+				ctx := httpRequest.Context()
+				ctx = context.WithValue(ctx, middleware.TraceIDKey, "123")
+				ctx = context.WithValue(ctx, authentication.AuthKey, jwt.RegisteredClaims{Subject: "8"})
+				httpRequest = httpRequest.WithContext(ctx)
+				c.Params = append(c.Params, gin.Param{Key: "id", Value: "1"})
+				c.Request = httpRequest
+
+				return c, rr, nil
+			},
+			expectedStatusCode: http.StatusBadRequest,
+			expectedResponse:   `{"error":"Bad Request"}`,
+		},
+		{
+			name: "not the company owner",
+			setup: func() (*gin.Context, *httptest.ResponseRecorder, service.JobService) {
+				rr := httptest.NewRecorder()
+				c, _ := gin.CreateTestContext(rr)
+				httpRequest, _ := http.NewRequest(http.MethodPatch, "http://test.com", strings.NewReader(`{"jobName": "golang developer"}`))
+				ctx := httpRequest.Context()
+				ctx = context.WithValue(ctx, middleware.TraceIDKey, "123")
+				ctx = context.WithValue(ctx, authentication.AuthKey, jwt.RegisteredClaims{Subject: "8"})
+				httpRequest = httpRequest.WithContext(ctx)
+				c.Params = append(c.Params, gin.Param{Key: "id", Value: "1"})
+				c.Request = httpRequest
+
+				mc := gomock.NewController(t)
+				mj := service.NewMockJobService(mc)
+
+				mj.EXPECT().UpdateJobByJobID(uint(8), gomock.Any(), gomock.Any()).Return(model.Response{}, service.ErrForbidden)
+
+				return c, rr, mj
+			},
+			expectedStatusCode: http.StatusForbidden,
+			expectedResponse:   `{"error":"Forbidden"}`,
+		},
+		{
+			name: "failure",
+			setup: func() (*gin.Context, *httptest.ResponseRecorder, service.JobService) {
+				rr := httptest.NewRecorder()
+				c, _ := gin.CreateTestContext(rr)
+				httpRequest, _ := http.NewRequest(http.MethodPatch, "http://test.com", strings.NewReader(`{"jobName": "golang developer"}`))
+				ctx := httpRequest.Context()
+				ctx = context.WithValue(ctx, middleware.TraceIDKey, "123")
+				ctx = context.WithValue(ctx, authentication.AuthKey, jwt.RegisteredClaims{Subject: "8"})
+				httpRequest = httpRequest.WithContext(ctx)
+				c.Params = append(c.Params, gin.Param{Key: "id", Value: "1"})
+				c.Request = httpRequest
+
+				mc := gomock.NewController(t)
+				mj := service.NewMockJobService(mc)
+
+				mj.EXPECT().UpdateJobByJobID(uint(8), gomock.Any(), gomock.Any()).Return(model.Response{}, errors.New("error"))
+
+				return c, rr, mj
+			},
+			expectedStatusCode: http.StatusBadRequest,
+			expectedResponse:   `{"error":"Bad Request"}`,
+		},
+		{
+			name: "invalid job details",
+			setup: func() (*gin.Context, *httptest.ResponseRecorder, service.JobService) {
+				rr := httptest.NewRecorder()
+				c, _ := gin.CreateTestContext(rr)
+				httpRequest, _ := http.NewRequest(http.MethodPatch, "http://test.com", strings.NewReader(`{"minNoticePeriod": 90}`))
+				ctx := httpRequest.Context()
+				ctx = context.WithValue(ctx, middleware.TraceIDKey, "123")
+				ctx = context.WithValue(ctx, authentication.AuthKey, jwt.RegisteredClaims{Subject: "8"})
+				httpRequest = httpRequest.WithContext(ctx)
+				c.Params = append(c.Params, gin.Param{Key: "id", Value: "1"})
+				c.Request = httpRequest
+
+				mc := gomock.NewController(t)
+				mj := service.NewMockJobService(mc)
+
+				mj.EXPECT().UpdateJobByJobID(uint(8), gomock.Any(), gomock.Any()).Return(model.Response{}, &service.ValidationError{Errors: []model.FieldError{
+					{Field: "minNoticePeriod", Message: "cannot be greater than maxNoticePeriod"},
+				}})
+
+				return c, rr, mj
+			},
+			expectedStatusCode: http.StatusBadRequest,
+			expectedResponse:   `{"errors":[{"field":"minNoticePeriod","message":"cannot be greater than maxNoticePeriod"}]}`,
+		},
+		{
+			name: "success",
+			setup: func() (*gin.Context, *httptest.ResponseRecorder, service.JobService) {
+				rr := httptest.NewRecorder()
+				c, _ := gin.CreateTestContext(rr)
+				httpRequest, _ := http.NewRequest(http.MethodPatch, "http://test.com", strings.NewReader(`{"jobName": "golang developer", "location": [1, 2]}`))
+				ctx := httpRequest.Context()
+				ctx = context.WithValue(ctx, middleware.TraceIDKey, "123")
+				ctx = context.WithValue(ctx, authentication.AuthKey, jwt.RegisteredClaims{Subject: "8"})
+				httpRequest = httpRequest.WithContext(ctx)
+				c.Params = append(c.Params, gin.Param{Key: "id", Value: "1"})
+				c.Request = httpRequest
+
+				mc := gomock.NewController(t)
+				mj := service.NewMockJobService(mc)
+
+				jobname := "golang developer"
+				locations := []uint{1, 2}
+				mj.EXPECT().UpdateJobByJobID(uint(8), uint(1), model.UpdateJob{Jobname: &jobname, Location: &locations}).Return(model.Response{Id: 1}, nil)
+
+				return c, rr, mj
+			},
+			expectedStatusCode: http.StatusOK,
+			expectedResponse:   `{"id":1}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gin.SetMode(gin.TestMode)
+			c, rr, mj := tt.setup()
+			h := Handler{
+				serviceJob: mj,
+			}
+			h.UpdateJob(c)
+			assert.Equal(t, tt.expectedStatusCode, rr.Code)
+			assert.Equal(t, tt.expectedResponse, rr.Body.String())
+		})
+	}
+}
+
+func TestHandler_ReplaceJob(t *testing.T) {
+	tests := []struct {
+		name               string
+		setup              func() (*gin.Context, *httptest.ResponseRecorder, service.JobService)
+		expectedStatusCode int
+		expectedResponse   string
+	}{
+		{
+			name: "missing trace id",
+			setup: func() (*gin.Context, *httptest.ResponseRecorder, service.JobService) {
+				rr := httptest.NewRecorder()
+				c, _ := gin.CreateTestContext(rr)
+				httpRequest, _ := http.NewRequest(http.MethodPut, "http://test.com", nil)
+				c.Request = httpRequest
+
+				return c, rr, nil
+			},
+			expectedStatusCode: http.StatusInternalServerError,
+			expectedResponse:   `{"error":"Internal Server Error"}`,
+		},
+		{
+			name: "missing jwt claims",
+			setup: func() (*gin.Context, *httptest.ResponseRecorder, service.JobService) {
+				rr := httptest.NewRecorder()
+				c, _ := gin.CreateTestContext(rr)
+				httpRequest, _ := http.NewRequest(http.MethodPut, "http://test.com", nil)
+				ctx := httpRequest.Context()
+				ctx = context.WithValue(ctx, middleware.TraceIDKey, "123")
+				httpRequest = httpRequest.WithContext(ctx)
+				c.Request = httpRequest
+
+				return c, rr, nil
+			},
+			expectedStatusCode: http.StatusUnauthorized,
+			expectedResponse:   `{"error":"Unauthorized"}`,
+		},
+		{
+			name: "invalid user id in token",
+			setup: func() (*gin.Context, *httptest.ResponseRecorder, service.JobService) {
+				rr := httptest.NewRecorder()
+				c, _ := gin.CreateTestContext(rr)
+				httpRequest, _ := http.NewRequest(http.MethodPut, "http://test.com", nil)
+				ctx := httpRequest.Context()
+				ctx = context.WithValue(ctx, middleware.TraceIDKey, "123")
+				ctx = context.WithValue(ctx, authentication.AuthKey, jwt.RegisteredClaims{Subject: "admin"})
+				httpRequest = httpRequest.WithContext(ctx)
+				c.Request = httpRequest
+
+				return c, rr, nil
+			},
+			expectedStatusCode: http.StatusUnauthorized,
+			expectedResponse:   `{"error":"Unauthorized"}`,
+		},
+		{
+			name: "error in validating",
+			setup: func() (*gin.Context, *httptest.ResponseRecorder, service.JobService) {
+				rr := httptest.NewRecorder()
+				c, _ := gin.CreateTestContext(rr)
+				httpRequest, _ := http.NewRequest(http.MethodPut, "http://test.com", strings.NewReader(`{"jobName": "golang developer"}`))
+				ctx := httpRequest.Context()
+				ctx = context.WithValue(ctx, middleware.TraceIDKey, "123")
+				ctx = context.WithValue(ctx, authentication.AuthKey, jwt.RegisteredClaims{Subject: "8"})
+				httpRequest = httpRequest.WithContext(ctx)
+				c.Params = append(c.Params, gin.Param{Key: "id", Value: "1"})
+				c.Request = httpRequest
+
+				return c, rr, nil
+			},
+			expectedStatusCode: http.StatusBadRequest,
+			expectedResponse:   `{"error":"Bad Request"}`,
+		},
+		{
+			name: "not the company owner",
+			setup: func() (*gin.Context, *httptest.ResponseRecorder, service.JobService) {
+				rr := httptest.NewRecorder()
+				c, _ := gin.CreateTestContext(rr)
+				httpRequest, _ := http.NewRequest(http.MethodPut, "http://test.com", strings.NewReader(
+					`{
+						"jobName": "golang developer",
+						"minNoticePeriod": 1,
+						"maxNoticePeriod": 50,
+						"location": [1,2],
+						"technologyStack": [1, 2],
+						"description": "Exciting job opportunity for a software Developer...",
+						"minExperience": 1,
+						"maxExperience": 6,
+						"qualifications": [1, 2],
+						"shifts": [1,2],
+						"jobtype": [1,2]
+					  }`,
+				))
+				ctx := httpRequest.Context()
+				ctx = context.WithValue(ctx, middleware.TraceIDKey, "123")
+				ctx = context.WithValue(ctx, authentication.AuthKey, jwt.RegisteredClaims{Subject: "8"})
+				httpRequest = httpRequest.WithContext(ctx)
+				c.Params = append(c.Params, gin.Param{Key: "id", Value: "1"})
+				c.Request = httpRequest
+
+				mc := gomock.NewController(t)
+				mj := service.NewMockJobService(mc)
+
+				mj.EXPECT().UpdateJobByJobID(uint(8), uint(1), gomock.Any()).Return(model.Response{}, service.ErrForbidden)
+
+				return c, rr, mj
+			},
+			expectedStatusCode: http.StatusForbidden,
+			expectedResponse:   `{"error":"Forbidden"}`,
+		},
+		{
+			name: "success",
+			setup: func() (*gin.Context, *httptest.ResponseRecorder, service.JobService) {
+				rr := httptest.NewRecorder()
+				c, _ := gin.CreateTestContext(rr)
+				httpRequest, _ := http.NewRequest(http.MethodPut, "http://test.com", strings.NewReader(
+					`{
+						"jobName": "golang developer",
+						"minNoticePeriod": 1,
+						"maxNoticePeriod": 50,
+						"location": [1,2],
+						"technologyStack": [1, 2],
+						"description": "Exciting job opportunity for a software Developer...",
+						"minExperience": 1,
+						"maxExperience": 6,
+						"qualifications": [1, 2],
+						"shifts": [1,2],
+						"jobtype": [1,2]
+					  }`,
+				))
+				ctx := httpRequest.Context()
+				ctx = context.WithValue(ctx, middleware.TraceIDKey, "123")
+				ctx = context.WithValue(ctx, authentication.AuthKey, jwt.RegisteredClaims{Subject: "8"})
+				httpRequest = httpRequest.WithContext(ctx)
+				c.Params = append(c.Params, gin.Param{Key: "id", Value: "1"})
+				c.Request = httpRequest
+
+				mc := gomock.NewController(t)
+				mj := service.NewMockJobService(mc)
+
+				mj.EXPECT().UpdateJobByJobID(uint(8), uint(1), gomock.Any()).Return(model.Response{Id: 1}, nil)
+
+				return c, rr, mj
+			},
+			expectedStatusCode: http.StatusOK,
+			expectedResponse:   `{"id":1}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gin.SetMode(gin.TestMode)
+			c, rr, mj := tt.setup()
+			h := Handler{
+				serviceJob: mj,
+			}
+			h.ReplaceJob(c)
+			assert.Equal(t, tt.expectedStatusCode, rr.Code)
+			assert.Equal(t, tt.expectedResponse, rr.Body.String())
+		})
+	}
+}
+
+func TestHandler_DeleteJob(t *testing.T) {
+	tests := []struct {
+		name               string
+		setup              func() (*gin.Context, *httptest.ResponseRecorder, service.JobService)
+		expectedStatusCode int
+		expectedResponse   string
+	}{
+		{
+			name: "missing trace id",
+			setup: func() (*gin.Context, *httptest.ResponseRecorder, service.JobService) {
+				rr := httptest.NewRecorder()
+				c, _ := gin.CreateTestContext(rr)
+				httpRequest, _ := http.NewRequest(http.MethodDelete, "http://test.com", nil)
+				c.Request = httpRequest
+
+				return c, rr, nil
+			},
+			expectedStatusCode: http.StatusInternalServerError,
+			expectedResponse:   `{"error":"Internal Server Error"}`,
+		},
+		{
+			name: "missing jwt claims",
+			setup: func() (*gin.Context, *httptest.ResponseRecorder, service.JobService) {
+				rr := httptest.NewRecorder()
+				c, _ := gin.CreateTestContext(rr)
+				httpRequest, _ := http.NewRequest(http.MethodDelete, "http://test.com", nil)
+				ctx := httpRequest.Context()
+				ctx = context.WithValue(ctx, middleware.TraceIDKey, "123")
+				httpRequest = httpRequest.WithContext(ctx)
+				c.Request = httpRequest
+
+				return c, rr, nil
+			},
+			expectedStatusCode: http.StatusUnauthorized,
+			expectedResponse:   `{"error":"Unauthorized"}`,
+		},
+		{
+			name: "invalid user id in token",
+			setup: func() (*gin.Context, *httptest.ResponseRecorder, service.JobService) {
+				rr := httptest.NewRecorder()
+				c, _ := gin.CreateTestContext(rr)
+				httpRequest, _ := http.NewRequest(http.MethodDelete, "http://test.com", nil)
+				ctx := httpRequest.Context()
+				ctx = context.WithValue(ctx, middleware.TraceIDKey, "123")
+				ctx = context.WithValue(ctx, authentication.AuthKey, jwt.RegisteredClaims{Subject: "admin"})
+				httpRequest = httpRequest.WithContext(ctx)
+				c.Params = append(c.Params, gin.Param{Key: "id", Value: "1"})
+				c.Request = httpRequest
+
+				return c, rr, nil
+			},
+			expectedStatusCode: http.StatusUnauthorized,
+			expectedResponse:   `{"error":"Unauthorized"}`,
+		},
+		{
+			name: "invalid job id",
+			setup: func() (*gin.Context, *httptest.ResponseRecorder, service.JobService) {
+				rr := httptest.NewRecorder()
+				c, _ := gin.CreateTestContext(rr)
+				httpRequest, _ := http.NewRequest(http.MethodDelete, "http://test.com", nil)
+				ctx := httpRequest.Context()
+				ctx = context.WithValue(ctx, middleware.TraceIDKey, "123")
+				ctx = context.WithValue(ctx, authentication.AuthKey, jwt.RegisteredClaims{Subject: "8"})
+				httpRequest = httpRequest.WithContext(ctx)
+				c.Params = append(c.Params, gin.Param{Key: "id", Value: "abc"})
+				c.Request = httpRequest
+
+				return c, rr, nil
+			},
+			expectedStatusCode: http.StatusBadRequest,
+			expectedResponse:   `{"error":"Bad Request"}`,
+		},
+		{
+			name: "not the company owner",
+			setup: func() (*gin.Context, *httptest.ResponseRecorder, service.JobService) {
+				rr := httptest.NewRecorder()
+				c, _ := gin.CreateTestContext(rr)
+				httpRequest, _ := http.NewRequest(http.MethodDelete, "http://test.com", nil)
+				ctx := httpRequest.Context()
+				ctx = context.WithValue(ctx, middleware.TraceIDKey, "123")
+				ctx = context.WithValue(ctx, authentication.AuthKey, jwt.RegisteredClaims{Subject: "8"})
+				httpRequest = httpRequest.WithContext(ctx)
+				c.Params = append(c.Params, gin.Param{Key: "id", Value: "1"})
+				c.Request = httpRequest
+
+				mc := gomock.NewController(t)
+				mj := service.NewMockJobService(mc)
+
+				mj.EXPECT().DeleteJobByJobID(uint(8), uint(1)).Return(service.ErrForbidden)
+
+				return c, rr, mj
+			},
+			expectedStatusCode: http.StatusForbidden,
+			expectedResponse:   `{"error":"Forbidden"}`,
+		},
+		{
+			name: "failure",
+			setup: func() (*gin.Context, *httptest.ResponseRecorder, service.JobService) {
+				rr := httptest.NewRecorder()
+				c, _ := gin.CreateTestContext(rr)
+				httpRequest, _ := http.NewRequest(http.MethodDelete, "http://test.com", nil)
+				ctx := httpRequest.Context()
+				ctx = context.WithValue(ctx, middleware.TraceIDKey, "123")
+				ctx = context.WithValue(ctx, authentication.AuthKey, jwt.RegisteredClaims{Subject: "8"})
+				httpRequest = httpRequest.WithContext(ctx)
+				c.Params = append(c.Params, gin.Param{Key: "id", Value: "1"})
+				c.Request = httpRequest
+
+				mc := gomock.NewController(t)
+				mj := service.NewMockJobService(mc)
+
+				mj.EXPECT().DeleteJobByJobID(uint(8), uint(1)).Return(errors.New("error"))
+
+				return c, rr, mj
+			},
+			expectedStatusCode: http.StatusBadRequest,
+			expectedResponse:   `{"error":"Bad Request"}`,
+		},
+		{
+			name: "success",
+			setup: func() (*gin.Context, *httptest.ResponseRecorder, service.JobService) {
+				rr := httptest.NewRecorder()
+				c, _ := gin.CreateTestContext(rr)
+				httpRequest, _ := http.NewRequest(http.MethodDelete, "http://test.com", nil)
+				ctx := httpRequest.Context()
+				ctx = context.WithValue(ctx, middleware.TraceIDKey, "123")
+				ctx = context.WithValue(ctx, authentication.AuthKey, jwt.RegisteredClaims{Subject: "8"})
+				httpRequest = httpRequest.WithContext(ctx)
+				c.Params = append(c.Params, gin.Param{Key: "id", Value: "1"})
+				c.Request = httpRequest
+
+				mc := gomock.NewController(t)
+				mj := service.NewMockJobService(mc)
+
+				mj.EXPECT().DeleteJobByJobID(uint(8), uint(1)).Return(nil)
+
+				return c, rr, mj
+			},
+			expectedStatusCode: http.StatusOK,
+			expectedResponse:   `{"msg":"job deleted"}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gin.SetMode(gin.TestMode)
+			c, rr, mj := tt.setup()
+			h := Handler{
+				serviceJob: mj,
+			}
+			h.DeleteJob(c)
+			assert.Equal(t, tt.expectedStatusCode, rr.Code)
+			assert.Equal(t, tt.expectedResponse, rr.Body.String())
+		})
+	}
+}
+
+func TestHandler_ChangeJobStatus(t *testing.T) {
+	tests := []struct {
+		name               string
+		setup              func() (*gin.Context, *httptest.ResponseRecorder, service.JobService)
+		expectedStatusCode int
+		expectedResponse   string
+	}{
+		{
+			name: "missing trace id",
+			setup: func() (*gin.Context, *httptest.ResponseRecorder, service.JobService) {
+				rr := httptest.NewRecorder()
+				c, _ := gin.CreateTestContext(rr)
+				httpRequest, _ := http.NewRequest(http.MethodPatch, "http://test.com", nil)
+				c.Request = httpRequest
+
+				return c, rr, nil
+			},
+			expectedStatusCode: http.StatusInternalServerError,
+			expectedResponse:   `{"error":"Internal Server Error"}`,
+		},
+		{
+			name: "missing jwt claims",
+			setup: func() (*gin.Context, *httptest.ResponseRecorder, service.JobService) {
+				rr := httptest.NewRecorder()
+				c, _ := gin.CreateTestContext(rr)
+				httpRequest, _ := http.NewRequest(http.MethodPatch, "http://test.com", nil)
+				ctx := httpRequest.Context()
+				ctx = context.WithValue(ctx, middleware.TraceIDKey, "123")
+				httpRequest = httpRequest.WithContext(ctx)
+				c.Request = httpRequest
+
+				return c, rr, nil
+			},
+			expectedStatusCode: http.StatusUnauthorized,
+			expectedResponse:   `{"error":"Unauthorized"}`,
+		},
+		{
+			name: "invalid user id in token",
+			setup: func() (*gin.Context, *httptest.ResponseRecorder, service.JobService) {
+				rr := httptest.NewRecorder()
+				c, _ := gin.CreateTestContext(rr)
+				httpRequest, _ := http.NewRequest(http.MethodPatch, "http://test.com", strings.NewReader(`{"status": "paused"}`))
+				ctx := httpRequest.Context()
+				ctx = context.WithValue(ctx, middleware.TraceIDKey, "123")
+				ctx = context.WithValue(ctx, authentication.AuthKey, jwt.RegisteredClaims{Subject: "admin"})
+				httpRequest = httpRequest.WithContext(ctx)
+				c.Params = append(c.Params, gin.Param{Key: "id", Value: "1"})
+				c.Request = httpRequest
+
+				return c, rr, nil
+			},
+			expectedStatusCode: http.StatusUnauthorized,
+			expectedResponse:   `{"error":"Unauthorized"}`,
+		},
+		{
+			name: "invalid job id",
+			setup: func() (*gin.Context, *httptest.ResponseRecorder, service.JobService) {
+				rr := httptest.NewRecorder()
+				c, _ := gin.CreateTestContext(rr)
+				httpRequest, _ := http.NewRequest(http.MethodPatch, "http://test.com", strings.NewReader(`{"status": "paused"}`))
+				ctx := httpRequest.Context()
+				ctx = context.WithValue(ctx, middleware.TraceIDKey, "123")
+				ctx = context.WithValue(ctx, authentication.AuthKey, jwt.RegisteredClaims{Subject: "8"})
+				httpRequest = httpRequest.WithContext(ctx)
+				c.Params = append(c.Params, gin.Param{Key: "id", Value: "abc"})
+				c.Request = httpRequest
+
+				return c, rr, nil
+			},
+			expectedStatusCode: http.StatusBadRequest,
+			expectedResponse:   `{"error":"Bad Request"}`,
+		},
+		{
+			name: "error in validating",
+			setup: func() (*gin.Context, *httptest.ResponseRecorder, service.JobService) {
+				rr := httptest.NewRecorder()
+				c, _ := gin.CreateTestContext(rr)
+				httpRequest, _ := http.NewRequest(http.MethodPatch, "http://test.com", strings.NewReader(`{"status": "expired"}`))
+				ctx := httpRequest.Context()
+				ctx = context.WithValue(ctx, middleware.TraceIDKey, "123")
+				ctx = context.WithValue(ctx, authentication.AuthKey, jwt.RegisteredClaims{Subject: "8"})
+				httpRequest = httpRequest.WithContext(ctx)
+				c.Params = append(c.Params, gin.Param{Key: "id", Value: "1"})
+				c.Request = httpRequest
+
+				return c, rr, nil
+			},
+			expectedStatusCode: http.StatusBadRequest,
+			expectedResponse:   `{"error":"Bad Request"}`,
+		},
+		{
+			name: "not the company owner",
+			setup: func() (*gin.Context, *httptest.ResponseRecorder, service.JobService) {
+				rr := httptest.NewRecorder()
+				c, _ := gin.CreateTestContext(rr)
+				httpRequest, _ := http.NewRequest(http.MethodPatch, "http://test.com", strings.NewReader(`{"status": "paused"}`))
+				ctx := httpRequest.Context()
+				ctx = context.WithValue(ctx, middleware.TraceIDKey, "123")
+				ctx = context.WithValue(ctx, authentication.AuthKey, jwt.RegisteredClaims{Subject: "8"})
+				httpRequest = httpRequest.WithContext(ctx)
+				c.Params = append(c.Params, gin.Param{Key: "id", Value: "1"})
+				c.Request = httpRequest
+
+				mc := gomock.NewController(t)
+				mj := service.NewMockJobService(mc)
+
+				mj.EXPECT().ChangeJobStatus(uint(8), uint(1), model.JobStatusPaused).Return(model.Response{}, service.ErrForbidden)
+
+				return c, rr, mj
+			},
+			expectedStatusCode: http.StatusForbidden,
+			expectedResponse:   `{"error":"Forbidden"}`,
+		},
+		{
+			name: "failure",
+			setup: func() (*gin.Context, *httptest.ResponseRecorder, service.JobService) {
+				rr := httptest.NewRecorder()
+				c, _ := gin.CreateTestContext(rr)
+				httpRequest, _ := http.NewRequest(http.MethodPatch, "http://test.com", strings.NewReader(`{"status": "paused"}`))
+				ctx := httpRequest.Context()
+				ctx = context.WithValue(ctx, middleware.TraceIDKey, "123")
+				ctx = context.WithValue(ctx, authentication.AuthKey, jwt.RegisteredClaims{Subject: "8"})
+				httpRequest = httpRequest.WithContext(ctx)
+				c.Params = append(c.Params, gin.Param{Key: "id", Value: "1"})
+				c.Request = httpRequest
+
+				mc := gomock.NewController(t)
+				mj := service.NewMockJobService(mc)
+
+				mj.EXPECT().ChangeJobStatus(uint(8), uint(1), model.JobStatusPaused).Return(model.Response{}, errors.New("error"))
+
+				return c, rr, mj
+			},
+			expectedStatusCode: http.StatusBadRequest,
+			expectedResponse:   `{"error":"Bad Request"}`,
+		},
+		{
+			name: "success",
+			setup: func() (*gin.Context, *httptest.ResponseRecorder, service.JobService) {
+				rr := httptest.NewRecorder()
+				c, _ := gin.CreateTestContext(rr)
+				httpRequest, _ := http.NewRequest(http.MethodPatch, "http://test.com", strings.NewReader(`{"status": "paused"}`))
+				ctx := httpRequest.Context()
+				ctx = context.WithValue(ctx, middleware.TraceIDKey, "123")
+				ctx = context.WithValue(ctx, authentication.AuthKey, jwt.RegisteredClaims{Subject: "8"})
+				httpRequest = httpRequest.WithContext(ctx)
+				c.Params = append(c.Params, gin.Param{Key: "id", Value: "1"})
+				c.Request = httpRequest
+
+				mc := gomock.NewController(t)
+				mj := service.NewMockJobService(mc)
+
+				mj.EXPECT().ChangeJobStatus(uint(8), uint(1), model.JobStatusPaused).Return(model.Response{Id: 1}, nil)
+
+				return c, rr, mj
+			},
+			expectedStatusCode: http.StatusOK,
+			expectedResponse:   `{"id":1}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gin.SetMode(gin.TestMode)
+			c, rr, mj := tt.setup()
+			h := Handler{
+				serviceJob: mj,
+			}
+			h.ChangeJobStatus(c)
+			assert.Equal(t, tt.expectedStatusCode, rr.Code)
+			assert.Equal(t, tt.expectedResponse, rr.Body.String())
+		})
+	}
+}
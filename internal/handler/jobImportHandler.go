@@ -0,0 +1,111 @@
+package handler
+
+import (
+	"errors"
+	"job-portal-api/internal/authentication"
+	"job-portal-api/internal/middleware"
+	"job-portal-api/internal/model"
+	"job-portal-api/internal/service"
+	"mime"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/rs/zerolog/log"
+)
+
+// maxImportSize is the largest import file accepted, in bytes
+const maxImportSize = 5 << 20
+
+func (h *Handler) ImportJobs(c *gin.Context) {
+	ctx := c.Request.Context()
+	traceId, ok := ctx.Value(middleware.TraceIDKey).(string)
+	if !ok {
+		log.Info().Msg("missing trace id")
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"error": http.StatusText(http.StatusInternalServerError)})
+		return
+	}
+
+	claims, ok := ctx.Value(authentication.AuthKey).(jwt.RegisteredClaims)
+	if !ok {
+		log.Info().Str("trace id : ", traceId).Msg("login first")
+		c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": http.StatusText(http.StatusUnauthorized)})
+		return
+	}
+
+	uID, err := strconv.ParseUint(claims.Subject, 10, 64)
+	if err != nil {
+		log.Error().Err(err).Str("trace id : ", traceId).Msg("error invalid user id in token")
+		c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": http.StatusText(http.StatusUnauthorized)})
+		return
+	}
+
+	cID, err := strconv.ParseUint(c.Param("id"), 10, 64)
+	if err != nil {
+		log.Error().Err(err).Str("trace id : ", traceId).Msg("error invalid company id")
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": http.StatusText(http.StatusBadRequest)})
+		return
+	}
+
+	dryRun := false
+	if v := c.Query("dry_run"); v != "" {
+		dryRun, err = strconv.ParseBool(v)
+		if err != nil {
+			log.Error().Err(err).Str("trace id : ", traceId).Msg("error invalid dry run flag")
+			c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": http.StatusText(http.StatusBadRequest)})
+			return
+		}
+	}
+
+	format := importFormat(c)
+	if format == "" {
+		log.Error().Str("trace id : ", traceId).Msg("error unknown import format")
+		c.AbortWithStatusJSON(http.StatusUnsupportedMediaType, gin.H{"error": http.StatusText(http.StatusUnsupportedMediaType)})
+		return
+	}
+
+	body := http.MaxBytesReader(c.Writer, c.Request.Body, maxImportSize)
+	result, err := h.serviceJob.ImportJobs(uint(uID), uint(cID), format, body, dryRun)
+	if errors.Is(err, service.ErrForbidden) {
+		log.Error().Err(err).Str("trace id : ", traceId).Msg("error user does not act for the company")
+		c.AbortWithStatusJSON(http.StatusForbidden, gin.H{"error": http.StatusText(http.StatusForbidden)})
+		return
+	}
+	var validationErr *service.ValidationError
+	if errors.As(err, &validationErr) {
+		log.Error().Err(err).Str("trace id : ", traceId).Msg("error invalid company for import")
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"errors": validationErr.Errors})
+		return
+	}
+	if err != nil {
+		log.Error().Err(err).Str("trace id : ", traceId).Msg("error in importing jobs")
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": http.StatusText(http.StatusBadRequest)})
+		return
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
+// importFormat takes the format from the query string, falling back to the
+// content type of the request.
+func importFormat(c *gin.Context) string {
+	switch c.Query("format") {
+	case model.ImportFormatCSV:
+		return model.ImportFormatCSV
+	case model.ImportFormatJSONL:
+		return model.ImportFormatJSONL
+	case "":
+	default:
+		return ""
+	}
+
+	mediaType, _, _ := mime.ParseMediaType(c.GetHeader("Content-Type"))
+	switch mediaType {
+	case "text/csv":
+		return model.ImportFormatCSV
+	case "application/x-ndjson", "application/jsonl", "application/x-jsonlines":
+		return model.ImportFormatJSONL
+	}
+	return ""
+}
@@ -0,0 +1,276 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"job-portal-api/internal/authentication"
+	"job-portal-api/internal/middleware"
+	"job-portal-api/internal/model"
+	"job-portal-api/internal/service"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang-jwt/jwt/v5"
+	"go.uber.org/mock/gomock"
+	"gopkg.in/go-playground/assert.v1"
+)
+
+func TestHandler_ImportJobs(t *testing.T) {
+	tests := []struct {
+		name               string
+		setup              func() (*gin.Context, *httptest.ResponseRecorder, service.JobService)
+		expectedStatusCode int
+		expectedResponse   string
+	}{
+		{
+			name: "missing trace id",
+			setup: func() (*gin.Context, *httptest.ResponseRecorder, service.JobService) {
+				rr := httptest.NewRecorder()
+				c, _ := gin.CreateTestContext(rr)
+				httpRequest, _ := http.NewRequest(http.MethodPost, "http://test.com", nil)
+				c.Request = httpRequest
+
+				return c, rr, nil
+			},
+			expectedStatusCode: http.StatusInternalServerError,
+			expectedResponse:   `{"error":"Internal Server Error"}`,
+		},
+		{
+			name: "missing jwt claims",
+			setup: func() (*gin.Context, *httptest.ResponseRecorder, service.JobService) {
+				rr := httptest.NewRecorder()
+				c, _ := gin.CreateTestContext(rr)
+				httpRequest, _ := http.NewRequest(http.MethodPost, "http://test.com", nil)
+				ctx := httpRequest.Context()
+				ctx = context.WithValue(ctx, middleware.TraceIDKey, "123")
+				httpRequest = httpRequest.WithContext(ctx)
+				c.Request = httpRequest
+
+				return c, rr, nil
+			},
+			expectedStatusCode: http.StatusUnauthorized,
+			expectedResponse:   `{"error":"Unauthorized"}`,
+		},
+		{
+			name: "invalid user id in token",
+			setup: func() (*gin.Context, *httptest.ResponseRecorder, service.JobService) {
+				rr := httptest.NewRecorder()
+				c, _ := gin.CreateTestContext(rr)
+				httpRequest, _ := http.NewRequest(http.MethodPost, "http://test.com?format=csv", strings.NewReader(`jobName`))
+				ctx := httpRequest.Context()
+				ctx = context.WithValue(ctx, middleware.TraceIDKey, "123")
+				ctx = context.WithValue(ctx, authentication.AuthKey, jwt.RegisteredClaims{Subject: "admin"})
+				httpRequest = httpRequest.WithContext(ctx)
+				c.Params = append(c.Params, gin.Param{Key: "id", Value: "1"})
+				c.Request = httpRequest
+
+				return c, rr, nil
+			},
+			expectedStatusCode: http.StatusUnauthorized,
+			expectedResponse:   `{"error":"Unauthorized"}`,
+		},
+		{
+			name: "invalid company id",
+			setup: func() (*gin.Context, *httptest.ResponseRecorder, service.JobService) {
+				rr := httptest.NewRecorder()
+				c, _ := gin.CreateTestContext(rr)
+				httpRequest, _ := http.NewRequest(http.MethodPost, "http://test.com?format=csv", strings.NewReader(`jobName`))
+				ctx := httpRequest.Context()
+				ctx = context.WithValue(ctx, middleware.TraceIDKey, "123")
+				ctx = context.WithValue(ctx, authentication.AuthKey, jwt.RegisteredClaims{Subject: "8"})
+				httpRequest = httpRequest.WithContext(ctx)
+				c.Params = append(c.Params, gin.Param{Key: "id", Value: "abc"})
+				c.Request = httpRequest
+
+				return c, rr, nil
+			},
+			expectedStatusCode: http.StatusBadRequest,
+			expectedResponse:   `{"error":"Bad Request"}`,
+		},
+		{
+			name: "invalid dry run flag",
+			setup: func() (*gin.Context, *httptest.ResponseRecorder, service.JobService) {
+				rr := httptest.NewRecorder()
+				c, _ := gin.CreateTestContext(rr)
+				httpRequest, _ := http.NewRequest(http.MethodPost, "http://test.com?format=csv&dry_run=perhaps", strings.NewReader(`jobName`))
+				ctx := httpRequest.Context()
+				ctx = context.WithValue(ctx, middleware.TraceIDKey, "123")
+				ctx = context.WithValue(ctx, authentication.AuthKey, jwt.RegisteredClaims{Subject: "8"})
+				httpRequest = httpRequest.WithContext(ctx)
+				c.Params = append(c.Params, gin.Param{Key: "id", Value: "1"})
+				c.Request = httpRequest
+
+				return c, rr, nil
+			},
+			expectedStatusCode: http.StatusBadRequest,
+			expectedResponse:   `{"error":"Bad Request"}`,
+		},
+		{
+			name: "unsupported format",
+			setup: func() (*gin.Context, *httptest.ResponseRecorder, service.JobService) {
+				rr := httptest.NewRecorder()
+				c, _ := gin.CreateTestContext(rr)
+				httpRequest, _ := http.NewRequest(http.MethodPost, "http://test.com?format=xlsx", strings.NewReader(`jobName`))
+				ctx := httpRequest.Context()
+				ctx = context.WithValue(ctx, middleware.TraceIDKey, "123")
+				ctx = context.WithValue(ctx, authentication.AuthKey, jwt.RegisteredClaims{Subject: "8"})
+				httpRequest = httpRequest.WithContext(ctx)
+				c.Params = append(c.Params, gin.Param{Key: "id", Value: "1"})
+				c.Request = httpRequest
+
+				return c, rr, nil
+			},
+			expectedStatusCode: http.StatusUnsupportedMediaType,
+			expectedResponse:   `{"error":"Unsupported Media Type"}`,
+		},
+		{
+			name: "missing format",
+			setup: func() (*gin.Context, *httptest.ResponseRecorder, service.JobService) {
+				rr := httptest.NewRecorder()
+				c, _ := gin.CreateTestContext(rr)
+				httpRequest, _ := http.NewRequest(http.MethodPost, "http://test.com", strings.NewReader(`jobName`))
+				ctx := httpRequest.Context()
+				ctx = context.WithValue(ctx, middleware.TraceIDKey, "123")
+				ctx = context.WithValue(ctx, authentication.AuthKey, jwt.RegisteredClaims{Subject: "8"})
+				httpRequest = httpRequest.WithContext(ctx)
+				c.Params = append(c.Params, gin.Param{Key: "id", Value: "1"})
+				c.Request = httpRequest
+
+				return c, rr, nil
+			},
+			expectedStatusCode: http.StatusUnsupportedMediaType,
+			expectedResponse:   `{"error":"Unsupported Media Type"}`,
+		},
+		{
+			name: "format from content type",
+			setup: func() (*gin.Context, *httptest.ResponseRecorder, service.JobService) {
+				rr := httptest.NewRecorder()
+				c, _ := gin.CreateTestContext(rr)
+				httpRequest, _ := http.NewRequest(http.MethodPost, "http://test.com", strings.NewReader(`jobName`))
+				httpRequest.Header.Set("Content-Type", "text/csv; charset=utf-8")
+				ctx := httpRequest.Context()
+				ctx = context.WithValue(ctx, middleware.TraceIDKey, "123")
+				ctx = context.WithValue(ctx, authentication.AuthKey, jwt.RegisteredClaims{Subject: "8"})
+				httpRequest = httpRequest.WithContext(ctx)
+				c.Params = append(c.Params, gin.Param{Key: "id", Value: "1"})
+				c.Request = httpRequest
+
+				mc := gomock.NewController(t)
+				mj := service.NewMockJobService(mc)
+
+				mj.EXPECT().ImportJobs(uint(8), uint(1), model.ImportFormatCSV, gomock.Any(), false).Return(model.ImportResult{Total: 1, JobIDs: []uint{}, Errors: []model.ImportRowError{}}, nil)
+
+				return c, rr, mj
+			},
+			expectedStatusCode: http.StatusOK,
+			expectedResponse:   `{"dry_run":false,"total":1,"valid":0,"imported":0,"job_ids":[],"errors":[]}`,
+		},
+		{
+			name: "not the company owner",
+			setup: func() (*gin.Context, *httptest.ResponseRecorder, service.JobService) {
+				rr := httptest.NewRecorder()
+				c, _ := gin.CreateTestContext(rr)
+				httpRequest, _ := http.NewRequest(http.MethodPost, "http://test.com?format=csv", strings.NewReader(`jobName`))
+				ctx := httpRequest.Context()
+				ctx = context.WithValue(ctx, middleware.TraceIDKey, "123")
+				ctx = context.WithValue(ctx, authentication.AuthKey, jwt.RegisteredClaims{Subject: "8"})
+				httpRequest = httpRequest.WithContext(ctx)
+				c.Params = append(c.Params, gin.Param{Key: "id", Value: "1"})
+				c.Request = httpRequest
+
+				mc := gomock.NewController(t)
+				mj := service.NewMockJobService(mc)
+
+				mj.EXPECT().ImportJobs(uint(8), uint(1), model.ImportFormatCSV, gomock.Any(), false).Return(model.ImportResult{}, service.ErrForbidden)
+
+				return c, rr, mj
+			},
+			expectedStatusCode: http.StatusForbidden,
+			expectedResponse:   `{"error":"Forbidden"}`,
+		},
+		{
+			name: "failure",
+			setup: func() (*gin.Context, *httptest.ResponseRecorder, service.JobService) {
+				rr := httptest.NewRecorder()
+				c, _ := gin.CreateTestContext(rr)
+				httpRequest, _ := http.NewRequest(http.MethodPost, "http://test.com?format=csv", strings.NewReader(`jobName`))
+				ctx := httpRequest.Context()
+				ctx = context.WithValue(ctx, middleware.TraceIDKey, "123")
+				ctx = context.WithValue(ctx, authentication.AuthKey, jwt.RegisteredClaims{Subject: "8"})
+				httpRequest = httpRequest.WithContext(ctx)
+				c.Params = append(c.Params, gin.Param{Key: "id", Value: "1"})
+				c.Request = httpRequest
+
+				mc := gomock.NewController(t)
+				mj := service.NewMockJobService(mc)
+
+				mj.EXPECT().ImportJobs(uint(8), uint(1), model.ImportFormatCSV, gomock.Any(), false).Return(model.ImportResult{}, errors.New("error"))
+
+				return c, rr, mj
+			},
+			expectedStatusCode: http.StatusBadRequest,
+			expectedResponse:   `{"error":"Bad Request"}`,
+		},
+		{
+			name: "unknown company",
+			setup: func() (*gin.Context, *httptest.ResponseRecorder, service.JobService) {
+				rr := httptest.NewRecorder()
+				c, _ := gin.CreateTestContext(rr)
+				httpRequest, _ := http.NewRequest(http.MethodPost, "http://test.com?format=csv", strings.NewReader(`jobName`))
+				ctx := httpRequest.Context()
+				ctx = context.WithValue(ctx, middleware.TraceIDKey, "123")
+				ctx = context.WithValue(ctx, authentication.AuthKey, jwt.RegisteredClaims{Subject: "8"})
+				httpRequest = httpRequest.WithContext(ctx)
+				c.Params = append(c.Params, gin.Param{Key: "id", Value: "1"})
+				c.Request = httpRequest
+
+				mc := gomock.NewController(t)
+				mj := service.NewMockJobService(mc)
+
+				mj.EXPECT().ImportJobs(uint(8), uint(1), model.ImportFormatCSV, gomock.Any(), false).Return(model.ImportResult{}, &service.ValidationError{Errors: []model.FieldError{{Field: "companyID", Message: "company does not exist"}}})
+
+				return c, rr, mj
+			},
+			expectedStatusCode: http.StatusBadRequest,
+			expectedResponse:   `{"errors":[{"field":"companyID","message":"company does not exist"}]}`,
+		},
+		{
+			name: "success",
+			setup: func() (*gin.Context, *httptest.ResponseRecorder, service.JobService) {
+				rr := httptest.NewRecorder()
+				c, _ := gin.CreateTestContext(rr)
+				httpRequest, _ := http.NewRequest(http.MethodPost, "http://test.com?format=jsonl&dry_run=true", strings.NewReader(`{"jobName": "golang developer"}`))
+				ctx := httpRequest.Context()
+				ctx = context.WithValue(ctx, middleware.TraceIDKey, "123")
+				ctx = context.WithValue(ctx, authentication.AuthKey, jwt.RegisteredClaims{Subject: "8"})
+				httpRequest = httpRequest.WithContext(ctx)
+				c.Params = append(c.Params, gin.Param{Key: "id", Value: "1"})
+				c.Request = httpRequest
+
+				mc := gomock.NewController(t)
+				mj := service.NewMockJobService(mc)
+
+				mj.EXPECT().ImportJobs(uint(8), uint(1), model.ImportFormatJSONL, gomock.Any(), true).Return(model.ImportResult{DryRun: true, Total: 1, JobIDs: []uint{}, Errors: []model.ImportRowError{{Row: 1, Errors: []model.FieldError{{Field: "description", Message: "is required"}}}}}, nil)
+
+				return c, rr, mj
+			},
+			expectedStatusCode: http.StatusOK,
+			expectedResponse:   `{"dry_run":true,"total":1,"valid":0,"imported":0,"job_ids":[],"errors":[{"row":1,"errors":[{"field":"description","message":"is required"}]}]}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gin.SetMode(gin.TestMode)
+			c, rr, mj := tt.setup()
+			h := Handler{
+				serviceJob: mj,
+			}
+			h.ImportJobs(c)
+			assert.Equal(t, tt.expectedStatusCode, rr.Code)
+			assert.Equal(t, tt.expectedResponse, rr.Body.String())
+		})
+	}
+}
@@ -0,0 +1,186 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"job-portal-api/internal/authentication"
+	"job-portal-api/internal/middleware"
+	"job-portal-api/internal/model"
+	"job-portal-api/internal/repository"
+	"job-portal-api/internal/service"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/rs/zerolog/log"
+)
+
+type CandidateProfileHandler interface {
+	ViewCandidateProfile(c *gin.Context)
+	UpdateCandidateProfile(c *gin.Context)
+	ApplyWithProfile(c *gin.Context)
+}
+
+func NewCandidateProfileHandler(serviceProfile service.CandidateProfileService) (CandidateProfileHandler, error) {
+	if serviceProfile == nil {
+		log.Info().Msg("candidate profile service cannot be nil")
+		return nil, errors.New("candidate profile service cannot be nil")
+	}
+	return &Handler{
+		serviceProfile: serviceProfile,
+	}, nil
+}
+
+// ViewCandidateProfile returns the profile of the logged in candidate.
+func (h *Handler) ViewCandidateProfile(c *gin.Context) {
+	ctx := c.Request.Context()
+	traceId, ok := ctx.Value(middleware.TraceIDKey).(string)
+	if !ok {
+		log.Info().Msg("missing trace id")
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"error": http.StatusText(http.StatusInternalServerError)})
+		return
+	}
+
+	claims, ok := ctx.Value(authentication.AuthKey).(jwt.RegisteredClaims)
+	if !ok {
+		log.Info().Str("trace id : ", traceId).Msg("login first")
+		c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": http.StatusText(http.StatusUnauthorized)})
+		return
+	}
+
+	uID, err := strconv.ParseUint(claims.Subject, 10, 64)
+	if err != nil {
+		log.Error().Err(err).Str("trace id : ", traceId).Msg("error invalid user id in token")
+		c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": http.StatusText(http.StatusUnauthorized)})
+		return
+	}
+
+	profile, err := h.serviceProfile.ViewCandidateProfile(uint(uID))
+	if errors.Is(err, repository.ErrProfileNotFound) {
+		log.Error().Err(err).Str("trace id : ", traceId).Msg("error candidate has no profile")
+		c.AbortWithStatusJSON(http.StatusNotFound, gin.H{"error": http.StatusText(http.StatusNotFound)})
+		return
+	}
+	if err != nil {
+		log.Error().Err(err).Str("trace id : ", traceId).Msg("error in fetching candidate profile")
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": http.StatusText(http.StatusBadRequest)})
+		return
+	}
+
+	c.JSON(http.StatusOK, profile)
+}
+
+// UpdateCandidateProfile creates or replaces the profile of the logged in
+// candidate. The body is a NewUserApplication without the job id.
+func (h *Handler) UpdateCandidateProfile(c *gin.Context) {
+	ctx := c.Request.Context()
+	traceId, ok := ctx.Value(middleware.TraceIDKey).(string)
+	if !ok {
+		log.Info().Msg("missing trace id")
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"error": http.StatusText(http.StatusInternalServerError)})
+		return
+	}
+
+	claims, ok := ctx.Value(authentication.AuthKey).(jwt.RegisteredClaims)
+	if !ok {
+		log.Info().Str("trace id : ", traceId).Msg("login first")
+		c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": http.StatusText(http.StatusUnauthorized)})
+		return
+	}
+
+	uID, err := strconv.ParseUint(claims.Subject, 10, 64)
+	if err != nil {
+		log.Error().Err(err).Str("trace id : ", traceId).Msg("error invalid user id in token")
+		c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": http.StatusText(http.StatusUnauthorized)})
+		return
+	}
+
+	var profileData model.NewCandidateProfile
+	err = json.NewDecoder(c.Request.Body).Decode(&profileData)
+	if err != nil {
+		log.Error().Err(err).Str("trace id : ", traceId).Msg("error in decoding")
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": http.StatusText(http.StatusBadRequest)})
+		return
+	}
+
+	validate := validator.New()
+	err = validate.Struct(profileData)
+	if err != nil {
+		log.Error().Err(err).Str("trace id : ", traceId).Msg("error in validating")
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": http.StatusText(http.StatusBadRequest)})
+		return
+	}
+
+	profile, err := h.serviceProfile.UpdateCandidateProfile(uint(uID), profileData)
+	if err != nil {
+		log.Error().Err(err).Str("trace id : ", traceId).Msg("error in saving candidate profile")
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": http.StatusText(http.StatusBadRequest)})
+		return
+	}
+
+	c.JSON(http.StatusOK, profile)
+}
+
+// ApplyWithProfile applies to the job in the body with the candidate's
+// profile.
+func (h *Handler) ApplyWithProfile(c *gin.Context) {
+	ctx := c.Request.Context()
+	traceId, ok := ctx.Value(middleware.TraceIDKey).(string)
+	if !ok {
+		log.Info().Msg("missing trace id")
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"error": http.StatusText(http.StatusInternalServerError)})
+		return
+	}
+
+	claims, ok := ctx.Value(authentication.AuthKey).(jwt.RegisteredClaims)
+	if !ok {
+		log.Info().Str("trace id : ", traceId).Msg("login first")
+		c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": http.StatusText(http.StatusUnauthorized)})
+		return
+	}
+
+	uID, err := strconv.ParseUint(claims.Subject, 10, 64)
+	if err != nil {
+		log.Error().Err(err).Str("trace id : ", traceId).Msg("error invalid user id in token")
+		c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": http.StatusText(http.StatusUnauthorized)})
+		return
+	}
+
+	var applicationData model.ProfileApplication
+	err = json.NewDecoder(c.Request.Body).Decode(&applicationData)
+	if err != nil {
+		log.Error().Err(err).Str("trace id : ", traceId).Msg("error in decoding")
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": http.StatusText(http.StatusBadRequest)})
+		return
+	}
+
+	validate := validator.New()
+	err = validate.Struct(applicationData)
+	if err != nil {
+		log.Error().Err(err).Str("trace id : ", traceId).Msg("error in validating")
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": http.StatusText(http.StatusBadRequest)})
+		return
+	}
+
+	application, err := h.serviceProfile.ApplyWithProfile(ctx, uint(uID), applicationData.Jid)
+	var conflictErr *service.ApplicationConflictError
+	if errors.As(err, &conflictErr) {
+		log.Error().Err(err).Str("trace id : ", traceId).Msg("error application already exists")
+		c.AbortWithStatusJSON(http.StatusConflict, gin.H{"error": http.StatusText(http.StatusConflict), "application_id": conflictErr.ApplicationID})
+		return
+	}
+	if errors.Is(err, repository.ErrProfileNotFound) {
+		log.Error().Err(err).Str("trace id : ", traceId).Msg("error candidate has no profile")
+		c.AbortWithStatusJSON(http.StatusNotFound, gin.H{"error": http.StatusText(http.StatusNotFound)})
+		return
+	}
+	if err != nil {
+		log.Error().Err(err).Str("trace id : ", traceId).Msg("error in submitting application")
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": http.StatusText(http.StatusBadRequest)})
+		return
+	}
+
+	c.JSON(http.StatusOK, application)
+}
@@ -0,0 +1,123 @@
+package handler
+
+import (
+	"errors"
+	"job-portal-api/internal/authentication"
+	"job-portal-api/internal/middleware"
+	"job-portal-api/internal/service"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/rs/zerolog/log"
+)
+
+type ResumeHandler interface {
+	DraftApplication(c *gin.Context)
+	DraftApplicationFromAttachment(c *gin.Context)
+}
+
+func NewResumeHandler(serviceResume service.ResumeService) (ResumeHandler, error) {
+	if serviceResume == nil {
+		log.Info().Msg("resume service cannot be nil")
+		return nil, errors.New("resume service cannot be nil")
+	}
+	return &Handler{
+		serviceResume: serviceResume,
+	}, nil
+}
+
+// DraftApplication reads the resume in the "file" field of a multipart form
+// and returns the application details found in it. The file is not kept.
+func (h *Handler) DraftApplication(c *gin.Context) {
+	ctx := c.Request.Context()
+	traceId, ok := ctx.Value(middleware.TraceIDKey).(string)
+	if !ok {
+		log.Info().Msg("missing trace id")
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"error": http.StatusText(http.StatusInternalServerError)})
+		return
+	}
+
+	_, ok = ctx.Value(authentication.AuthKey).(jwt.RegisteredClaims)
+	if !ok {
+		log.Info().Str("trace id : ", traceId).Msg("login first")
+		c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": http.StatusText(http.StatusUnauthorized)})
+		return
+	}
+
+	c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, maxUploadRequestSize)
+	fileHeader, err := c.FormFile("file")
+	var maxBytesErr *http.MaxBytesError
+	if errors.As(err, &maxBytesErr) {
+		log.Error().Err(err).Str("trace id : ", traceId).Msg("error upload request too large")
+		c.AbortWithStatusJSON(http.StatusRequestEntityTooLarge, gin.H{"error": http.StatusText(http.StatusRequestEntityTooLarge)})
+		return
+	}
+	if err != nil {
+		log.Error().Err(err).Str("trace id : ", traceId).Msg("error in reading uploaded file")
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": http.StatusText(http.StatusBadRequest)})
+		return
+	}
+
+	file, err := fileHeader.Open()
+	if err != nil {
+		log.Error().Err(err).Str("trace id : ", traceId).Msg("error in opening uploaded file")
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": http.StatusText(http.StatusBadRequest)})
+		return
+	}
+	defer file.Close()
+
+	draft, err := h.serviceResume.DraftApplication(fileHeader.Filename, fileHeader.Size, file)
+	if err != nil {
+		log.Error().Err(err).Str("trace id : ", traceId).Msg("error in reading resume")
+		status := attachmentErrorStatus(err)
+		c.AbortWithStatusJSON(status, gin.H{"error": http.StatusText(status)})
+		return
+	}
+
+	c.JSON(http.StatusOK, draft)
+}
+
+// DraftApplicationFromAttachment reads a resume the candidate uploaded
+// earlier with an application.
+func (h *Handler) DraftApplicationFromAttachment(c *gin.Context) {
+	ctx := c.Request.Context()
+	traceId, ok := ctx.Value(middleware.TraceIDKey).(string)
+	if !ok {
+		log.Info().Msg("missing trace id")
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"error": http.StatusText(http.StatusInternalServerError)})
+		return
+	}
+
+	claims, ok := ctx.Value(authentication.AuthKey).(jwt.RegisteredClaims)
+	if !ok {
+		log.Info().Str("trace id : ", traceId).Msg("login first")
+		c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": http.StatusText(http.StatusUnauthorized)})
+		return
+	}
+
+	uID, err := strconv.ParseUint(claims.Subject, 10, 64)
+	if err != nil {
+		log.Error().Err(err).Str("trace id : ", traceId).Msg("error invalid user id in token")
+		c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": http.StatusText(http.StatusUnauthorized)})
+		return
+	}
+
+	atID, err := strconv.ParseUint(c.Param("id"), 10, 64)
+	if err != nil {
+		log.Error().Err(err).Str("trace id : ", traceId).Msg("error invalid attachment id")
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": http.StatusText(http.StatusBadRequest)})
+		return
+	}
+
+	draft, err := h.serviceResume.DraftApplicationFromAttachment(ctx, uint(uID), uint(atID))
+	if err != nil {
+		log.Error().Err(err).Str("trace id : ", traceId).Msg("error in reading resume")
+		status := attachmentErrorStatus(err)
+		c.AbortWithStatusJSON(status, gin.H{"error": http.StatusText(status)})
+		return
+	}
+
+	c.JSON(http.StatusOK, draft)
+}
@@ -0,0 +1,308 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"job-portal-api/internal/authentication"
+	"job-portal-api/internal/middleware"
+	"job-portal-api/internal/model"
+	"job-portal-api/internal/service"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang-jwt/jwt/v5"
+	"go.uber.org/mock/gomock"
+	"gopkg.in/go-playground/assert.v1"
+)
+
+func TestHandler_DraftApplication(t *testing.T) {
+	tests := []struct {
+		name               string
+		setup              func() (*gin.Context, *httptest.ResponseRecorder, service.ResumeService)
+		expectedStatusCode int
+		expectedResponse   string
+	}{
+		{
+			name: "missing trace id",
+			setup: func() (*gin.Context, *httptest.ResponseRecorder, service.ResumeService) {
+				rr := httptest.NewRecorder()
+				c, _ := gin.CreateTestContext(rr)
+				httpRequest, _ := http.NewRequest(http.MethodPost, "http://test.com", nil)
+				c.Request = httpRequest
+
+				return c, rr, nil
+			},
+			expectedStatusCode: http.StatusInternalServerError,
+			expectedResponse:   `{"error":"Internal Server Error"}`,
+		},
+		{
+			name: "missing jwt claims",
+			setup: func() (*gin.Context, *httptest.ResponseRecorder, service.ResumeService) {
+				rr := httptest.NewRecorder()
+				c, _ := gin.CreateTestContext(rr)
+				httpRequest, _ := http.NewRequest(http.MethodPost, "http://test.com", nil)
+				ctx := httpRequest.Context()
+				ctx = context.WithValue(ctx, middleware.TraceIDKey, "123")
+				httpRequest = httpRequest.WithContext(ctx)
+				c.Request = httpRequest
+
+				return c, rr, nil
+			},
+			expectedStatusCode: http.StatusUnauthorized,
+			expectedResponse:   `{"error":"Unauthorized"}`,
+		},
+		{
+			name: "missing file",
+			setup: func() (*gin.Context, *httptest.ResponseRecorder, service.ResumeService) {
+				rr := httptest.NewRecorder()
+				c, _ := gin.CreateTestContext(rr)
+				httpRequest, _ := uploadRequest("", "", "")
+				ctx := httpRequest.Context()
+				ctx = context.WithValue(ctx, middleware.TraceIDKey, "123")
+				ctx = context.WithValue(ctx, authentication.AuthKey, jwt.RegisteredClaims{Subject: "1"})
+				httpRequest = httpRequest.WithContext(ctx)
+				c.Request = httpRequest
+
+				return c, rr, nil
+			},
+			expectedStatusCode: http.StatusBadRequest,
+			expectedResponse:   `{"error":"Bad Request"}`,
+		},
+		{
+			name: "unsupported type",
+			setup: func() (*gin.Context, *httptest.ResponseRecorder, service.ResumeService) {
+				rr := httptest.NewRecorder()
+				c, _ := gin.CreateTestContext(rr)
+				httpRequest, _ := uploadRequest("", "resume.doc", "Go in Pune")
+				ctx := httpRequest.Context()
+				ctx = context.WithValue(ctx, middleware.TraceIDKey, "123")
+				ctx = context.WithValue(ctx, authentication.AuthKey, jwt.RegisteredClaims{Subject: "1"})
+				httpRequest = httpRequest.WithContext(ctx)
+				c.Request = httpRequest
+
+				mc := gomock.NewController(t)
+				mr := service.NewMockResumeService(mc)
+
+				mr.EXPECT().DraftApplication("resume.doc", int64(10), gomock.Any()).Return(model.ApplicationDraft{}, service.ErrAttachmentUnsupported)
+
+				return c, rr, mr
+			},
+			expectedStatusCode: http.StatusUnsupportedMediaType,
+			expectedResponse:   `{"error":"Unsupported Media Type"}`,
+		},
+		{
+			name: "unreadable resume",
+			setup: func() (*gin.Context, *httptest.ResponseRecorder, service.ResumeService) {
+				rr := httptest.NewRecorder()
+				c, _ := gin.CreateTestContext(rr)
+				httpRequest, _ := uploadRequest("", "resume.pdf", "%PDF-1.4")
+				ctx := httpRequest.Context()
+				ctx = context.WithValue(ctx, middleware.TraceIDKey, "123")
+				ctx = context.WithValue(ctx, authentication.AuthKey, jwt.RegisteredClaims{Subject: "1"})
+				httpRequest = httpRequest.WithContext(ctx)
+				c.Request = httpRequest
+
+				mc := gomock.NewController(t)
+				mr := service.NewMockResumeService(mc)
+
+				mr.EXPECT().DraftApplication("resume.pdf", int64(8), gomock.Any()).Return(model.ApplicationDraft{}, service.ErrResumeUnreadable)
+
+				return c, rr, mr
+			},
+			expectedStatusCode: http.StatusUnprocessableEntity,
+			expectedResponse:   `{"error":"Unprocessable Entity"}`,
+		},
+		{
+			name: "success",
+			setup: func() (*gin.Context, *httptest.ResponseRecorder, service.ResumeService) {
+				rr := httptest.NewRecorder()
+				c, _ := gin.CreateTestContext(rr)
+				httpRequest, _ := uploadRequest("", "resume.txt", "Go in Pune")
+				ctx := httpRequest.Context()
+				ctx = context.WithValue(ctx, middleware.TraceIDKey, "123")
+				ctx = context.WithValue(ctx, authentication.AuthKey, jwt.RegisteredClaims{Subject: "1"})
+				httpRequest = httpRequest.WithContext(ctx)
+				c.Request = httpRequest
+
+				mc := gomock.NewController(t)
+				mr := service.NewMockResumeService(mc)
+
+				mr.EXPECT().DraftApplication("resume.txt", int64(10), gomock.Any()).Return(model.ApplicationDraft{Jobs: model.Requestfield{Location: []uint{2}, TechnologyStack: []uint{1}, Qualifications: []uint{}, Shift: []uint{}, Jobtype: []uint{}, Skills: []model.SkillLevel{}}, Matches: []model.DraftMatch{{Kind: model.TaxonomyLocation, ID: 2, Name: "Pune"}, {Kind: model.TaxonomyTechnologyStack, ID: 1, Name: "Go"}}}, nil)
+
+				return c, rr, mr
+			},
+			expectedStatusCode: http.StatusOK,
+			expectedResponse:   `{"job_application":{"noticePeriod":0,"location":[2],"technologyStack":[1],"experience":0,"qualifications":[],"shifts":[],"jobtype":[],"skills":[]},"matches":[{"kind":"locations","id":2,"name":"Pune"},{"kind":"technology_stacks","id":1,"name":"Go"}]}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gin.SetMode(gin.TestMode)
+			c, rr, mr := tt.setup()
+			h := Handler{
+				serviceResume: mr,
+			}
+			h.DraftApplication(c)
+			assert.Equal(t, tt.expectedStatusCode, rr.Code)
+			assert.Equal(t, tt.expectedResponse, rr.Body.String())
+		})
+	}
+}
+
+func TestHandler_DraftApplicationFromAttachment(t *testing.T) {
+	tests := []struct {
+		name               string
+		setup              func() (*gin.Context, *httptest.ResponseRecorder, service.ResumeService)
+		expectedStatusCode int
+		expectedResponse   string
+	}{
+		{
+			name: "missing trace id",
+			setup: func() (*gin.Context, *httptest.ResponseRecorder, service.ResumeService) {
+				rr := httptest.NewRecorder()
+				c, _ := gin.CreateTestContext(rr)
+				httpRequest, _ := http.NewRequest(http.MethodGet, "http://test.com", nil)
+				c.Request = httpRequest
+
+				return c, rr, nil
+			},
+			expectedStatusCode: http.StatusInternalServerError,
+			expectedResponse:   `{"error":"Internal Server Error"}`,
+		},
+		{
+			name: "missing jwt claims",
+			setup: func() (*gin.Context, *httptest.ResponseRecorder, service.ResumeService) {
+				rr := httptest.NewRecorder()
+				c, _ := gin.CreateTestContext(rr)
+				httpRequest, _ := http.NewRequest(http.MethodGet, "http://test.com", nil)
+				ctx := httpRequest.Context()
+				ctx = context.WithValue(ctx, middleware.TraceIDKey, "123")
+				httpRequest = httpRequest.WithContext(ctx)
+				c.Request = httpRequest
+
+				return c, rr, nil
+			},
+			expectedStatusCode: http.StatusUnauthorized,
+			expectedResponse:   `{"error":"Unauthorized"}`,
+		},
+		{
+			name: "invalid user id in token",
+			setup: func() (*gin.Context, *httptest.ResponseRecorder, service.ResumeService) {
+				rr := httptest.NewRecorder()
+				c, _ := gin.CreateTestContext(rr)
+				httpRequest, _ := http.NewRequest(http.MethodGet, "http://test.com", nil)
+				ctx := httpRequest.Context()
+				ctx = context.WithValue(ctx, middleware.TraceIDKey, "123")
+				ctx = context.WithValue(ctx, authentication.AuthKey, jwt.RegisteredClaims{Subject: "admin"})
+				httpRequest = httpRequest.WithContext(ctx)
+				c.Params = append(c.Params, gin.Param{Key: "id", Value: "7"})
+				c.Request = httpRequest
+
+				return c, rr, nil
+			},
+			expectedStatusCode: http.StatusUnauthorized,
+			expectedResponse:   `{"error":"Unauthorized"}`,
+		},
+		{
+			name: "invalid attachment id",
+			setup: func() (*gin.Context, *httptest.ResponseRecorder, service.ResumeService) {
+				rr := httptest.NewRecorder()
+				c, _ := gin.CreateTestContext(rr)
+				httpRequest, _ := http.NewRequest(http.MethodGet, "http://test.com", nil)
+				ctx := httpRequest.Context()
+				ctx = context.WithValue(ctx, middleware.TraceIDKey, "123")
+				ctx = context.WithValue(ctx, authentication.AuthKey, jwt.RegisteredClaims{Subject: "1"})
+				httpRequest = httpRequest.WithContext(ctx)
+				c.Params = append(c.Params, gin.Param{Key: "id", Value: "abc"})
+				c.Request = httpRequest
+
+				return c, rr, nil
+			},
+			expectedStatusCode: http.StatusBadRequest,
+			expectedResponse:   `{"error":"Bad Request"}`,
+		},
+		{
+			name: "another user",
+			setup: func() (*gin.Context, *httptest.ResponseRecorder, service.ResumeService) {
+				rr := httptest.NewRecorder()
+				c, _ := gin.CreateTestContext(rr)
+				httpRequest, _ := http.NewRequest(http.MethodGet, "http://test.com", nil)
+				ctx := httpRequest.Context()
+				ctx = context.WithValue(ctx, middleware.TraceIDKey, "123")
+				ctx = context.WithValue(ctx, authentication.AuthKey, jwt.RegisteredClaims{Subject: "1"})
+				httpRequest = httpRequest.WithContext(ctx)
+				c.Params = append(c.Params, gin.Param{Key: "id", Value: "7"})
+				c.Request = httpRequest
+
+				mc := gomock.NewController(t)
+				mr := service.NewMockResumeService(mc)
+
+				mr.EXPECT().DraftApplicationFromAttachment(gomock.Any(), uint(1), uint(7)).Return(model.ApplicationDraft{}, service.ErrForbidden)
+
+				return c, rr, mr
+			},
+			expectedStatusCode: http.StatusForbidden,
+			expectedResponse:   `{"error":"Forbidden"}`,
+		},
+		{
+			name: "failure",
+			setup: func() (*gin.Context, *httptest.ResponseRecorder, service.ResumeService) {
+				rr := httptest.NewRecorder()
+				c, _ := gin.CreateTestContext(rr)
+				httpRequest, _ := http.NewRequest(http.MethodGet, "http://test.com", nil)
+				ctx := httpRequest.Context()
+				ctx = context.WithValue(ctx, middleware.TraceIDKey, "123")
+				ctx = context.WithValue(ctx, authentication.AuthKey, jwt.RegisteredClaims{Subject: "1"})
+				httpRequest = httpRequest.WithContext(ctx)
+				c.Params = append(c.Params, gin.Param{Key: "id", Value: "7"})
+				c.Request = httpRequest
+
+				mc := gomock.NewController(t)
+				mr := service.NewMockResumeService(mc)
+
+				mr.EXPECT().DraftApplicationFromAttachment(gomock.Any(), uint(1), uint(7)).Return(model.ApplicationDraft{}, errors.New("error"))
+
+				return c, rr, mr
+			},
+			expectedStatusCode: http.StatusBadRequest,
+			expectedResponse:   `{"error":"Bad Request"}`,
+		},
+		{
+			name: "success",
+			setup: func() (*gin.Context, *httptest.ResponseRecorder, service.ResumeService) {
+				rr := httptest.NewRecorder()
+				c, _ := gin.CreateTestContext(rr)
+				httpRequest, _ := http.NewRequest(http.MethodGet, "http://test.com", nil)
+				ctx := httpRequest.Context()
+				ctx = context.WithValue(ctx, middleware.TraceIDKey, "123")
+				ctx = context.WithValue(ctx, authentication.AuthKey, jwt.RegisteredClaims{Subject: "1"})
+				httpRequest = httpRequest.WithContext(ctx)
+				c.Params = append(c.Params, gin.Param{Key: "id", Value: "7"})
+				c.Request = httpRequest
+
+				mc := gomock.NewController(t)
+				mr := service.NewMockResumeService(mc)
+
+				mr.EXPECT().DraftApplicationFromAttachment(gomock.Any(), uint(1), uint(7)).Return(model.ApplicationDraft{Jobs: model.Requestfield{Location: []uint{2}, TechnologyStack: []uint{1}, Qualifications: []uint{}, Shift: []uint{}, Jobtype: []uint{}, Skills: []model.SkillLevel{}}, Matches: []model.DraftMatch{{Kind: model.TaxonomyLocation, ID: 2, Name: "Pune"}, {Kind: model.TaxonomyTechnologyStack, ID: 1, Name: "Go"}}}, nil)
+
+				return c, rr, mr
+			},
+			expectedStatusCode: http.StatusOK,
+			expectedResponse:   `{"job_application":{"noticePeriod":0,"location":[2],"technologyStack":[1],"experience":0,"qualifications":[],"shifts":[],"jobtype":[],"skills":[]},"matches":[{"kind":"locations","id":2,"name":"Pune"},{"kind":"technology_stacks","id":1,"name":"Go"}]}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gin.SetMode(gin.TestMode)
+			c, rr, mr := tt.setup()
+			h := Handler{
+				serviceResume: mr,
+			}
+			h.DraftApplicationFromAttachment(c)
+			assert.Equal(t, tt.expectedStatusCode, rr.Code)
+			assert.Equal(t, tt.expectedResponse, rr.Body.String())
+		})
+	}
+}
@@ -0,0 +1,150 @@
+package handler
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"job-portal-api/internal/authentication"
+	"job-portal-api/internal/middleware"
+	"job-portal-api/internal/model"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/rs/zerolog/log"
+)
+
+// maxStreamLineSize caps one application line of a streamed request
+const maxStreamLineSize = 1 << 20
+
+// streamTimeout bounds every read and write of a stream. It takes the place
+// of the server read and write timeouts, which would otherwise cut off a
+// stream of thousands of applications part way through.
+const streamTimeout = 30 * time.Second
+
+// ProcessJobApplicationStream reads newline delimited applications from the
+// request body and writes one NDJSON result line per application as soon as
+// it is screened.
+func (h *Handler) ProcessJobApplicationStream(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	traceId, ok := ctx.Value(middleware.TraceIDKey).(string)
+	if !ok {
+		log.Info().Msg("missing trace id")
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"error": http.StatusText(http.StatusInternalServerError)})
+		return
+	}
+
+	_, ok = ctx.Value(authentication.AuthKey).(jwt.RegisteredClaims)
+	if !ok {
+		log.Info().Str("trace id : ", traceId).Msg("login first")
+		c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": http.StatusText(http.StatusUnauthorized)})
+		return
+	}
+
+	//results are written while the body is still being read
+	rc := http.NewResponseController(c.Writer)
+	err := rc.EnableFullDuplex()
+	if err != nil {
+		log.Debug().Err(err).Str("trace id : ", traceId).Msg("full duplex is not supported")
+	}
+	extendWriteDeadline := func() {
+		err := rc.SetWriteDeadline(time.Now().Add(streamTimeout))
+		if err != nil && !errors.Is(err, http.ErrNotSupported) {
+			log.Error().Err(err).Str("trace id : ", traceId).Msg("error in extending write deadline")
+		}
+	}
+	extendWriteDeadline()
+
+	c.Header("Content-Type", "application/x-ndjson")
+	c.Status(http.StatusOK)
+	c.Writer.WriteHeaderNow()
+
+	applications := make(chan model.StreamedApplication)
+	read := make(chan struct{})
+	go func() {
+		defer close(read)
+		readStreamedApplications(ctx, &deadlineReader{r: c.Request.Body, rc: rc}, traceId, applications)
+	}()
+	//the body must not be read once the handler has returned
+	defer func() { <-read }()
+
+	encoder := json.NewEncoder(c.Writer)
+	h.serviceJob.StreamApplications(ctx, applications, func(result model.ScreeningStreamResult) {
+		extendWriteDeadline()
+		err := encoder.Encode(result)
+		if err != nil {
+			log.Error().Err(err).Str("trace id : ", traceId).Msg("error in writing screening result")
+			return
+		}
+		c.Writer.Flush()
+	})
+}
+
+// readStreamedApplications sends every non empty line of the request body
+// as an application, lines that cannot be decoded or validated are sent
+// with their error.
+func readStreamedApplications(ctx context.Context, body io.Reader, traceId string, applications chan<- model.StreamedApplication) {
+	defer close(applications)
+
+	send := func(v model.StreamedApplication) bool {
+		select {
+		case <-ctx.Done():
+			return false
+		case applications <- v:
+			return true
+		}
+	}
+
+	validate := validator.New()
+	scanner := bufio.NewScanner(body)
+	scanner.Buffer(make([]byte, 0, 64*1024), maxStreamLineSize)
+	line := 0
+	for scanner.Scan() {
+		line++
+		data := bytes.TrimSpace(scanner.Bytes())
+		if len(data) == 0 {
+			continue
+		}
+
+		v := model.StreamedApplication{Line: line}
+		err := json.Unmarshal(data, &v.Application)
+		if err != nil {
+			log.Error().Err(err).Str("trace id : ", traceId).Int("line", line).Msg("error in decoding")
+			v.Err = errors.New("could not decode application")
+		} else if err = validate.Struct(v.Application); err != nil {
+			log.Error().Err(err).Str("trace id : ", traceId).Int("line", line).Msg("error in validating")
+			v.Err = errors.New("invalid application")
+		}
+
+		if !send(v) {
+			return
+		}
+	}
+
+	err := scanner.Err()
+	if err != nil {
+		log.Error().Err(err).Str("trace id : ", traceId).Msg("error in reading application stream")
+		send(model.StreamedApplication{Line: line, Err: errors.New("could not read the rest of the stream")})
+	}
+}
+
+// deadlineReader extends the read deadline of the connection before every
+// read of the request body.
+type deadlineReader struct {
+	r  io.Reader
+	rc *http.ResponseController
+}
+
+func (d *deadlineReader) Read(p []byte) (int, error) {
+	err := d.rc.SetReadDeadline(time.Now().Add(streamTimeout))
+	if err != nil && !errors.Is(err, http.ErrNotSupported) {
+		return 0, err
+	}
+	return d.r.Read(p)
+}
@@ -0,0 +1,176 @@
+package handler
+
+import (
+	"context"
+	"job-portal-api/internal/authentication"
+	"job-portal-api/internal/middleware"
+	"job-portal-api/internal/model"
+	"job-portal-api/internal/service"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang-jwt/jwt/v5"
+	"go.uber.org/mock/gomock"
+	"gopkg.in/go-playground/assert.v1"
+)
+
+func TestHandler_ProcessJobApplicationStream(t *testing.T) {
+	tests := []struct {
+		name               string
+		setup              func() (*gin.Context, *httptest.ResponseRecorder, service.JobService)
+		expectedStatusCode int
+		expectedResponse   string
+	}{
+		{
+			name: "missing trace id",
+			setup: func() (*gin.Context, *httptest.ResponseRecorder, service.JobService) {
+				rr := httptest.NewRecorder()
+				c, _ := gin.CreateTestContext(rr)
+				httpRequest, _ := http.NewRequest(http.MethodPost, "http://test.com", nil)
+				c.Request = httpRequest
+
+				return c, rr, nil
+			},
+			expectedStatusCode: http.StatusInternalServerError,
+			expectedResponse:   `{"error":"Internal Server Error"}`,
+		},
+		{
+			name: "missing jwt claims",
+			setup: func() (*gin.Context, *httptest.ResponseRecorder, service.JobService) {
+				rr := httptest.NewRecorder()
+				c, _ := gin.CreateTestContext(rr)
+				httpRequest, _ := http.NewRequest(http.MethodPost, "http://test.com", nil)
+				ctx := httpRequest.Context()
+				ctx = context.WithValue(ctx, middleware.TraceIDKey, "123")
+				httpRequest = httpRequest.WithContext(ctx)
+				c.Request = httpRequest
+
+				return c, rr, nil
+			},
+			expectedStatusCode: http.StatusUnauthorized,
+			expectedResponse:   `{"error":"Unauthorized"}`,
+		},
+		{
+			name: "empty stream",
+			setup: func() (*gin.Context, *httptest.ResponseRecorder, service.JobService) {
+				rr := httptest.NewRecorder()
+				c, _ := gin.CreateTestContext(rr)
+				httpRequest, _ := http.NewRequest(http.MethodPost, "http://test.com", strings.NewReader(``))
+				ctx := httpRequest.Context()
+				ctx = context.WithValue(ctx, middleware.TraceIDKey, "123")
+				ctx = context.WithValue(ctx, authentication.AuthKey, jwt.RegisteredClaims{})
+				httpRequest = httpRequest.WithContext(ctx)
+				c.Request = httpRequest
+
+				mc := gomock.NewController(t)
+				mj := service.NewMockJobService(mc)
+
+				mj.EXPECT().StreamApplications(gomock.Any(), gomock.Any(), gomock.Any()).Do(func(ctx context.Context, applications <-chan model.StreamedApplication, emit func(model.ScreeningStreamResult)) {
+					for v := range applications {
+						if v.Err != nil {
+							emit(model.ScreeningStreamResult{Line: v.Line, Error: v.Err.Error()})
+							continue
+						}
+						application := v.Application
+						emit(model.ScreeningStreamResult{Line: v.Line, Application: &application, Screening: &model.ScreeningResult{Accepted: true, Score: 1, MaxScore: 1, Threshold: 0.5}})
+					}
+				})
+
+				return c, rr, mj
+			},
+			expectedStatusCode: http.StatusOK,
+			expectedResponse:   ``,
+		},
+		{
+			name: "every line is answered",
+			setup: func() (*gin.Context, *httptest.ResponseRecorder, service.JobService) {
+				rr := httptest.NewRecorder()
+				c, _ := gin.CreateTestContext(rr)
+				httpRequest, _ := http.NewRequest(http.MethodPost, "http://test.com", strings.NewReader(`{"name":"asha","age":"25","jid":1,"job_application":{"noticePeriod":30,"experience":2}}
+{"name":
+
+{"name":"ravi"}
+{"name":"asha","age":"25","jid":1,"job_application":{"noticePeriod":30,"experience":2}}
+`))
+				ctx := httpRequest.Context()
+				ctx = context.WithValue(ctx, middleware.TraceIDKey, "123")
+				ctx = context.WithValue(ctx, authentication.AuthKey, jwt.RegisteredClaims{})
+				httpRequest = httpRequest.WithContext(ctx)
+				c.Request = httpRequest
+
+				mc := gomock.NewController(t)
+				mj := service.NewMockJobService(mc)
+
+				mj.EXPECT().StreamApplications(gomock.Any(), gomock.Any(), gomock.Any()).Do(func(ctx context.Context, applications <-chan model.StreamedApplication, emit func(model.ScreeningStreamResult)) {
+					for v := range applications {
+						if v.Err != nil {
+							emit(model.ScreeningStreamResult{Line: v.Line, Error: v.Err.Error()})
+							continue
+						}
+						application := v.Application
+						emit(model.ScreeningStreamResult{Line: v.Line, Application: &application, Screening: &model.ScreeningResult{Accepted: true, Score: 1, MaxScore: 1, Threshold: 0.5}})
+					}
+				})
+
+				return c, rr, mj
+			},
+			expectedStatusCode: http.StatusOK,
+			expectedResponse: `{"line":1,"application":{"name":"asha","age":"25","jid":1,"job_application":{"noticePeriod":30,"location":null,"technologyStack":null,"experience":2,"qualifications":null,"shifts":null,"jobtype":null,"skills":null}},"screening":{"accepted":true,"score":1,"max_score":1,"threshold":0.5,"knocked_out":false,"criteria":null}}
+{"line":2,"error":"could not decode application"}
+{"line":4,"error":"invalid application"}
+{"line":5,"application":{"name":"asha","age":"25","jid":1,"job_application":{"noticePeriod":30,"location":null,"technologyStack":null,"experience":2,"qualifications":null,"shifts":null,"jobtype":null,"skills":null}},"screening":{"accepted":true,"score":1,"max_score":1,"threshold":0.5,"knocked_out":false,"criteria":null}}
+`,
+		},
+		{
+			name: "line too long",
+			setup: func() (*gin.Context, *httptest.ResponseRecorder, service.JobService) {
+				rr := httptest.NewRecorder()
+				c, _ := gin.CreateTestContext(rr)
+				httpRequest, _ := http.NewRequest(http.MethodPost, "http://test.com", strings.NewReader(`{"name":"asha","age":"25","jid":1,"job_application":{"noticePeriod":30,"experience":2}}
+{"name":"`+strings.Repeat("a", maxStreamLineSize)+`"}
+{"name":"asha","age":"25","jid":1,"job_application":{"noticePeriod":30,"experience":2}}
+`))
+				ctx := httpRequest.Context()
+				ctx = context.WithValue(ctx, middleware.TraceIDKey, "123")
+				ctx = context.WithValue(ctx, authentication.AuthKey, jwt.RegisteredClaims{})
+				httpRequest = httpRequest.WithContext(ctx)
+				c.Request = httpRequest
+
+				mc := gomock.NewController(t)
+				mj := service.NewMockJobService(mc)
+
+				mj.EXPECT().StreamApplications(gomock.Any(), gomock.Any(), gomock.Any()).Do(func(ctx context.Context, applications <-chan model.StreamedApplication, emit func(model.ScreeningStreamResult)) {
+					for v := range applications {
+						if v.Err != nil {
+							emit(model.ScreeningStreamResult{Line: v.Line, Error: v.Err.Error()})
+							continue
+						}
+						application := v.Application
+						emit(model.ScreeningStreamResult{Line: v.Line, Application: &application, Screening: &model.ScreeningResult{Accepted: true, Score: 1, MaxScore: 1, Threshold: 0.5}})
+					}
+				})
+
+				return c, rr, mj
+			},
+			expectedStatusCode: http.StatusOK,
+			expectedResponse: `{"line":1,"application":{"name":"asha","age":"25","jid":1,"job_application":{"noticePeriod":30,"location":null,"technologyStack":null,"experience":2,"qualifications":null,"shifts":null,"jobtype":null,"skills":null}},"screening":{"accepted":true,"score":1,"max_score":1,"threshold":0.5,"knocked_out":false,"criteria":null}}
+{"line":1,"error":"could not read the rest of the stream"}
+`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gin.SetMode(gin.TestMode)
+			c, rr, mj := tt.setup()
+			h := Handler{
+				serviceJob: mj,
+			}
+			h.ProcessJobApplicationStream(c)
+			assert.Equal(t, tt.expectedStatusCode, rr.Code)
+			assert.Equal(t, tt.expectedResponse, rr.Body.String())
+		})
+	}
+}
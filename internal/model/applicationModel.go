@@ -0,0 +1,108 @@
+package model
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+const (
+	ApplicationStageApplied   = "applied"
+	ApplicationStageScreening = "screening"
+	ApplicationStageInterview = "interview"
+	ApplicationStageOffer     = "offer"
+	ApplicationStageHired     = "hired"
+	ApplicationStageRejected  = "rejected"
+	ApplicationStageWithdrawn = "withdrawn"
+)
+
+// ApplicationStages lists the hiring pipeline in order.
+var ApplicationStages = []string{
+	ApplicationStageApplied,
+	ApplicationStageScreening,
+	ApplicationStageInterview,
+	ApplicationStageOffer,
+	ApplicationStageHired,
+	ApplicationStageRejected,
+	ApplicationStageWithdrawn,
+}
+
+// Application is a candidate's application to a job. UserID is the subject
+// of the token the application was submitted with, a candidate has at most
+// one application per job. Duplicates stored before the unique index existed
+// are removed at startup, before the index is built.
+type Application struct {
+	gorm.Model
+	UserID         uint            `json:"user_id" gorm:"uniqueIndex:idx_application_user_job"`
+	JobID          uint            `json:"job_id" gorm:"index;uniqueIndex:idx_application_user_job"`
+	Job            Job             `json:"-" gorm:"ForeignKey:JobID"`
+	Name           string          `json:"name"`
+	Age            string          `json:"age"`
+	Details        Requestfield    `json:"details" gorm:"serializer:json"`
+	Accepted       bool            `json:"accepted"`
+	Screening      ScreeningResult `json:"screening" gorm:"serializer:json"`
+	ScreenedAt     time.Time       `json:"screened_at"`
+	Stage          string          `json:"stage" gorm:"default:applied;index"`
+	StageChangedAt time.Time       `json:"stage_changed_at"`
+}
+
+// ApplicationStageHistory records one move of an application through the
+// pipeline. The first entry of every application has an empty From.
+type ApplicationStageHistory struct {
+	ID            uint      `json:"id" gorm:"primarykey"`
+	ApplicationID uint      `json:"application_id" gorm:"index"`
+	From          string    `json:"from"`
+	To            string    `json:"to"`
+	ChangedBy     uint      `json:"changed_by"`
+	ChangedAt     time.Time `json:"changed_at"`
+}
+
+type ApplicationStageChange struct {
+	Stage string `json:"stage" validate:"required,oneof=applied screening interview offer hired rejected withdrawn"`
+}
+
+type ApplicationStageGroup struct {
+	Stage        string        `json:"stage"`
+	Applications []Application `json:"applications"`
+}
+
+type ApplicationPipeline struct {
+	JobID  uint                    `json:"job_id"`
+	Stages []ApplicationStageGroup `json:"stages"`
+}
+
+// ShortlistEntry is an application of a job ranked by its screening score.
+type ShortlistEntry struct {
+	Rank          int       `json:"rank"`
+	ApplicationID uint      `json:"application_id"`
+	UserID        uint      `json:"user_id"`
+	Name          string    `json:"name"`
+	Stage         string    `json:"stage"`
+	Accepted      bool      `json:"accepted"`
+	KnockedOut    bool      `json:"knocked_out"`
+	Score         float64   `json:"score"`
+	MaxScore      float64   `json:"max_score"`
+	AppliedAt     time.Time `json:"applied_at"`
+}
+
+type Shortlist struct {
+	JobID      uint             `json:"job_id"`
+	Total      int              `json:"total"`
+	Entries    []ShortlistEntry `json:"entries"`
+	NextOffset *int             `json:"next_offset,omitempty"`
+}
+
+// CandidateApplication is one of the candidate's own applications with a
+// summary of the job and company it was made to.
+type CandidateApplication struct {
+	ApplicationID  uint      `json:"application_id"`
+	JobID          uint      `json:"job_id"`
+	Jobname        string    `json:"jobname"`
+	JobStatus      string    `json:"job_status"`
+	CompanyID      uint      `json:"company_id"`
+	CompanyName    string    `json:"company_name"`
+	Stage          string    `json:"stage"`
+	Accepted       bool      `json:"accepted"`
+	AppliedAt      time.Time `json:"applied_at"`
+	StageChangedAt time.Time `json:"stage_changed_at"`
+}
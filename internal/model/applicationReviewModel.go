@@ -0,0 +1,54 @@
+package model
+
+import "time"
+
+// ApplicationNote is a private note a recruiter left on an application.
+type ApplicationNote struct {
+	ID            uint      `json:"id" gorm:"primarykey"`
+	ApplicationID uint      `json:"application_id" gorm:"index"`
+	AuthorID      uint      `json:"author_id"`
+	Author        User      `json:"-" gorm:"ForeignKey:AuthorID"`
+	AuthorName    string    `json:"author_name" gorm:"-"`
+	Body          string    `json:"body"`
+	CreatedAt     time.Time `json:"created_at"`
+}
+
+// ApplicationTag is a free-form label on an application, stored lower
+// case so that filtering by tag ignores case.
+type ApplicationTag struct {
+	ID            uint      `json:"id" gorm:"primarykey"`
+	ApplicationID uint      `json:"application_id" gorm:"uniqueIndex:idx_application_tag"`
+	Tag           string    `json:"tag" gorm:"uniqueIndex:idx_application_tag;index"`
+	TaggedBy      uint      `json:"tagged_by"`
+	CreatedAt     time.Time `json:"created_at"`
+}
+
+// ApplicationRating is the hiring team's star rating of an application,
+// the last rating given replaces the previous one.
+type ApplicationRating struct {
+	ApplicationID uint      `json:"application_id" gorm:"primarykey;autoIncrement:false"`
+	Rating        int       `json:"rating" gorm:"index"`
+	RatedBy       uint      `json:"rated_by"`
+	RatedAt       time.Time `json:"rated_at"`
+}
+
+// ApplicationReview is everything the hiring team recorded about an
+// application. Rating is nil until the application is rated.
+type ApplicationReview struct {
+	ApplicationID uint               `json:"application_id"`
+	Rating        *ApplicationRating `json:"rating"`
+	Tags          []string           `json:"tags"`
+	Notes         []ApplicationNote  `json:"notes"`
+}
+
+type NewApplicationNote struct {
+	Body string `json:"body" validate:"required,max=5000"`
+}
+
+type NewApplicationTags struct {
+	Tags []string `json:"tags" validate:"max=20,dive,required,max=50"`
+}
+
+type NewApplicationRating struct {
+	Rating int `json:"rating" validate:"required,min=1,max=5"`
+}
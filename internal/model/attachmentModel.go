@@ -0,0 +1,23 @@
+package model
+
+import "time"
+
+const (
+	AttachmentKindResume      = "resume"
+	AttachmentKindCoverLetter = "cover_letter"
+	AttachmentKindOther       = "other"
+)
+
+// Attachment is a file uploaded with an application. The file itself is
+// kept in the storage backend under StorageKey.
+type Attachment struct {
+	ID            uint      `json:"id" gorm:"primarykey"`
+	ApplicationID uint      `json:"application_id" gorm:"index"`
+	Kind          string    `json:"kind"`
+	FileName      string    `json:"file_name"`
+	ContentType   string    `json:"content_type"`
+	Size          int64     `json:"size"`
+	StorageKey    string    `json:"-"`
+	UploadedBy    uint      `json:"uploaded_by"`
+	CreatedAt     time.Time `json:"created_at"`
+}
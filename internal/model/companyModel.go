@@ -2,11 +2,16 @@ package model
 
 import "gorm.io/gorm"
 
+// Company is a hiring company. OwnerID is the user that created it, who
+// acts for the company on its jobs. Companies created before owners were
+// recorded get APP_DEFAULTCOMPANYOWNER at startup, until then nobody can act
+// for them.
 type Company struct {
 	gorm.Model
 	CompanyName string `json:"companyName" validate:"required"`
 	Address     string `json:"address"`
 	Domain      string `json:"domain"`
+	OwnerID     uint   `json:"owner_id" gorm:"index"`
 }
 
 type AddCompany struct {
@@ -0,0 +1,46 @@
+package model
+
+import "time"
+
+const (
+	ImportFormatCSV   = "csv"
+	ImportFormatJSONL = "jsonl"
+)
+
+// ImportJobRow is one job of a bulk import. Reference data is given by name
+// instead of id, in CSV files the lists are separated by ";".
+type ImportJobRow struct {
+	Jobname           string     `json:"jobName" validate:"required"`
+	MinNoticePeriod   int        `json:"minNoticePeriod" validate:"required"`
+	MaxNoticePeriod   uint       `json:"maxNoticePeriod" validate:"required"`
+	Location          []string   `json:"location"`
+	TechnologyStack   []string   `json:"technologyStack"`
+	Description       string     `json:"description" validate:"required"`
+	MinExperience     int        `json:"minExperience" validate:"required"`
+	MaxExperience     uint       `json:"maxExperience" validate:"required"`
+	Qualifications    []string   `json:"qualifications"`
+	Shift             []string   `json:"shifts"`
+	Jobtype           []string   `json:"jobtype"`
+	Status            string     `json:"status" validate:"omitempty,oneof=draft published"`
+	ExpiresAt         *time.Time `json:"expiresAt"`
+	WorkMode          string     `json:"workMode" validate:"omitempty,oneof=onsite remote hybrid"`
+	MinSalary         float64    `json:"minSalary"`
+	MaxSalary         float64    `json:"maxSalary"`
+	Currency          string     `json:"currency"`
+	PayPeriod         string     `json:"payPeriod" validate:"omitempty,oneof=hourly monthly yearly"`
+	SalaryUndisclosed bool       `json:"salaryUndisclosed"`
+}
+
+type ImportRowError struct {
+	Row    int          `json:"row"`
+	Errors []FieldError `json:"errors"`
+}
+
+type ImportResult struct {
+	DryRun   bool             `json:"dry_run"`
+	Total    int              `json:"total"`
+	Valid    int              `json:"valid"`
+	Imported int              `json:"imported"`
+	JobIDs   []uint           `json:"job_ids"`
+	Errors   []ImportRowError `json:"errors"`
+}
@@ -1,6 +1,30 @@
 package model
 
-import "gorm.io/gorm"
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+const (
+	JobStatusDraft     = "draft"
+	JobStatusPublished = "published"
+	JobStatusPaused    = "paused"
+	JobStatusClosed    = "closed"
+	JobStatusExpired   = "expired"
+)
+
+const (
+	WorkModeOnsite = "onsite"
+	WorkModeRemote = "remote"
+	WorkModeHybrid = "hybrid"
+)
+
+const (
+	PayPeriodHourly  = "hourly"
+	PayPeriodMonthly = "monthly"
+	PayPeriodYearly  = "yearly"
+)
 
 type Job struct {
 	gorm.Model
@@ -17,6 +41,23 @@ type Job struct {
 	Qualifications  []Qualification   `json:"qualifications" gorm:"many2many:job_qualification;"`
 	Shift           []Shift           `json:"shifts" gorm:"many2many:job_shift;" `
 	Jobtype         []JobType         `json:"jobtype" gorm:"many2many:job_type;"`
+	Status          string            `json:"status" gorm:"default:published;index"`
+	PublishedAt     *time.Time        `json:"published_at"`
+	ExpiresAt       *time.Time        `json:"expires_at"`
+	WorkMode        string            `json:"work_mode" gorm:"default:onsite;index"`
+	//salary as posted by the company
+	MinSalary         float64 `json:"min_salary"`
+	MaxSalary         float64 `json:"max_salary"`
+	Currency          string  `json:"currency"`
+	PayPeriod         string  `json:"pay_period"`
+	SalaryUndisclosed bool    `json:"salary_undisclosed"`
+	//yearly salary in the base currency, nil when no salary is disclosed
+	NormalisedMinSalary *float64 `json:"normalised_min_salary" gorm:"index"`
+	NormalisedMaxSalary *float64 `json:"normalised_max_salary" gorm:"index"`
+	//skills with minimum proficiency, scored instead of TechnologyStack when set
+	SkillRequirements []SkillRequirement `json:"skill_requirements" gorm:"serializer:json"`
+	//how applications are screened, empty means the default policy
+	ScoringPolicy ScoringPolicy `json:"scoring_policy" gorm:"serializer:json"`
 }
 
 type JobType struct {
@@ -26,7 +67,9 @@ type JobType struct {
 
 type Location struct {
 	gorm.Model
-	PlaceName string `json:"place_name"`
+	PlaceName string   `json:"place_name"`
+	Latitude  *float64 `json:"latitude,omitempty"`
+	Longitude *float64 `json:"longitude,omitempty"`
 }
 
 type TechnologyStack struct {
@@ -45,17 +88,116 @@ type Shift struct {
 }
 
 type NewJobs struct {
-	Jobname         string `json:"jobName" validate:"required"`
-	MinNoticePeriod int    `json:"minNoticePeriod" validate:"required"`
-	MaxNoticePeriod uint   `json:"maxNoticePeriod" validate:"required"`
-	Location        []uint `json:"location" `
-	TechnologyStack []uint `json:"technologyStack" `
-	Description     string `json:"description" validate:"required"`
-	MinExperience   int    `json:"minExperience" validate:"required"`
-	MaxExperience   uint   `json:"maxExperience" validate:"required"`
-	Qualifications  []uint `json:"qualifications"`
-	Shift           []uint `json:"shifts"`
-	Jobtype         []uint `json:"jobtype"`
+	Jobname           string             `json:"jobName" validate:"required"`
+	MinNoticePeriod   int                `json:"minNoticePeriod" validate:"required"`
+	MaxNoticePeriod   uint               `json:"maxNoticePeriod" validate:"required"`
+	Location          []uint             `json:"location" `
+	TechnologyStack   []uint             `json:"technologyStack" `
+	Description       string             `json:"description" validate:"required"`
+	MinExperience     int                `json:"minExperience" validate:"required"`
+	MaxExperience     uint               `json:"maxExperience" validate:"required"`
+	Qualifications    []uint             `json:"qualifications"`
+	Shift             []uint             `json:"shifts"`
+	Jobtype           []uint             `json:"jobtype"`
+	Status            string             `json:"status" validate:"omitempty,oneof=draft published"`
+	ExpiresAt         *time.Time         `json:"expiresAt"`
+	WorkMode          string             `json:"workMode" validate:"omitempty,oneof=onsite remote hybrid"`
+	MinSalary         float64            `json:"minSalary"`
+	MaxSalary         float64            `json:"maxSalary"`
+	Currency          string             `json:"currency"`
+	PayPeriod         string             `json:"payPeriod" validate:"omitempty,oneof=hourly monthly yearly"`
+	SalaryUndisclosed bool               `json:"salaryUndisclosed"`
+	SkillRequirements []SkillRequirement `json:"skillRequirements" validate:"dive"`
+	ScoringPolicy     ScoringPolicy      `json:"scoringPolicy"`
+}
+
+type UpdateJob struct {
+	Jobname           *string             `json:"jobName"`
+	MinNoticePeriod   *int                `json:"minNoticePeriod"`
+	MaxNoticePeriod   *uint               `json:"maxNoticePeriod"`
+	Location          *[]uint             `json:"location"`
+	TechnologyStack   *[]uint             `json:"technologyStack"`
+	Description       *string             `json:"description"`
+	MinExperience     *int                `json:"minExperience"`
+	MaxExperience     *uint               `json:"maxExperience"`
+	Qualifications    *[]uint             `json:"qualifications"`
+	Shift             *[]uint             `json:"shifts"`
+	Jobtype           *[]uint             `json:"jobtype"`
+	ExpiresAt         *time.Time          `json:"expiresAt"`
+	WorkMode          *string             `json:"workMode" validate:"omitempty,oneof=onsite remote hybrid"`
+	MinSalary         *float64            `json:"minSalary"`
+	MaxSalary         *float64            `json:"maxSalary"`
+	Currency          *string             `json:"currency"`
+	PayPeriod         *string             `json:"payPeriod" validate:"omitempty,oneof=hourly monthly yearly"`
+	SalaryUndisclosed *bool               `json:"salaryUndisclosed"`
+	SkillRequirements *[]SkillRequirement `json:"skillRequirements" validate:"omitempty,dive"`
+	ScoringPolicy     *ScoringPolicy      `json:"scoringPolicy"`
+	// ClearExpiresAt removes the expiry date when ExpiresAt is nil. A PATCH
+	// cannot tell a missing expiresAt from null, so only a PUT sets it.
+	ClearExpiresAt bool `json:"-"`
+}
+
+type JobStatusChange struct {
+	Status string `json:"status" validate:"required,oneof=draft published paused closed"`
+}
+
+const (
+	JobSortNewest     = "newest"
+	JobSortExperience = "experience"
+	JobSortSalary     = "salary"
+)
+
+type JobFilter struct {
+	CompanyID       uint
+	Location        []uint
+	TechnologyStack []uint
+	Jobtype         []uint
+	Shift           []uint
+	MinExperience   *int
+	MaxExperience   *int
+	NoticePeriod    *int
+	MinSalary       *float64
+	MaxSalary       *float64
+	WorkMode        []string
+	//jobs with a location within RadiusKm of the point
+	Latitude  *float64
+	Longitude *float64
+	RadiusKm  *float64
+	//remote jobs match every location filter when set
+	IncludeRemote bool
+	Sort          string
+	Cursor        string
+	Limit         int
+}
+
+type JobCursor struct {
+	Sort          string    `json:"sort"`
+	ID            uint      `json:"id"`
+	CreatedAt     time.Time `json:"created_at"`
+	MinExperience int       `json:"min_experience"`
+	Salary        float64   `json:"salary"`
+}
+
+type JobPage struct {
+	Jobs       []Job  `json:"jobs"`
+	NextCursor string `json:"next_cursor,omitempty"`
+}
+
+type JobSearchResult struct {
+	Job                  Job     `json:"job"`
+	Rank                 float64 `json:"rank"`
+	JobnameHighlight     string  `json:"jobname_highlight"`
+	DescriptionHighlight string  `json:"description_highlight"`
+}
+
+type JobSearchPage struct {
+	Results    []JobSearchResult `json:"results"`
+	NextOffset *int              `json:"next_offset,omitempty"`
+}
+
+type FieldError struct {
+	Field   string `json:"field"`
+	Message string `json:"message"`
 }
 
 type Response struct {
@@ -70,11 +212,12 @@ type NewUserApplication struct {
 }
 
 type Requestfield struct {
-	NoticePeriod    int    `json:"noticePeriod" validate:"required"`
-	Location        []uint `json:"location"`
-	TechnologyStack []uint `json:"technologyStack"`
-	Experience      int    `json:"experience" validate:"required"`
-	Qualifications  []uint `json:"qualifications"`
-	Shift           []uint `json:"shifts"`
-	Jobtype         []uint `json:"jobtype"`
+	NoticePeriod    int          `json:"noticePeriod" validate:"required"`
+	Location        []uint       `json:"location"`
+	TechnologyStack []uint       `json:"technologyStack"`
+	Experience      int          `json:"experience" validate:"required"`
+	Qualifications  []uint       `json:"qualifications"`
+	Shift           []uint       `json:"shifts"`
+	Jobtype         []uint       `json:"jobtype"`
+	Skills          []SkillLevel `json:"skills" validate:"dive"`
 }
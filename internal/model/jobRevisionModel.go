@@ -0,0 +1,55 @@
+package model
+
+import "time"
+
+// JobRevision is an immutable copy of a job taken every time the job is
+// created, changed or deleted. Revisions are numbered from 1 for every job,
+// the one taken on delete is marked Deleted and keeps the job as it was.
+type JobRevision struct {
+	ID        uint        `json:"id" gorm:"primarykey"`
+	JobID     uint        `json:"job_id" gorm:"uniqueIndex:idx_job_revision"`
+	Revision  int         `json:"revision" gorm:"uniqueIndex:idx_job_revision"`
+	Snapshot  JobSnapshot `json:"snapshot" gorm:"serializer:json"`
+	Deleted   bool        `json:"deleted"`
+	CreatedAt time.Time   `json:"created_at"`
+}
+
+// JobSnapshot holds the editable fields of a job, with the association lists
+// stored as ids so that a revision does not change when reference data is
+// renamed.
+type JobSnapshot struct {
+	Jobname           string             `json:"jobName"`
+	MinNoticePeriod   int                `json:"minNoticePeriod"`
+	MaxNoticePeriod   uint               `json:"maxNoticePeriod"`
+	Location          []uint             `json:"location"`
+	TechnologyStack   []uint             `json:"technologyStack"`
+	Description       string             `json:"description"`
+	MinExperience     int                `json:"minExperience"`
+	MaxExperience     uint               `json:"maxExperience"`
+	Qualifications    []uint             `json:"qualifications"`
+	Shift             []uint             `json:"shifts"`
+	Jobtype           []uint             `json:"jobtype"`
+	Status            string             `json:"status"`
+	ExpiresAt         *time.Time         `json:"expiresAt"`
+	WorkMode          string             `json:"workMode"`
+	MinSalary         float64            `json:"minSalary"`
+	MaxSalary         float64            `json:"maxSalary"`
+	Currency          string             `json:"currency"`
+	PayPeriod         string             `json:"payPeriod"`
+	SalaryUndisclosed bool               `json:"salaryUndisclosed"`
+	SkillRequirements []SkillRequirement `json:"skillRequirements"`
+	ScoringPolicy     ScoringPolicy      `json:"scoringPolicy"`
+}
+
+type JobRevisionChange struct {
+	Field string      `json:"field"`
+	From  interface{} `json:"from"`
+	To    interface{} `json:"to"`
+}
+
+type JobRevisionDiff struct {
+	JobID   uint                `json:"job_id"`
+	From    int                 `json:"from"`
+	To      int                 `json:"to"`
+	Changes []JobRevisionChange `json:"changes"`
+}
@@ -0,0 +1,22 @@
+package model
+
+import "time"
+
+const NotificationApplicationWithdrawn = "application_withdrawn"
+
+// CompanyNotification tells a company about something a candidate did on
+// one of its jobs.
+type CompanyNotification struct {
+	ID            uint      `json:"id" gorm:"primarykey"`
+	CompanyID     uint      `json:"company_id" gorm:"index"`
+	JobID         uint      `json:"job_id"`
+	ApplicationID uint      `json:"application_id"`
+	Kind          string    `json:"kind"`
+	Message       string    `json:"message"`
+	CreatedAt     time.Time `json:"created_at"`
+}
+
+type CompanyNotificationPage struct {
+	Notifications []CompanyNotification `json:"notifications"`
+	NextOffset    *int                  `json:"next_offset,omitempty"`
+}
@@ -0,0 +1,31 @@
+package model
+
+import "time"
+
+// CandidateProfile holds the details a candidate applies with, so that
+// they do not have to send them with every application. A user has at most
+// one profile.
+type CandidateProfile struct {
+	UserID    uint         `json:"user_id" gorm:"primarykey;autoIncrement:false"`
+	User      User         `json:"-" gorm:"ForeignKey:UserID"`
+	Name      string       `json:"name"`
+	Age       string       `json:"age"`
+	Details   Requestfield `json:"details" gorm:"serializer:json"`
+	CreatedAt time.Time    `json:"created_at"`
+	UpdatedAt time.Time    `json:"updated_at"`
+}
+
+// NewCandidateProfile is the body of a profile update. The application
+// details are required too, validation goes into Jobs and checks its notice
+// period and experience, so a profile never applies with empty details.
+type NewCandidateProfile struct {
+	Name string       `json:"name" validate:"required"`
+	Age  string       `json:"age" validate:"required"`
+	Jobs Requestfield `json:"job_application"`
+}
+
+// ProfileApplication applies to a job with the details of the candidate's
+// profile.
+type ProfileApplication struct {
+	Jid uint `json:"jid" validate:"required"`
+}
@@ -0,0 +1,16 @@
+package model
+
+// ApplicationDraft is what could be read from a resume. The candidate
+// confirms or corrects it before applying with it.
+type ApplicationDraft struct {
+	Jobs    Requestfield `json:"job_application"`
+	Matches []DraftMatch `json:"matches"`
+}
+
+// DraftMatch is one reference data entry whose name was found in the
+// resume.
+type DraftMatch struct {
+	Kind string `json:"kind"`
+	ID   uint   `json:"id"`
+	Name string `json:"name"`
+}
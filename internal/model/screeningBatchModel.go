@@ -0,0 +1,50 @@
+package model
+
+import "time"
+
+const (
+	ScreeningBatchQueued    = "queued"
+	ScreeningBatchRunning   = "running"
+	ScreeningBatchCompleted = "completed"
+)
+
+const (
+	ScreeningItemPending  = "pending"
+	ScreeningItemScreened = "screened"
+	ScreeningItemFailed   = "failed"
+)
+
+// ScreeningBatch is a set of applications screened in the background. The
+// counters are updated as every item finishes, so they show the progress
+// of a running batch. SubmittedBy is the user who submitted the batch, the
+// only one who can read it.
+type ScreeningBatch struct {
+	ID          uint       `json:"id" gorm:"primarykey"`
+	SubmittedBy uint       `json:"submitted_by" gorm:"index"`
+	Status      string     `json:"status" gorm:"index"`
+	Total       int        `json:"total"`
+	Processed   int        `json:"processed"`
+	Accepted    int        `json:"accepted"`
+	Failed      int        `json:"failed"`
+	CreatedAt   time.Time  `json:"created_at"`
+	UpdatedAt   time.Time  `json:"updated_at"`
+	CompletedAt *time.Time `json:"completed_at"`
+}
+
+// ScreeningBatchItem is one application of a batch. Position is its place
+// in the submitted list, Error says why a failed item could not be
+// screened.
+type ScreeningBatchItem struct {
+	ID          uint               `json:"id" gorm:"primarykey"`
+	BatchID     uint               `json:"batch_id" gorm:"uniqueIndex:idx_screening_batch_item"`
+	Position    int                `json:"position" gorm:"uniqueIndex:idx_screening_batch_item"`
+	Status      string             `json:"status" gorm:"index"`
+	Application NewUserApplication `json:"application" gorm:"serializer:json"`
+	Screening   *ScreeningResult   `json:"screening" gorm:"serializer:json"`
+	Error       string             `json:"error,omitempty"`
+}
+
+type ScreeningBatchItemPage struct {
+	Items      []ScreeningBatchItem `json:"items"`
+	NextOffset *int                 `json:"next_offset,omitempty"`
+}
@@ -0,0 +1,125 @@
+package model
+
+const (
+	CriterionNoticePeriod   = "notice_period"
+	CriterionExperience     = "experience"
+	CriterionLocation       = "location"
+	CriterionSkills         = "skills"
+	CriterionQualifications = "qualifications"
+	CriterionShift          = "shift"
+	CriterionJobType        = "job_type"
+)
+
+// ScreeningCriteria lists the criteria in the order they are reported.
+var ScreeningCriteria = []string{
+	CriterionNoticePeriod,
+	CriterionExperience,
+	CriterionLocation,
+	CriterionSkills,
+	CriterionQualifications,
+	CriterionShift,
+	CriterionJobType,
+}
+
+const (
+	ProficiencyBeginner     = "beginner"
+	ProficiencyIntermediate = "intermediate"
+	ProficiencyAdvanced     = "advanced"
+	ProficiencyExpert       = "expert"
+)
+
+// SkillRequirement is a technology stack a job needs, with the minimum
+// proficiency and years of use. Either minimum can be left out.
+type SkillRequirement struct {
+	TechnologyStackID uint   `json:"technologyStackId" validate:"required"`
+	MinProficiency    string `json:"minProficiency" validate:"omitempty,oneof=beginner intermediate advanced expert"`
+	MinYears          int    `json:"minYears" validate:"gte=0"`
+}
+
+// SkillLevel is how well an applicant knows a technology stack.
+type SkillLevel struct {
+	TechnologyStackID uint   `json:"technologyStackId" validate:"required"`
+	Proficiency       string `json:"proficiency" validate:"omitempty,oneof=beginner intermediate advanced expert"`
+	Years             int    `json:"years" validate:"gte=0"`
+}
+
+// SkillResult is how far an application fulfils one required skill, from 0
+// to 1.
+type SkillResult struct {
+	TechnologyStackID uint    `json:"technology_stack_id"`
+	Fulfilment        float64 `json:"fulfilment"`
+	Reason            string  `json:"reason"`
+}
+
+// ScoringPolicy is how applications to a job are screened. A criterion
+// without a weight counts for one point and Threshold is the share of the
+// maximum score needed to pass, one half when it is not set.
+type ScoringPolicy struct {
+	Weights   map[string]float64 `json:"weights,omitempty"`
+	Threshold *float64           `json:"threshold,omitempty"`
+	Knockouts []Knockout         `json:"knockouts,omitempty"`
+}
+
+// Knockout is a must-have that rejects an application whatever its score.
+// Without an ID the criterion itself has to match, with an ID the
+// application has to list that id, e.g. a technology stack.
+type Knockout struct {
+	Criterion string `json:"criterion"`
+	ID        uint   `json:"id,omitempty"`
+}
+
+type KnockoutResult struct {
+	Criterion string `json:"criterion"`
+	ID        uint   `json:"id,omitempty"`
+	Met       bool   `json:"met"`
+	Reason    string `json:"reason"`
+}
+
+// CriterionResult explains how an application did against one screening
+// criterion of a job.
+type CriterionResult struct {
+	Criterion string  `json:"criterion"`
+	Matched   bool    `json:"matched"`
+	Weight    float64 `json:"weight"`
+	Score     float64 `json:"score"`
+	Reason    string  `json:"reason"`
+}
+
+// ScreeningResult is the outcome of screening an application against a job.
+// The application is accepted when Score reaches Threshold and no knockout
+// failed.
+type ScreeningResult struct {
+	Accepted   bool              `json:"accepted"`
+	Score      float64           `json:"score"`
+	MaxScore   float64           `json:"max_score"`
+	Threshold  float64           `json:"threshold"`
+	KnockedOut bool              `json:"knocked_out"`
+	Criteria   []CriterionResult `json:"criteria"`
+	Knockouts  []KnockoutResult  `json:"knockouts,omitempty"`
+	Skills     []SkillResult     `json:"skills,omitempty"`
+}
+
+// ScreenedApplication is an application from the screening endpoint together
+// with its result.
+type ScreenedApplication struct {
+	NewUserApplication
+	Screening ScreeningResult `json:"screening"`
+}
+
+// StreamedApplication is one line read from a streamed screening request.
+// Err is set when the line could not be read as an application.
+type StreamedApplication struct {
+	Line        int
+	Application NewUserApplication
+	Err         error
+}
+
+// ScreeningStreamResult is one line of a streamed screening response. Line
+// is the request line it answers, results are written as soon as they are
+// decided so they do not keep the order of the request.
+type ScreeningStreamResult struct {
+	Line        int                 `json:"line"`
+	Application *NewUserApplication `json:"application,omitempty"`
+	Screening   *ScreeningResult    `json:"screening,omitempty"`
+	Error       string              `json:"error,omitempty"`
+}
@@ -0,0 +1,35 @@
+package model
+
+const (
+	TaxonomyLocation        = "locations"
+	TaxonomyTechnologyStack = "technology_stacks"
+	TaxonomyQualification   = "qualifications"
+	TaxonomyShift           = "shifts"
+	TaxonomyJobType         = "job_types"
+)
+
+type Taxonomy struct {
+	ID        uint     `json:"id"`
+	Name      string   `json:"name"`
+	Latitude  *float64 `json:"latitude,omitempty"`
+	Longitude *float64 `json:"longitude,omitempty"`
+}
+
+type NewTaxonomy struct {
+	Name string `json:"name" validate:"required"`
+}
+
+type Coordinates struct {
+	Latitude  *float64 `json:"latitude" validate:"required,gte=-90,lte=90"`
+	Longitude *float64 `json:"longitude" validate:"required,gte=-180,lte=180"`
+}
+
+type TaxonomyCatalogue struct {
+	Locations        []string `json:"locations"`
+	TechnologyStacks []string `json:"technology_stacks"`
+	Qualifications   []string `json:"qualifications"`
+	Shifts           []string `json:"shifts"`
+	JobTypes         []string `json:"job_types"`
+	//coordinates of the seeded locations, keyed by place name
+	LocationCoordinates map[string]Coordinates `json:"location_coordinates"`
+}
@@ -0,0 +1,232 @@
+package repository
+
+import (
+	"errors"
+	"job-portal-api/internal/model"
+
+	"github.com/rs/zerolog/log"
+	"gorm.io/gorm"
+)
+
+var (
+	ErrApplicationNotFound  = errors.New("could not find the application")
+	ErrDuplicateApplication = errors.New("application already exists")
+)
+
+//go:generate mockgen -source=applicationRepository.go -destination=applicationRepository_mock.go -package=repository
+type ApplicationRepository interface {
+	CreateApplication(application model.Application) (model.Application, error)
+	GetApplicationByID(aID uint) (model.Application, error)
+	GetApplicationByUserAndJob(uID uint, jID uint) (model.Application, error)
+	UpdateApplicationDetails(application model.Application) (model.Application, error)
+	ReopenApplication(application model.Application, change model.ApplicationStageHistory) (model.Application, error)
+	GetApplicationsByJobID(jID uint) ([]model.Application, error)
+	GetApplicationsByUserID(uID uint) ([]model.Application, error)
+	UpdateApplicationStage(application model.Application, change model.ApplicationStageHistory) (model.Application, error)
+	GetApplicationStageHistory(aID uint) ([]model.ApplicationStageHistory, error)
+	WithdrawApplication(application model.Application, change model.ApplicationStageHistory, notification model.CompanyNotification) (model.Application, error)
+}
+
+func NewApplicationRepo(db *gorm.DB) (ApplicationRepository, error) {
+	if db == nil {
+		log.Info().Msg("database cannot be nil")
+		return nil, errors.New("database cannot be nil")
+	}
+	return &Repo{
+		db: db,
+	}, nil
+}
+
+func (r *Repo) CreateApplication(application model.Application) (model.Application, error) {
+
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		output := tx.Omit("Job").Create(&application)
+		if output.Error != nil {
+			return output.Error
+		}
+		return tx.Create(&model.ApplicationStageHistory{
+			ApplicationID: application.ID,
+			To:            application.Stage,
+			ChangedBy:     application.UserID,
+			ChangedAt:     application.StageChangedAt,
+		}).Error
+	})
+	if errors.Is(err, gorm.ErrDuplicatedKey) {
+		log.Info().Uint("user id", application.UserID).Uint("job id", application.JobID).Msg("application already exists")
+		return model.Application{}, ErrDuplicateApplication
+	}
+	if err != nil {
+		log.Error().Err(err).Msg("error in creating application")
+		return model.Application{}, errors.New("could not create application")
+	}
+
+	return application, nil
+}
+
+func (r *Repo) GetApplicationByID(aID uint) (model.Application, error) {
+
+	var application model.Application
+
+	output := r.db.Where("id = ?", aID).First(&application)
+	if output.Error != nil {
+		log.Error().Err(output.Error).Msg("error in application id")
+		return model.Application{}, errors.New("could not find the application")
+	}
+
+	return application, nil
+}
+
+// GetApplicationByUserAndJob returns ErrApplicationNotFound when the user
+// has not applied to the job.
+func (r *Repo) GetApplicationByUserAndJob(uID uint, jID uint) (model.Application, error) {
+
+	var application model.Application
+
+	output := r.db.Where("user_id = ? AND job_id = ?", uID, jID).First(&application)
+	if errors.Is(output.Error, gorm.ErrRecordNotFound) {
+		return model.Application{}, ErrApplicationNotFound
+	}
+	if output.Error != nil {
+		log.Error().Err(output.Error).Msg("error in fetching application")
+		return model.Application{}, errors.New("could not fetch the application")
+	}
+
+	return application, nil
+}
+
+// UpdateApplicationDetails stores a resubmitted application together with
+// its new screening result.
+func (r *Repo) UpdateApplicationDetails(application model.Application) (model.Application, error) {
+
+	output := r.db.Model(&application).Omit("Job").
+		Select("name", "age", "details", "accepted", "screening", "screened_at").
+		Updates(&application)
+	if output.Error != nil {
+		log.Error().Err(output.Error).Msg("error in updating application")
+		return model.Application{}, errors.New("could not update the application")
+	}
+
+	return application, nil
+}
+
+// ReopenApplication stores the new details of a withdrawn application and
+// moves it back into the pipeline in one transaction.
+func (r *Repo) ReopenApplication(application model.Application, change model.ApplicationStageHistory) (model.Application, error) {
+
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		output := tx.Model(&application).Omit("Job").
+			Select("name", "age", "details", "accepted", "screening", "screened_at").
+			Updates(&application)
+		if output.Error != nil {
+			return output.Error
+		}
+		return updateApplicationStage(tx, application, change)
+	})
+	if err != nil {
+		log.Error().Err(err).Msg("error in reopening application")
+		return model.Application{}, errors.New("could not reopen the application")
+	}
+
+	application.Stage = change.To
+	application.StageChangedAt = change.ChangedAt
+	return application, nil
+}
+
+func (r *Repo) GetApplicationsByJobID(jID uint) ([]model.Application, error) {
+
+	applications := []model.Application{}
+
+	output := r.db.Where("job_id = ?", jID).Order("created_at, id").Find(&applications)
+	if output.Error != nil {
+		log.Error().Err(output.Error).Msg("error in fetching applications")
+		return nil, errors.New("could not fetch applications for the job")
+	}
+
+	return applications, nil
+}
+
+// GetApplicationsByUserID returns the user's applications newest first, with
+// their job and its company. A deleted job is still loaded so that the
+// candidate can see where they applied.
+func (r *Repo) GetApplicationsByUserID(uID uint) ([]model.Application, error) {
+
+	applications := []model.Application{}
+
+	output := r.db.Where("user_id = ?", uID).
+		Preload("Job", func(db *gorm.DB) *gorm.DB { return db.Unscoped() }).
+		Preload("Job.Company", func(db *gorm.DB) *gorm.DB { return db.Unscoped() }).
+		Order("created_at DESC, id DESC").Find(&applications)
+	if output.Error != nil {
+		log.Error().Err(output.Error).Msg("error in fetching applications")
+		return nil, errors.New("could not fetch applications of the user")
+	}
+
+	return applications, nil
+}
+
+// UpdateApplicationStage moves the application to change.To and records the
+// move. The update only applies while the application is still in
+// change.From, so two recruiters moving the same application at once cannot
+// both succeed.
+func (r *Repo) UpdateApplicationStage(application model.Application, change model.ApplicationStageHistory) (model.Application, error) {
+
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		return updateApplicationStage(tx, application, change)
+	})
+	if err != nil {
+		log.Error().Err(err).Msg("error in updating application stage")
+		return model.Application{}, errors.New("could not update application stage")
+	}
+
+	application.Stage = change.To
+	application.StageChangedAt = change.ChangedAt
+	return application, nil
+}
+
+// WithdrawApplication moves the application to withdrawn like
+// UpdateApplicationStage and stores the notification to the company in the
+// same transaction.
+func (r *Repo) WithdrawApplication(application model.Application, change model.ApplicationStageHistory, notification model.CompanyNotification) (model.Application, error) {
+
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		err := updateApplicationStage(tx, application, change)
+		if err != nil {
+			return err
+		}
+		return tx.Create(&notification).Error
+	})
+	if err != nil {
+		log.Error().Err(err).Msg("error in withdrawing application")
+		return model.Application{}, errors.New("could not withdraw the application")
+	}
+
+	application.Stage = change.To
+	application.StageChangedAt = change.ChangedAt
+	return application, nil
+}
+
+func updateApplicationStage(tx *gorm.DB, application model.Application, change model.ApplicationStageHistory) error {
+	output := tx.Model(&model.Application{}).
+		Where("id = ? AND stage = ?", application.ID, change.From).
+		Updates(map[string]interface{}{"stage": change.To, "stage_changed_at": change.ChangedAt})
+	if output.Error != nil {
+		return output.Error
+	}
+	if output.RowsAffected == 0 {
+		return errors.New("application stage has changed")
+	}
+	return tx.Create(&change).Error
+}
+
+func (r *Repo) GetApplicationStageHistory(aID uint) ([]model.ApplicationStageHistory, error) {
+
+	history := []model.ApplicationStageHistory{}
+
+	output := r.db.Where("application_id = ?", aID).Order("changed_at, id").Find(&history)
+	if output.Error != nil {
+		log.Error().Err(output.Error).Msg("error in fetching application stage history")
+		return nil, errors.New("could not fetch application stage history")
+	}
+
+	return history, nil
+}
@@ -0,0 +1,189 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: applicationRepository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=applicationRepository.go -destination=applicationRepository_mock.go -package=repository
+//
+// Package repository is a generated GoMock package.
+package repository
+
+import (
+	model "job-portal-api/internal/model"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockApplicationRepository is a mock of ApplicationRepository interface.
+type MockApplicationRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockApplicationRepositoryMockRecorder
+}
+
+// MockApplicationRepositoryMockRecorder is the mock recorder for MockApplicationRepository.
+type MockApplicationRepositoryMockRecorder struct {
+	mock *MockApplicationRepository
+}
+
+// NewMockApplicationRepository creates a new mock instance.
+func NewMockApplicationRepository(ctrl *gomock.Controller) *MockApplicationRepository {
+	mock := &MockApplicationRepository{ctrl: ctrl}
+	mock.recorder = &MockApplicationRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockApplicationRepository) EXPECT() *MockApplicationRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CreateApplication mocks base method.
+func (m *MockApplicationRepository) CreateApplication(application model.Application) (model.Application, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateApplication", application)
+	ret0, _ := ret[0].(model.Application)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateApplication indicates an expected call of CreateApplication.
+func (mr *MockApplicationRepositoryMockRecorder) CreateApplication(application any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateApplication", reflect.TypeOf((*MockApplicationRepository)(nil).CreateApplication), application)
+}
+
+// GetApplicationByID mocks base method.
+func (m *MockApplicationRepository) GetApplicationByID(aID uint) (model.Application, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetApplicationByID", aID)
+	ret0, _ := ret[0].(model.Application)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetApplicationByID indicates an expected call of GetApplicationByID.
+func (mr *MockApplicationRepositoryMockRecorder) GetApplicationByID(aID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetApplicationByID", reflect.TypeOf((*MockApplicationRepository)(nil).GetApplicationByID), aID)
+}
+
+// GetApplicationByUserAndJob mocks base method.
+func (m *MockApplicationRepository) GetApplicationByUserAndJob(uID, jID uint) (model.Application, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetApplicationByUserAndJob", uID, jID)
+	ret0, _ := ret[0].(model.Application)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetApplicationByUserAndJob indicates an expected call of GetApplicationByUserAndJob.
+func (mr *MockApplicationRepositoryMockRecorder) GetApplicationByUserAndJob(uID, jID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetApplicationByUserAndJob", reflect.TypeOf((*MockApplicationRepository)(nil).GetApplicationByUserAndJob), uID, jID)
+}
+
+// GetApplicationStageHistory mocks base method.
+func (m *MockApplicationRepository) GetApplicationStageHistory(aID uint) ([]model.ApplicationStageHistory, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetApplicationStageHistory", aID)
+	ret0, _ := ret[0].([]model.ApplicationStageHistory)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetApplicationStageHistory indicates an expected call of GetApplicationStageHistory.
+func (mr *MockApplicationRepositoryMockRecorder) GetApplicationStageHistory(aID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetApplicationStageHistory", reflect.TypeOf((*MockApplicationRepository)(nil).GetApplicationStageHistory), aID)
+}
+
+// GetApplicationsByJobID mocks base method.
+func (m *MockApplicationRepository) GetApplicationsByJobID(jID uint) ([]model.Application, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetApplicationsByJobID", jID)
+	ret0, _ := ret[0].([]model.Application)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetApplicationsByJobID indicates an expected call of GetApplicationsByJobID.
+func (mr *MockApplicationRepositoryMockRecorder) GetApplicationsByJobID(jID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetApplicationsByJobID", reflect.TypeOf((*MockApplicationRepository)(nil).GetApplicationsByJobID), jID)
+}
+
+// GetApplicationsByUserID mocks base method.
+func (m *MockApplicationRepository) GetApplicationsByUserID(uID uint) ([]model.Application, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetApplicationsByUserID", uID)
+	ret0, _ := ret[0].([]model.Application)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetApplicationsByUserID indicates an expected call of GetApplicationsByUserID.
+func (mr *MockApplicationRepositoryMockRecorder) GetApplicationsByUserID(uID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetApplicationsByUserID", reflect.TypeOf((*MockApplicationRepository)(nil).GetApplicationsByUserID), uID)
+}
+
+// ReopenApplication mocks base method.
+func (m *MockApplicationRepository) ReopenApplication(application model.Application, change model.ApplicationStageHistory) (model.Application, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReopenApplication", application, change)
+	ret0, _ := ret[0].(model.Application)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReopenApplication indicates an expected call of ReopenApplication.
+func (mr *MockApplicationRepositoryMockRecorder) ReopenApplication(application, change any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReopenApplication", reflect.TypeOf((*MockApplicationRepository)(nil).ReopenApplication), application, change)
+}
+
+// UpdateApplicationDetails mocks base method.
+func (m *MockApplicationRepository) UpdateApplicationDetails(application model.Application) (model.Application, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateApplicationDetails", application)
+	ret0, _ := ret[0].(model.Application)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateApplicationDetails indicates an expected call of UpdateApplicationDetails.
+func (mr *MockApplicationRepositoryMockRecorder) UpdateApplicationDetails(application any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateApplicationDetails", reflect.TypeOf((*MockApplicationRepository)(nil).UpdateApplicationDetails), application)
+}
+
+// UpdateApplicationStage mocks base method.
+func (m *MockApplicationRepository) UpdateApplicationStage(application model.Application, change model.ApplicationStageHistory) (model.Application, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateApplicationStage", application, change)
+	ret0, _ := ret[0].(model.Application)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateApplicationStage indicates an expected call of UpdateApplicationStage.
+func (mr *MockApplicationRepositoryMockRecorder) UpdateApplicationStage(application, change any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateApplicationStage", reflect.TypeOf((*MockApplicationRepository)(nil).UpdateApplicationStage), application, change)
+}
+
+// WithdrawApplication mocks base method.
+func (m *MockApplicationRepository) WithdrawApplication(application model.Application, change model.ApplicationStageHistory, notification model.CompanyNotification) (model.Application, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WithdrawApplication", application, change, notification)
+	ret0, _ := ret[0].(model.Application)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WithdrawApplication indicates an expected call of WithdrawApplication.
+func (mr *MockApplicationRepositoryMockRecorder) WithdrawApplication(application, change, notification any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WithdrawApplication", reflect.TypeOf((*MockApplicationRepository)(nil).WithdrawApplication), application, change, notification)
+}
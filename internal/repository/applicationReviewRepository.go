@@ -0,0 +1,154 @@
+package repository
+
+import (
+	"errors"
+	"job-portal-api/internal/model"
+
+	"github.com/rs/zerolog/log"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+var ErrRatingNotFound = errors.New("application is not rated")
+
+//go:generate mockgen -source=applicationReviewRepository.go -destination=applicationReviewRepository_mock.go -package=repository
+type ApplicationReviewRepository interface {
+	CreateApplicationNote(note model.ApplicationNote) (model.ApplicationNote, error)
+	GetApplicationNotes(aID uint) ([]model.ApplicationNote, error)
+	ReplaceApplicationTags(aID uint, tags []string, taggedBy uint) error
+	GetApplicationTags(aID uint) ([]string, error)
+	SaveApplicationRating(rating model.ApplicationRating) error
+	GetApplicationRating(aID uint) (model.ApplicationRating, error)
+	FilterApplications(jID uint, tag string, minRating int) ([]model.Application, error)
+}
+
+func NewApplicationReviewRepo(db *gorm.DB) (ApplicationReviewRepository, error) {
+	if db == nil {
+		log.Info().Msg("database cannot be nil")
+		return nil, errors.New("database cannot be nil")
+	}
+	return &Repo{
+		db: db,
+	}, nil
+}
+
+func (r *Repo) CreateApplicationNote(note model.ApplicationNote) (model.ApplicationNote, error) {
+
+	output := r.db.Omit("Author").Create(&note)
+	if output.Error != nil {
+		log.Error().Err(output.Error).Msg("error in creating application note")
+		return model.ApplicationNote{}, errors.New("could not create the note")
+	}
+
+	return note, nil
+}
+
+// GetApplicationNotes returns the notes oldest first with their authors.
+func (r *Repo) GetApplicationNotes(aID uint) ([]model.ApplicationNote, error) {
+
+	notes := []model.ApplicationNote{}
+
+	output := r.db.Where("application_id = ?", aID).Preload("Author").Order("created_at, id").Find(&notes)
+	if output.Error != nil {
+		log.Error().Err(output.Error).Msg("error in fetching application notes")
+		return nil, errors.New("could not fetch the notes")
+	}
+
+	return notes, nil
+}
+
+// ReplaceApplicationTags sets the tags of the application to tags.
+func (r *Repo) ReplaceApplicationTags(aID uint, tags []string, taggedBy uint) error {
+
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		output := tx.Where("application_id = ?", aID).Delete(&model.ApplicationTag{})
+		if output.Error != nil {
+			return output.Error
+		}
+		if len(tags) == 0 {
+			return nil
+		}
+
+		rows := make([]model.ApplicationTag, 0, len(tags))
+		for _, v := range tags {
+			rows = append(rows, model.ApplicationTag{ApplicationID: aID, Tag: v, TaggedBy: taggedBy})
+		}
+		return tx.Create(&rows).Error
+	})
+	if err != nil {
+		log.Error().Err(err).Msg("error in replacing application tags")
+		return errors.New("could not update the tags")
+	}
+
+	return nil
+}
+
+func (r *Repo) GetApplicationTags(aID uint) ([]string, error) {
+
+	tags := []string{}
+
+	output := r.db.Model(&model.ApplicationTag{}).Where("application_id = ?", aID).Order("tag").Pluck("tag", &tags)
+	if output.Error != nil {
+		log.Error().Err(output.Error).Msg("error in fetching application tags")
+		return nil, errors.New("could not fetch the tags")
+	}
+
+	return tags, nil
+}
+
+// SaveApplicationRating rates the application, replacing an earlier rating.
+func (r *Repo) SaveApplicationRating(rating model.ApplicationRating) error {
+
+	output := r.db.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "application_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"rating", "rated_by", "rated_at"}),
+	}).Create(&rating)
+	if output.Error != nil {
+		log.Error().Err(output.Error).Msg("error in saving application rating")
+		return errors.New("could not save the rating")
+	}
+
+	return nil
+}
+
+// GetApplicationRating returns ErrRatingNotFound when the application has
+// not been rated.
+func (r *Repo) GetApplicationRating(aID uint) (model.ApplicationRating, error) {
+
+	var rating model.ApplicationRating
+
+	output := r.db.Where("application_id = ?", aID).First(&rating)
+	if errors.Is(output.Error, gorm.ErrRecordNotFound) {
+		return model.ApplicationRating{}, ErrRatingNotFound
+	}
+	if output.Error != nil {
+		log.Error().Err(output.Error).Msg("error in fetching application rating")
+		return model.ApplicationRating{}, errors.New("could not fetch the rating")
+	}
+
+	return rating, nil
+}
+
+// FilterApplications returns the applications of the job carrying the tag
+// and rated at least minRating. An empty tag or a minRating of 0 does not
+// filter.
+func (r *Repo) FilterApplications(jID uint, tag string, minRating int) ([]model.Application, error) {
+
+	applications := []model.Application{}
+
+	query := r.db.Where("job_id = ?", jID)
+	if tag != "" {
+		query = query.Where("id IN (?)", r.db.Model(&model.ApplicationTag{}).Select("application_id").Where("tag = ?", tag))
+	}
+	if minRating > 0 {
+		query = query.Where("id IN (?)", r.db.Model(&model.ApplicationRating{}).Select("application_id").Where("rating >= ?", minRating))
+	}
+
+	output := query.Order("created_at, id").Find(&applications)
+	if output.Error != nil {
+		log.Error().Err(output.Error).Msg("error in filtering applications")
+		return nil, errors.New("could not fetch applications for the job")
+	}
+
+	return applications, nil
+}
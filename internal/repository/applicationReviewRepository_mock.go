@@ -0,0 +1,142 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: applicationReviewRepository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=applicationReviewRepository.go -destination=applicationReviewRepository_mock.go -package=repository
+//
+// Package repository is a generated GoMock package.
+package repository
+
+import (
+	model "job-portal-api/internal/model"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockApplicationReviewRepository is a mock of ApplicationReviewRepository interface.
+type MockApplicationReviewRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockApplicationReviewRepositoryMockRecorder
+}
+
+// MockApplicationReviewRepositoryMockRecorder is the mock recorder for MockApplicationReviewRepository.
+type MockApplicationReviewRepositoryMockRecorder struct {
+	mock *MockApplicationReviewRepository
+}
+
+// NewMockApplicationReviewRepository creates a new mock instance.
+func NewMockApplicationReviewRepository(ctrl *gomock.Controller) *MockApplicationReviewRepository {
+	mock := &MockApplicationReviewRepository{ctrl: ctrl}
+	mock.recorder = &MockApplicationReviewRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockApplicationReviewRepository) EXPECT() *MockApplicationReviewRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CreateApplicationNote mocks base method.
+func (m *MockApplicationReviewRepository) CreateApplicationNote(note model.ApplicationNote) (model.ApplicationNote, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateApplicationNote", note)
+	ret0, _ := ret[0].(model.ApplicationNote)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateApplicationNote indicates an expected call of CreateApplicationNote.
+func (mr *MockApplicationReviewRepositoryMockRecorder) CreateApplicationNote(note any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateApplicationNote", reflect.TypeOf((*MockApplicationReviewRepository)(nil).CreateApplicationNote), note)
+}
+
+// FilterApplications mocks base method.
+func (m *MockApplicationReviewRepository) FilterApplications(jID uint, tag string, minRating int) ([]model.Application, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FilterApplications", jID, tag, minRating)
+	ret0, _ := ret[0].([]model.Application)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FilterApplications indicates an expected call of FilterApplications.
+func (mr *MockApplicationReviewRepositoryMockRecorder) FilterApplications(jID, tag, minRating any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FilterApplications", reflect.TypeOf((*MockApplicationReviewRepository)(nil).FilterApplications), jID, tag, minRating)
+}
+
+// GetApplicationNotes mocks base method.
+func (m *MockApplicationReviewRepository) GetApplicationNotes(aID uint) ([]model.ApplicationNote, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetApplicationNotes", aID)
+	ret0, _ := ret[0].([]model.ApplicationNote)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetApplicationNotes indicates an expected call of GetApplicationNotes.
+func (mr *MockApplicationReviewRepositoryMockRecorder) GetApplicationNotes(aID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetApplicationNotes", reflect.TypeOf((*MockApplicationReviewRepository)(nil).GetApplicationNotes), aID)
+}
+
+// GetApplicationRating mocks base method.
+func (m *MockApplicationReviewRepository) GetApplicationRating(aID uint) (model.ApplicationRating, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetApplicationRating", aID)
+	ret0, _ := ret[0].(model.ApplicationRating)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetApplicationRating indicates an expected call of GetApplicationRating.
+func (mr *MockApplicationReviewRepositoryMockRecorder) GetApplicationRating(aID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetApplicationRating", reflect.TypeOf((*MockApplicationReviewRepository)(nil).GetApplicationRating), aID)
+}
+
+// GetApplicationTags mocks base method.
+func (m *MockApplicationReviewRepository) GetApplicationTags(aID uint) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetApplicationTags", aID)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetApplicationTags indicates an expected call of GetApplicationTags.
+func (mr *MockApplicationReviewRepositoryMockRecorder) GetApplicationTags(aID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetApplicationTags", reflect.TypeOf((*MockApplicationReviewRepository)(nil).GetApplicationTags), aID)
+}
+
+// ReplaceApplicationTags mocks base method.
+func (m *MockApplicationReviewRepository) ReplaceApplicationTags(aID uint, tags []string, taggedBy uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplaceApplicationTags", aID, tags, taggedBy)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReplaceApplicationTags indicates an expected call of ReplaceApplicationTags.
+func (mr *MockApplicationReviewRepositoryMockRecorder) ReplaceApplicationTags(aID, tags, taggedBy any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplaceApplicationTags", reflect.TypeOf((*MockApplicationReviewRepository)(nil).ReplaceApplicationTags), aID, tags, taggedBy)
+}
+
+// SaveApplicationRating mocks base method.
+func (m *MockApplicationReviewRepository) SaveApplicationRating(rating model.ApplicationRating) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveApplicationRating", rating)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveApplicationRating indicates an expected call of SaveApplicationRating.
+func (mr *MockApplicationReviewRepositoryMockRecorder) SaveApplicationRating(rating any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveApplicationRating", reflect.TypeOf((*MockApplicationReviewRepository)(nil).SaveApplicationRating), rating)
+}
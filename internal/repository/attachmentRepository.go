@@ -0,0 +1,63 @@
+package repository
+
+import (
+	"errors"
+	"job-portal-api/internal/model"
+
+	"github.com/rs/zerolog/log"
+	"gorm.io/gorm"
+)
+
+//go:generate mockgen -source=attachmentRepository.go -destination=attachmentRepository_mock.go -package=repository
+type AttachmentRepository interface {
+	CreateAttachment(attachment model.Attachment) (model.Attachment, error)
+	GetAttachmentByID(atID uint) (model.Attachment, error)
+	GetAttachmentsByApplicationID(aID uint) ([]model.Attachment, error)
+}
+
+func NewAttachmentRepo(db *gorm.DB) (AttachmentRepository, error) {
+	if db == nil {
+		log.Info().Msg("database cannot be nil")
+		return nil, errors.New("database cannot be nil")
+	}
+	return &Repo{
+		db: db,
+	}, nil
+}
+
+func (r *Repo) CreateAttachment(attachment model.Attachment) (model.Attachment, error) {
+
+	output := r.db.Create(&attachment)
+	if output.Error != nil {
+		log.Error().Err(output.Error).Msg("error in creating attachment")
+		return model.Attachment{}, errors.New("could not create attachment")
+	}
+
+	return attachment, nil
+}
+
+func (r *Repo) GetAttachmentByID(atID uint) (model.Attachment, error) {
+
+	var attachment model.Attachment
+
+	output := r.db.Where("id = ?", atID).First(&attachment)
+	if output.Error != nil {
+		log.Error().Err(output.Error).Msg("error in attachment id")
+		return model.Attachment{}, errors.New("could not find the attachment")
+	}
+
+	return attachment, nil
+}
+
+func (r *Repo) GetAttachmentsByApplicationID(aID uint) ([]model.Attachment, error) {
+
+	attachments := []model.Attachment{}
+
+	output := r.db.Where("application_id = ?", aID).Order("created_at, id").Find(&attachments)
+	if output.Error != nil {
+		log.Error().Err(output.Error).Msg("error in fetching attachments")
+		return nil, errors.New("could not fetch attachments for the application")
+	}
+
+	return attachments, nil
+}
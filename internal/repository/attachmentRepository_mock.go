@@ -0,0 +1,84 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: attachmentRepository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=attachmentRepository.go -destination=attachmentRepository_mock.go -package=repository
+//
+// Package repository is a generated GoMock package.
+package repository
+
+import (
+	model "job-portal-api/internal/model"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockAttachmentRepository is a mock of AttachmentRepository interface.
+type MockAttachmentRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockAttachmentRepositoryMockRecorder
+}
+
+// MockAttachmentRepositoryMockRecorder is the mock recorder for MockAttachmentRepository.
+type MockAttachmentRepositoryMockRecorder struct {
+	mock *MockAttachmentRepository
+}
+
+// NewMockAttachmentRepository creates a new mock instance.
+func NewMockAttachmentRepository(ctrl *gomock.Controller) *MockAttachmentRepository {
+	mock := &MockAttachmentRepository{ctrl: ctrl}
+	mock.recorder = &MockAttachmentRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAttachmentRepository) EXPECT() *MockAttachmentRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CreateAttachment mocks base method.
+func (m *MockAttachmentRepository) CreateAttachment(attachment model.Attachment) (model.Attachment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAttachment", attachment)
+	ret0, _ := ret[0].(model.Attachment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateAttachment indicates an expected call of CreateAttachment.
+func (mr *MockAttachmentRepositoryMockRecorder) CreateAttachment(attachment any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAttachment", reflect.TypeOf((*MockAttachmentRepository)(nil).CreateAttachment), attachment)
+}
+
+// GetAttachmentByID mocks base method.
+func (m *MockAttachmentRepository) GetAttachmentByID(atID uint) (model.Attachment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAttachmentByID", atID)
+	ret0, _ := ret[0].(model.Attachment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAttachmentByID indicates an expected call of GetAttachmentByID.
+func (mr *MockAttachmentRepositoryMockRecorder) GetAttachmentByID(atID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAttachmentByID", reflect.TypeOf((*MockAttachmentRepository)(nil).GetAttachmentByID), atID)
+}
+
+// GetAttachmentsByApplicationID mocks base method.
+func (m *MockAttachmentRepository) GetAttachmentsByApplicationID(aID uint) ([]model.Attachment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAttachmentsByApplicationID", aID)
+	ret0, _ := ret[0].([]model.Attachment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAttachmentsByApplicationID indicates an expected call of GetAttachmentsByApplicationID.
+func (mr *MockAttachmentRepositoryMockRecorder) GetAttachmentsByApplicationID(aID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAttachmentsByApplicationID", reflect.TypeOf((*MockAttachmentRepository)(nil).GetAttachmentsByApplicationID), aID)
+}
@@ -2,18 +2,41 @@ package repository
 
 import (
 	"errors"
+	"fmt"
 	"job-portal-api/internal/model"
+	"strings"
+	"time"
 
 	"github.com/rs/zerolog/log"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 //go:generate mockgen -source=jobRepository.go -destination=jobRepository_mock.go -package=repository
 type JobRepository interface {
 	CreateJob(jodData model.Job) (model.Response, error)
+	CreateJobs(jobData []model.Job) ([]uint, error)
 	GetJobByCompanyID(cID uint) ([]model.Job, error)
 	GetJobByJobID(cID uint) (model.Job, error)
-	GetAllJobs() ([]model.Job, error)
+	FilterJobs(filter model.JobFilter, after *model.JobCursor) ([]model.Job, error)
+	SearchJobs(query string, filter model.JobFilter, offset int) ([]model.JobSearchResult, error)
+	UpdateJob(jobData model.Job) (model.Job, error)
+	DeleteJob(jID uint) error
+	ExpireJobs(now time.Time) ([]uint, error)
+	GetJobRevisions(jID uint) ([]model.JobRevision, error)
+	GetJobRevision(jID uint, revision int) (model.JobRevision, error)
+}
+
+// MissingReferenceError is returned by UpdateJob when the job points at
+// reference data that does not exist or was retired. Saving the job anyway
+// would drop those entries without telling anyone.
+type MissingReferenceError struct {
+	Kind string
+	IDs  []uint
+}
+
+func (e *MissingReferenceError) Error() string {
+	return fmt.Sprintf("unknown %s ids %v", e.Kind, e.IDs)
 }
 
 func NewJobRepo(db *gorm.DB) (JobRepository, error) {
@@ -29,10 +52,15 @@ func NewJobRepo(db *gorm.DB) (JobRepository, error) {
 
 func (r *Repo) CreateJob(jobData model.Job) (model.Response, error) {
 
-	output := r.db.Create(&jobData)
-
-	if output.Error != nil {
-		log.Error().Err(output.Error).Msg("error in creating job table")
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		output := tx.Create(&jobData)
+		if output.Error != nil {
+			return output.Error
+		}
+		return addJobRevision(tx, jobData)
+	})
+	if err != nil {
+		log.Error().Err(err).Msg("error in creating job table")
 		return model.Response{}, errors.New("could not create job")
 	}
 
@@ -41,6 +69,33 @@ func (r *Repo) CreateJob(jobData model.Job) (model.Response, error) {
 	}, nil
 }
 
+// CreateJobs stores all the jobs in one transaction, if one of them fails
+// none of them is kept.
+func (r *Repo) CreateJobs(jobData []model.Job) ([]uint, error) {
+
+	jobIDs := make([]uint, 0, len(jobData))
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		for i := range jobData {
+			output := tx.Create(&jobData[i])
+			if output.Error != nil {
+				return output.Error
+			}
+			err := addJobRevision(tx, jobData[i])
+			if err != nil {
+				return err
+			}
+			jobIDs = append(jobIDs, jobData[i].ID)
+		}
+		return nil
+	})
+	if err != nil {
+		log.Error().Err(err).Msg("error in creating jobs")
+		return nil, errors.New("could not create jobs")
+	}
+
+	return jobIDs, nil
+}
+
 func (r *Repo) GetJobByCompanyID(cID uint) ([]model.Job, error) {
 
 	var jobData []model.Job
@@ -67,16 +122,419 @@ func (r *Repo) GetJobByJobID(jID uint) (model.Job, error) {
 	return jobData, nil
 }
 
-func (r *Repo) GetAllJobs() ([]model.Job, error) {
+func (r *Repo) FilterJobs(filter model.JobFilter, after *model.JobCursor) ([]model.Job, error) {
 
 	var jobData []model.Job
 
-	output := r.db.Preload("Company").Preload("Location").Preload("TechnologyStack").Preload("Qualifications").Preload("Shift").Preload("Jobtype").Find(&jobData)
+	query := r.db.Model(&model.Job{}).
+		Where("status = ? AND (expires_at IS NULL OR expires_at > ?)", model.JobStatusPublished, time.Now())
+	query = applyJobFilter(query, filter)
 
-	if output.Error != nil || output.RowsAffected == 0 {
-		log.Error().Err(output.Error).Msg("error while retriving job data")
-		return nil, errors.New("error while getting all jobs")
+	switch filter.Sort {
+	case model.JobSortSalary:
+		//undisclosed salaries sort as -1 so that they come last
+		if after != nil {
+			query = query.Where("(COALESCE(normalised_max_salary, -1), id) < (?, ?)", after.Salary, after.ID)
+		}
+		query = query.Order("COALESCE(normalised_max_salary, -1) DESC, id DESC")
+	case model.JobSortExperience:
+		if after != nil {
+			query = query.Where("(min_experience, id) > (?, ?)", after.MinExperience, after.ID)
+		}
+		query = query.Order("min_experience ASC, id ASC")
+	default:
+		if after != nil {
+			query = query.Where("(created_at, id) < (?, ?)", after.CreatedAt, after.ID)
+		}
+		query = query.Order("created_at DESC, id DESC")
+	}
+
+	output := query.Limit(filter.Limit).Preload("Company").Preload("Location").Preload("TechnologyStack").Preload("Qualifications").Preload("Shift").Preload("Jobtype").Find(&jobData)
+	if output.Error != nil {
+		log.Error().Err(output.Error).Msg("error while filtering job data")
+		return nil, errors.New("error while getting jobs")
 	}
 
 	return jobData, nil
 }
+
+// applyJobFilter narrows a jobs query down to the structured listing filters.
+// Association filters match a job when it has at least one of the given IDs.
+func applyJobFilter(query *gorm.DB, filter model.JobFilter) *gorm.DB {
+	if filter.CompanyID != 0 {
+		query = query.Where("jobs.cid = ?", filter.CompanyID)
+	}
+	query = applyLocationFilter(query, filter)
+	if len(filter.WorkMode) != 0 {
+		query = query.Where("jobs.work_mode IN ?", filter.WorkMode)
+	}
+	if len(filter.TechnologyStack) != 0 {
+		query = query.Where("jobs.id IN (SELECT job_id FROM job_techstack WHERE technology_stack_id IN ?)", filter.TechnologyStack)
+	}
+	if len(filter.Jobtype) != 0 {
+		query = query.Where("jobs.id IN (SELECT job_id FROM job_type WHERE job_type_id IN ?)", filter.Jobtype)
+	}
+	if len(filter.Shift) != 0 {
+		query = query.Where("jobs.id IN (SELECT job_id FROM job_shift WHERE shift_id IN ?)", filter.Shift)
+	}
+	if filter.MinExperience != nil {
+		query = query.Where("jobs.max_experience >= ?", *filter.MinExperience)
+	}
+	if filter.MaxExperience != nil {
+		query = query.Where("jobs.min_experience <= ?", *filter.MaxExperience)
+	}
+	if filter.NoticePeriod != nil {
+		query = query.Where("jobs.min_notice_period <= ? AND jobs.max_notice_period >= ?", *filter.NoticePeriod, *filter.NoticePeriod)
+	}
+	if filter.MinSalary != nil {
+		query = query.Where("jobs.normalised_max_salary >= ?", *filter.MinSalary)
+	}
+	if filter.MaxSalary != nil {
+		query = query.Where("jobs.normalised_min_salary <= ?", *filter.MaxSalary)
+	}
+	return query
+}
+
+// haversineCondition keeps the locations within a distance of a point using
+// the mean earth radius of 6371 km. The placeholders are latitude, latitude,
+// longitude and the distance in km. Rounding can push the term under the
+// square root just past 1, where asin fails, so it is clamped.
+const haversineCondition = `2 * 6371 * asin(sqrt(LEAST(1,
+	power(sin(radians(locations.latitude - ?) / 2), 2) +
+	cos(radians(?)) * cos(radians(locations.latitude)) * power(sin(radians(locations.longitude - ?) / 2), 2)
+))) <= ?`
+
+// applyLocationFilter matches the jobs posted at one of the given locations
+// and at a location within the radius. Remote jobs are matched as well when
+// the filter asks for them.
+func applyLocationFilter(query *gorm.DB, filter model.JobFilter) *gorm.DB {
+	var conditions []string
+	var args []interface{}
+
+	if len(filter.Location) != 0 {
+		conditions = append(conditions, "jobs.id IN (SELECT job_id FROM job_location WHERE location_id IN ?)")
+		args = append(args, filter.Location)
+	}
+	if filter.Latitude != nil && filter.Longitude != nil && filter.RadiusKm != nil {
+		conditions = append(conditions, `jobs.id IN (SELECT job_location.job_id FROM job_location
+			JOIN locations ON locations.id = job_location.location_id
+			WHERE locations.deleted_at IS NULL AND locations.latitude IS NOT NULL AND `+haversineCondition+`)`)
+		args = append(args, *filter.Latitude, *filter.Latitude, *filter.Longitude, *filter.RadiusKm)
+	}
+	if len(conditions) == 0 {
+		return query
+	}
+
+	condition := strings.Join(conditions, " AND ")
+	if filter.IncludeRemote {
+		condition = "(" + condition + ") OR jobs.work_mode = ?"
+		args = append(args, model.WorkModeRemote)
+	}
+
+	return query.Where("("+condition+")", args...)
+}
+
+func (r *Repo) SearchJobs(query string, filter model.JobFilter, offset int) ([]model.JobSearchResult, error) {
+
+	var hits []struct {
+		ID                   uint
+		Rank                 float64
+		JobnameHighlight     string
+		DescriptionHighlight string
+	}
+
+	search := r.db.Table("jobs").
+		Select(`jobs.id, ts_rank(jobs.search_vector, query) AS rank,
+			ts_headline('english', jobs.jobname, query, 'StartSel=<mark>, StopSel=</mark>, HighlightAll=true') AS jobname_highlight,
+			ts_headline('english', jobs.description, query, 'StartSel=<mark>, StopSel=</mark>, MaxFragments=2') AS description_highlight`).
+		Joins("CROSS JOIN websearch_to_tsquery('english', ?) AS query", query).
+		Where("jobs.search_vector @@ query AND jobs.deleted_at IS NULL").
+		Where("jobs.status = ? AND (jobs.expires_at IS NULL OR jobs.expires_at > ?)", model.JobStatusPublished, time.Now())
+	search = applyJobFilter(search, filter)
+
+	output := search.Order("rank DESC, jobs.id DESC").Limit(filter.Limit).Offset(offset).Scan(&hits)
+	if output.Error != nil {
+		log.Error().Err(output.Error).Msg("error while searching jobs")
+		return nil, errors.New("error while searching jobs")
+	}
+	if len(hits) == 0 {
+		return []model.JobSearchResult{}, nil
+	}
+
+	var jobIDs []uint
+	for _, v := range hits {
+		jobIDs = append(jobIDs, v.ID)
+	}
+
+	var jobData []model.Job
+	output = r.db.Preload("Company").Preload("Location").Preload("TechnologyStack").Preload("Qualifications").Preload("Shift").Preload("Jobtype").Where("id IN ?", jobIDs).Find(&jobData)
+	if output.Error != nil {
+		log.Error().Err(output.Error).Msg("error while retriving searched jobs")
+		return nil, errors.New("error while searching jobs")
+	}
+
+	jobsByID := make(map[uint]model.Job, len(jobData))
+	for _, v := range jobData {
+		jobsByID[v.ID] = v
+	}
+
+	//keeping the ranked order of the search hits
+	results := make([]model.JobSearchResult, 0, len(hits))
+	for _, v := range hits {
+		job, ok := jobsByID[v.ID]
+		if !ok {
+			continue
+		}
+		results = append(results, model.JobSearchResult{
+			Job:                  job,
+			Rank:                 v.Rank,
+			JobnameHighlight:     v.JobnameHighlight,
+			DescriptionHighlight: v.DescriptionHighlight,
+		})
+	}
+
+	return results, nil
+}
+
+func (r *Repo) UpdateJob(jobData model.Job) (model.Job, error) {
+
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		err := checkJobReferences(tx, jobData)
+		if err != nil {
+			return err
+		}
+
+		output := tx.Omit(clause.Associations).Save(&jobData)
+		if output.Error != nil {
+			return output.Error
+		}
+
+		err = tx.Model(&jobData).Association("Location").Replace(jobData.Location)
+		if err != nil {
+			return err
+		}
+		err = tx.Model(&jobData).Association("TechnologyStack").Replace(jobData.TechnologyStack)
+		if err != nil {
+			return err
+		}
+		err = tx.Model(&jobData).Association("Qualifications").Replace(jobData.Qualifications)
+		if err != nil {
+			return err
+		}
+		err = tx.Model(&jobData).Association("Shift").Replace(jobData.Shift)
+		if err != nil {
+			return err
+		}
+		err = tx.Model(&jobData).Association("Jobtype").Replace(jobData.Jobtype)
+		if err != nil {
+			return err
+		}
+		return addJobRevision(tx, jobData)
+	})
+	var referenceErr *MissingReferenceError
+	if errors.As(err, &referenceErr) {
+		log.Error().Err(err).Msg("error job references missing reference data")
+		return model.Job{}, referenceErr
+	}
+	if err != nil {
+		log.Error().Err(err).Msg("error in updating job")
+		return model.Job{}, errors.New("could not update job")
+	}
+
+	return jobData, nil
+}
+
+// checkJobReferences looks the job's reference data up again inside the
+// update, so that an entry retired after the service validated the job is
+// reported instead of being dropped by the association replace.
+func checkJobReferences(tx *gorm.DB, jobData model.Job) error {
+	references := []struct {
+		kind string
+		ids  []uint
+	}{
+		{kind: model.TaxonomyLocation},
+		{kind: model.TaxonomyTechnologyStack},
+		{kind: model.TaxonomyQualification},
+		{kind: model.TaxonomyShift},
+		{kind: model.TaxonomyJobType},
+	}
+	for _, v := range jobData.Location {
+		references[0].ids = append(references[0].ids, v.ID)
+	}
+	for _, v := range jobData.TechnologyStack {
+		references[1].ids = append(references[1].ids, v.ID)
+	}
+	for _, v := range jobData.Qualifications {
+		references[2].ids = append(references[2].ids, v.ID)
+	}
+	for _, v := range jobData.Shift {
+		references[3].ids = append(references[3].ids, v.ID)
+	}
+	for _, v := range jobData.Jobtype {
+		references[4].ids = append(references[4].ids, v.ID)
+	}
+
+	for _, v := range references {
+		missing, err := findMissingTaxonomyIDs(tx, v.kind, v.ids)
+		if err != nil {
+			return err
+		}
+		if len(missing) != 0 {
+			return &MissingReferenceError{Kind: v.kind, IDs: missing}
+		}
+	}
+	return nil
+}
+
+func (r *Repo) DeleteJob(jID uint) error {
+
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		var jobData model.Job
+		err := tx.Preload("Location").Preload("TechnologyStack").Preload("Qualifications").Preload("Shift").Preload("Jobtype").Where("id = ?", jID).First(&jobData).Error
+		if err != nil {
+			return err
+		}
+
+		err = tx.Delete(&jobData).Error
+		if err != nil {
+			return err
+		}
+
+		//the last revision keeps what the job said when it was taken down
+		return createJobRevision(tx, model.JobRevision{
+			JobID:    jobData.ID,
+			Deleted:  true,
+			Snapshot: jobSnapshot(jobData),
+		})
+	})
+	if err != nil {
+		log.Error().Err(err).Msg("error in deleting job")
+		return errors.New("could not delete the job")
+	}
+
+	return nil
+}
+
+func (r *Repo) ExpireJobs(now time.Time) ([]uint, error) {
+
+	var jobIDs []uint
+
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		output := tx.Model(&model.Job{}).Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("status IN ? AND expires_at IS NOT NULL AND expires_at <= ?", []string{model.JobStatusPublished, model.JobStatusPaused}, now).
+			Pluck("id", &jobIDs)
+		if output.Error != nil || len(jobIDs) == 0 {
+			return output.Error
+		}
+
+		err := tx.Model(&model.Job{}).Where("id IN ?", jobIDs).Update("status", model.JobStatusExpired).Error
+		if err != nil {
+			return err
+		}
+
+		var jobData []model.Job
+		err = tx.Preload("Location").Preload("TechnologyStack").Preload("Qualifications").Preload("Shift").Preload("Jobtype").Where("id IN ?", jobIDs).Find(&jobData).Error
+		if err != nil {
+			return err
+		}
+		for _, v := range jobData {
+			err = addJobRevision(tx, v)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		log.Error().Err(err).Msg("error in expiring jobs")
+		return nil, errors.New("could not expire jobs")
+	}
+
+	return jobIDs, nil
+}
+
+// addJobRevision stores the job as its next revision. It runs in the same
+// transaction as the change, after the job row is written, so concurrent
+// edits of one job wait on the row lock and get consecutive numbers.
+func addJobRevision(tx *gorm.DB, jobData model.Job) error {
+	return createJobRevision(tx, model.JobRevision{
+		JobID:    jobData.ID,
+		Snapshot: jobSnapshot(jobData),
+	})
+}
+
+// createJobRevision stores the revision under the next number of its job.
+func createJobRevision(tx *gorm.DB, revision model.JobRevision) error {
+
+	var latest int
+	output := tx.Model(&model.JobRevision{}).Where("job_id = ?", revision.JobID).Select("COALESCE(MAX(revision), 0)").Scan(&latest)
+	if output.Error != nil {
+		return output.Error
+	}
+
+	revision.Revision = latest + 1
+	return tx.Create(&revision).Error
+}
+
+func jobSnapshot(jobData model.Job) model.JobSnapshot {
+	snapshot := model.JobSnapshot{
+		Jobname:           jobData.Jobname,
+		MinNoticePeriod:   jobData.MinNoticePeriod,
+		MaxNoticePeriod:   jobData.MaxNoticePeriod,
+		Description:       jobData.Description,
+		MinExperience:     jobData.MinExperience,
+		MaxExperience:     jobData.MaxExperience,
+		Status:            jobData.Status,
+		ExpiresAt:         jobData.ExpiresAt,
+		WorkMode:          jobData.WorkMode,
+		MinSalary:         jobData.MinSalary,
+		MaxSalary:         jobData.MaxSalary,
+		Currency:          jobData.Currency,
+		PayPeriod:         jobData.PayPeriod,
+		SalaryUndisclosed: jobData.SalaryUndisclosed,
+		SkillRequirements: jobData.SkillRequirements,
+		ScoringPolicy:     jobData.ScoringPolicy,
+	}
+	for _, v := range jobData.Location {
+		snapshot.Location = append(snapshot.Location, v.ID)
+	}
+	for _, v := range jobData.TechnologyStack {
+		snapshot.TechnologyStack = append(snapshot.TechnologyStack, v.ID)
+	}
+	for _, v := range jobData.Qualifications {
+		snapshot.Qualifications = append(snapshot.Qualifications, v.ID)
+	}
+	for _, v := range jobData.Shift {
+		snapshot.Shift = append(snapshot.Shift, v.ID)
+	}
+	for _, v := range jobData.Jobtype {
+		snapshot.Jobtype = append(snapshot.Jobtype, v.ID)
+	}
+	return snapshot
+}
+
+func (r *Repo) GetJobRevisions(jID uint) ([]model.JobRevision, error) {
+
+	var revisions []model.JobRevision
+
+	output := r.db.Where("job_id = ?", jID).Order("revision").Find(&revisions)
+	if output.Error != nil || output.RowsAffected == 0 {
+		log.Error().Err(output.Error).Msg("error in fetching job revisions")
+		return nil, errors.New("could not find revisions for the job")
+	}
+
+	return revisions, nil
+}
+
+func (r *Repo) GetJobRevision(jID uint, revision int) (model.JobRevision, error) {
+
+	var jobRevision model.JobRevision
+
+	output := r.db.Where("job_id = ? AND revision = ?", jID, revision).First(&jobRevision)
+	if output.Error != nil {
+		log.Error().Err(output.Error).Msg("error in fetching job revision")
+		return model.JobRevision{}, errors.New("could not find the job revision")
+	}
+
+	return jobRevision, nil
+}
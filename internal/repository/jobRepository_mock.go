@@ -11,6 +11,7 @@ package repository
 import (
 	model "job-portal-api/internal/model"
 	reflect "reflect"
+	time "time"
 
 	gomock "go.uber.org/mock/gomock"
 )
@@ -53,19 +54,63 @@ func (mr *MockJobRepositoryMockRecorder) CreateJob(jodData any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateJob", reflect.TypeOf((*MockJobRepository)(nil).CreateJob), jodData)
 }
 
-// GetAllJobs mocks base method.
-func (m *MockJobRepository) GetAllJobs() ([]model.Job, error) {
+// CreateJobs mocks base method.
+func (m *MockJobRepository) CreateJobs(jobData []model.Job) ([]uint, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAllJobs")
+	ret := m.ctrl.Call(m, "CreateJobs", jobData)
+	ret0, _ := ret[0].([]uint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateJobs indicates an expected call of CreateJobs.
+func (mr *MockJobRepositoryMockRecorder) CreateJobs(jobData any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateJobs", reflect.TypeOf((*MockJobRepository)(nil).CreateJobs), jobData)
+}
+
+// DeleteJob mocks base method.
+func (m *MockJobRepository) DeleteJob(jID uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteJob", jID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteJob indicates an expected call of DeleteJob.
+func (mr *MockJobRepositoryMockRecorder) DeleteJob(jID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteJob", reflect.TypeOf((*MockJobRepository)(nil).DeleteJob), jID)
+}
+
+// ExpireJobs mocks base method.
+func (m *MockJobRepository) ExpireJobs(now time.Time) ([]uint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExpireJobs", now)
+	ret0, _ := ret[0].([]uint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExpireJobs indicates an expected call of ExpireJobs.
+func (mr *MockJobRepositoryMockRecorder) ExpireJobs(now any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExpireJobs", reflect.TypeOf((*MockJobRepository)(nil).ExpireJobs), now)
+}
+
+// FilterJobs mocks base method.
+func (m *MockJobRepository) FilterJobs(filter model.JobFilter, after *model.JobCursor) ([]model.Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FilterJobs", filter, after)
 	ret0, _ := ret[0].([]model.Job)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetAllJobs indicates an expected call of GetAllJobs.
-func (mr *MockJobRepositoryMockRecorder) GetAllJobs() *gomock.Call {
+// FilterJobs indicates an expected call of FilterJobs.
+func (mr *MockJobRepositoryMockRecorder) FilterJobs(filter, after any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllJobs", reflect.TypeOf((*MockJobRepository)(nil).GetAllJobs))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FilterJobs", reflect.TypeOf((*MockJobRepository)(nil).FilterJobs), filter, after)
 }
 
 // GetJobByCompanyID mocks base method.
@@ -97,3 +142,63 @@ func (mr *MockJobRepositoryMockRecorder) GetJobByJobID(cID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetJobByJobID", reflect.TypeOf((*MockJobRepository)(nil).GetJobByJobID), cID)
 }
+
+// GetJobRevision mocks base method.
+func (m *MockJobRepository) GetJobRevision(jID uint, revision int) (model.JobRevision, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetJobRevision", jID, revision)
+	ret0, _ := ret[0].(model.JobRevision)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetJobRevision indicates an expected call of GetJobRevision.
+func (mr *MockJobRepositoryMockRecorder) GetJobRevision(jID, revision any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetJobRevision", reflect.TypeOf((*MockJobRepository)(nil).GetJobRevision), jID, revision)
+}
+
+// GetJobRevisions mocks base method.
+func (m *MockJobRepository) GetJobRevisions(jID uint) ([]model.JobRevision, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetJobRevisions", jID)
+	ret0, _ := ret[0].([]model.JobRevision)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetJobRevisions indicates an expected call of GetJobRevisions.
+func (mr *MockJobRepositoryMockRecorder) GetJobRevisions(jID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetJobRevisions", reflect.TypeOf((*MockJobRepository)(nil).GetJobRevisions), jID)
+}
+
+// SearchJobs mocks base method.
+func (m *MockJobRepository) SearchJobs(query string, filter model.JobFilter, offset int) ([]model.JobSearchResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchJobs", query, filter, offset)
+	ret0, _ := ret[0].([]model.JobSearchResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchJobs indicates an expected call of SearchJobs.
+func (mr *MockJobRepositoryMockRecorder) SearchJobs(query, filter, offset any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchJobs", reflect.TypeOf((*MockJobRepository)(nil).SearchJobs), query, filter, offset)
+}
+
+// UpdateJob mocks base method.
+func (m *MockJobRepository) UpdateJob(jobData model.Job) (model.Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateJob", jobData)
+	ret0, _ := ret[0].(model.Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateJob indicates an expected call of UpdateJob.
+func (mr *MockJobRepositoryMockRecorder) UpdateJob(jobData any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateJob", reflect.TypeOf((*MockJobRepository)(nil).UpdateJob), jobData)
+}
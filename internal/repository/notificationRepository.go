@@ -0,0 +1,38 @@
+package repository
+
+import (
+	"errors"
+	"job-portal-api/internal/model"
+
+	"github.com/rs/zerolog/log"
+	"gorm.io/gorm"
+)
+
+//go:generate mockgen -source=notificationRepository.go -destination=notificationRepository_mock.go -package=repository
+type NotificationRepository interface {
+	GetCompanyNotifications(cID uint, offset int, limit int) ([]model.CompanyNotification, error)
+}
+
+func NewNotificationRepo(db *gorm.DB) (NotificationRepository, error) {
+	if db == nil {
+		log.Info().Msg("database cannot be nil")
+		return nil, errors.New("database cannot be nil")
+	}
+	return &Repo{
+		db: db,
+	}, nil
+}
+
+// GetCompanyNotifications returns the company's notifications newest first.
+func (r *Repo) GetCompanyNotifications(cID uint, offset int, limit int) ([]model.CompanyNotification, error) {
+
+	notifications := []model.CompanyNotification{}
+
+	output := r.db.Where("company_id = ?", cID).Order("created_at DESC, id DESC").Offset(offset).Limit(limit).Find(&notifications)
+	if output.Error != nil {
+		log.Error().Err(output.Error).Msg("error in fetching company notifications")
+		return nil, errors.New("could not fetch company notifications")
+	}
+
+	return notifications, nil
+}
@@ -0,0 +1,54 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: notificationRepository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=notificationRepository.go -destination=notificationRepository_mock.go -package=repository
+//
+// Package repository is a generated GoMock package.
+package repository
+
+import (
+	model "job-portal-api/internal/model"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockNotificationRepository is a mock of NotificationRepository interface.
+type MockNotificationRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockNotificationRepositoryMockRecorder
+}
+
+// MockNotificationRepositoryMockRecorder is the mock recorder for MockNotificationRepository.
+type MockNotificationRepositoryMockRecorder struct {
+	mock *MockNotificationRepository
+}
+
+// NewMockNotificationRepository creates a new mock instance.
+func NewMockNotificationRepository(ctrl *gomock.Controller) *MockNotificationRepository {
+	mock := &MockNotificationRepository{ctrl: ctrl}
+	mock.recorder = &MockNotificationRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockNotificationRepository) EXPECT() *MockNotificationRepositoryMockRecorder {
+	return m.recorder
+}
+
+// GetCompanyNotifications mocks base method.
+func (m *MockNotificationRepository) GetCompanyNotifications(cID uint, offset, limit int) ([]model.CompanyNotification, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCompanyNotifications", cID, offset, limit)
+	ret0, _ := ret[0].([]model.CompanyNotification)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCompanyNotifications indicates an expected call of GetCompanyNotifications.
+func (mr *MockNotificationRepositoryMockRecorder) GetCompanyNotifications(cID, offset, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCompanyNotifications", reflect.TypeOf((*MockNotificationRepository)(nil).GetCompanyNotifications), cID, offset, limit)
+}
@@ -0,0 +1,62 @@
+package repository
+
+import (
+	"errors"
+	"job-portal-api/internal/model"
+
+	"github.com/rs/zerolog/log"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+var ErrProfileNotFound = errors.New("could not find the candidate profile")
+
+//go:generate mockgen -source=profileRepository.go -destination=profileRepository_mock.go -package=repository
+type CandidateProfileRepository interface {
+	GetCandidateProfile(uID uint) (model.CandidateProfile, error)
+	SaveCandidateProfile(profile model.CandidateProfile) (model.CandidateProfile, error)
+}
+
+func NewCandidateProfileRepo(db *gorm.DB) (CandidateProfileRepository, error) {
+	if db == nil {
+		log.Info().Msg("database cannot be nil")
+		return nil, errors.New("database cannot be nil")
+	}
+	return &Repo{
+		db: db,
+	}, nil
+}
+
+// GetCandidateProfile returns ErrProfileNotFound when the user has not set
+// up a profile yet.
+func (r *Repo) GetCandidateProfile(uID uint) (model.CandidateProfile, error) {
+
+	var profile model.CandidateProfile
+
+	output := r.db.Where("user_id = ?", uID).First(&profile)
+	if errors.Is(output.Error, gorm.ErrRecordNotFound) {
+		return model.CandidateProfile{}, ErrProfileNotFound
+	}
+	if output.Error != nil {
+		log.Error().Err(output.Error).Msg("error in fetching candidate profile")
+		return model.CandidateProfile{}, errors.New("could not fetch the candidate profile")
+	}
+
+	return profile, nil
+}
+
+// SaveCandidateProfile creates the user's profile or replaces the details
+// of the existing one.
+func (r *Repo) SaveCandidateProfile(profile model.CandidateProfile) (model.CandidateProfile, error) {
+
+	output := r.db.Omit("User").Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "user_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"name", "age", "details", "updated_at"}),
+	}).Create(&profile)
+	if output.Error != nil {
+		log.Error().Err(output.Error).Msg("error in saving candidate profile")
+		return model.CandidateProfile{}, errors.New("could not save the candidate profile")
+	}
+
+	return r.GetCandidateProfile(profile.UserID)
+}
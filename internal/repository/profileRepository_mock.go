@@ -0,0 +1,69 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: profileRepository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=profileRepository.go -destination=profileRepository_mock.go -package=repository
+//
+// Package repository is a generated GoMock package.
+package repository
+
+import (
+	model "job-portal-api/internal/model"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockCandidateProfileRepository is a mock of CandidateProfileRepository interface.
+type MockCandidateProfileRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockCandidateProfileRepositoryMockRecorder
+}
+
+// MockCandidateProfileRepositoryMockRecorder is the mock recorder for MockCandidateProfileRepository.
+type MockCandidateProfileRepositoryMockRecorder struct {
+	mock *MockCandidateProfileRepository
+}
+
+// NewMockCandidateProfileRepository creates a new mock instance.
+func NewMockCandidateProfileRepository(ctrl *gomock.Controller) *MockCandidateProfileRepository {
+	mock := &MockCandidateProfileRepository{ctrl: ctrl}
+	mock.recorder = &MockCandidateProfileRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCandidateProfileRepository) EXPECT() *MockCandidateProfileRepositoryMockRecorder {
+	return m.recorder
+}
+
+// GetCandidateProfile mocks base method.
+func (m *MockCandidateProfileRepository) GetCandidateProfile(uID uint) (model.CandidateProfile, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCandidateProfile", uID)
+	ret0, _ := ret[0].(model.CandidateProfile)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCandidateProfile indicates an expected call of GetCandidateProfile.
+func (mr *MockCandidateProfileRepositoryMockRecorder) GetCandidateProfile(uID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCandidateProfile", reflect.TypeOf((*MockCandidateProfileRepository)(nil).GetCandidateProfile), uID)
+}
+
+// SaveCandidateProfile mocks base method.
+func (m *MockCandidateProfileRepository) SaveCandidateProfile(profile model.CandidateProfile) (model.CandidateProfile, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveCandidateProfile", profile)
+	ret0, _ := ret[0].(model.CandidateProfile)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SaveCandidateProfile indicates an expected call of SaveCandidateProfile.
+func (mr *MockCandidateProfileRepositoryMockRecorder) SaveCandidateProfile(profile any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveCandidateProfile", reflect.TypeOf((*MockCandidateProfileRepository)(nil).SaveCandidateProfile), profile)
+}
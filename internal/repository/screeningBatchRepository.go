@@ -0,0 +1,185 @@
+package repository
+
+import (
+	"errors"
+	"job-portal-api/internal/model"
+	"time"
+
+	"github.com/rs/zerolog/log"
+	"gorm.io/gorm"
+)
+
+// ErrScreeningBatchClaimed is returned when the batch is finished, or is
+// being run by another worker.
+var ErrScreeningBatchClaimed = errors.New("screening batch is already claimed")
+
+//go:generate mockgen -source=screeningBatchRepository.go -destination=screeningBatchRepository_mock.go -package=repository
+type ScreeningBatchRepository interface {
+	CreateScreeningBatch(batch model.ScreeningBatch, items []model.ScreeningBatchItem) (model.ScreeningBatch, error)
+	GetScreeningBatch(bID uint) (model.ScreeningBatch, error)
+	GetUnfinishedScreeningBatchIDs() ([]uint, error)
+	StartScreeningBatch(bID uint, staleBefore time.Time) error
+	CompleteScreeningBatch(bID uint, completedAt time.Time) error
+	GetPendingScreeningBatchItems(bID uint) ([]model.ScreeningBatchItem, error)
+	SaveScreeningBatchItem(item model.ScreeningBatchItem) error
+	GetScreeningBatchItems(bID uint, status string, offset int, limit int) ([]model.ScreeningBatchItem, error)
+}
+
+func NewScreeningBatchRepo(db *gorm.DB) (ScreeningBatchRepository, error) {
+	if db == nil {
+		log.Info().Msg("database cannot be nil")
+		return nil, errors.New("database cannot be nil")
+	}
+	return &Repo{
+		db: db,
+	}, nil
+}
+
+func (r *Repo) CreateScreeningBatch(batch model.ScreeningBatch, items []model.ScreeningBatchItem) (model.ScreeningBatch, error) {
+
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		output := tx.Create(&batch)
+		if output.Error != nil {
+			return output.Error
+		}
+		for i := range items {
+			items[i].BatchID = batch.ID
+		}
+		return tx.CreateInBatches(&items, 500).Error
+	})
+	if err != nil {
+		log.Error().Err(err).Msg("error in creating screening batch")
+		return model.ScreeningBatch{}, errors.New("could not create screening batch")
+	}
+
+	return batch, nil
+}
+
+func (r *Repo) GetScreeningBatch(bID uint) (model.ScreeningBatch, error) {
+
+	var batch model.ScreeningBatch
+
+	output := r.db.Where("id = ?", bID).First(&batch)
+	if output.Error != nil {
+		log.Error().Err(output.Error).Msg("error in screening batch id")
+		return model.ScreeningBatch{}, errors.New("could not find the screening batch")
+	}
+
+	return batch, nil
+}
+
+// GetUnfinishedScreeningBatchIDs lists the batches still to be screened,
+// oldest first. Running batches are included, they were interrupted when
+// the server stopped.
+func (r *Repo) GetUnfinishedScreeningBatchIDs() ([]uint, error) {
+
+	var ids []uint
+
+	output := r.db.Model(&model.ScreeningBatch{}).
+		Where("status IN ?", []string{model.ScreeningBatchQueued, model.ScreeningBatchRunning}).
+		Order("id").Pluck("id", &ids)
+	if output.Error != nil {
+		log.Error().Err(output.Error).Msg("error in fetching unfinished screening batches")
+		return nil, errors.New("could not fetch unfinished screening batches")
+	}
+
+	return ids, nil
+}
+
+// StartScreeningBatch claims a batch for one worker. A queued batch can be
+// claimed, and so can a running one that made no progress since
+// staleBefore, its worker was interrupted. Saving an item updates the batch,
+// so a batch that is still being run is never claimed twice.
+func (r *Repo) StartScreeningBatch(bID uint, staleBefore time.Time) error {
+
+	output := r.db.Model(&model.ScreeningBatch{}).
+		Where("id = ? AND (status = ? OR (status = ? AND updated_at < ?))", bID, model.ScreeningBatchQueued, model.ScreeningBatchRunning, staleBefore).
+		Update("status", model.ScreeningBatchRunning)
+	if output.Error != nil {
+		log.Error().Err(output.Error).Msg("error in starting screening batch")
+		return errors.New("could not start screening batch")
+	}
+	if output.RowsAffected != 1 {
+		log.Info().Uint("batch id", bID).Msg("screening batch is claimed by another worker")
+		return ErrScreeningBatchClaimed
+	}
+
+	return nil
+}
+
+func (r *Repo) CompleteScreeningBatch(bID uint, completedAt time.Time) error {
+
+	output := r.db.Model(&model.ScreeningBatch{}).Where("id = ?", bID).
+		Updates(map[string]interface{}{"status": model.ScreeningBatchCompleted, "completed_at": completedAt})
+	if output.Error != nil {
+		log.Error().Err(output.Error).Msg("error in completing screening batch")
+		return errors.New("could not complete screening batch")
+	}
+
+	return nil
+}
+
+func (r *Repo) GetPendingScreeningBatchItems(bID uint) ([]model.ScreeningBatchItem, error) {
+
+	items := []model.ScreeningBatchItem{}
+
+	output := r.db.Where("batch_id = ? AND status = ?", bID, model.ScreeningItemPending).Order("position").Find(&items)
+	if output.Error != nil {
+		log.Error().Err(output.Error).Msg("error in fetching pending screening batch items")
+		return nil, errors.New("could not fetch screening batch items")
+	}
+
+	return items, nil
+}
+
+// SaveScreeningBatchItem stores the outcome of a pending item and counts it
+// on its batch in the same transaction, so the progress always matches the
+// items.
+func (r *Repo) SaveScreeningBatchItem(item model.ScreeningBatchItem) error {
+
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		output := tx.Model(&item).Where("status = ?", model.ScreeningItemPending).
+			Select("status", "screening", "error").Updates(&item)
+		if output.Error != nil {
+			return output.Error
+		}
+		if output.RowsAffected == 0 {
+			return nil
+		}
+
+		counters := map[string]interface{}{
+			"processed":  gorm.Expr("processed + 1"),
+			"updated_at": time.Now(),
+		}
+		if item.Status == model.ScreeningItemFailed {
+			counters["failed"] = gorm.Expr("failed + 1")
+		}
+		if item.Screening != nil && item.Screening.Accepted {
+			counters["accepted"] = gorm.Expr("accepted + 1")
+		}
+		return tx.Model(&model.ScreeningBatch{}).Where("id = ?", item.BatchID).UpdateColumns(counters).Error
+	})
+	if err != nil {
+		log.Error().Err(err).Msg("error in saving screening batch item")
+		return errors.New("could not save screening batch item")
+	}
+
+	return nil
+}
+
+func (r *Repo) GetScreeningBatchItems(bID uint, status string, offset int, limit int) ([]model.ScreeningBatchItem, error) {
+
+	items := []model.ScreeningBatchItem{}
+
+	query := r.db.Where("batch_id = ?", bID)
+	if status != "" {
+		query = query.Where("status = ?", status)
+	}
+	output := query.Order("position").Offset(offset).Limit(limit).Find(&items)
+	if output.Error != nil {
+		log.Error().Err(output.Error).Msg("error in fetching screening batch items")
+		return nil, errors.New("could not fetch screening batch items")
+	}
+
+	return items, nil
+}
@@ -0,0 +1,157 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: screeningBatchRepository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=screeningBatchRepository.go -destination=screeningBatchRepository_mock.go -package=repository
+//
+// Package repository is a generated GoMock package.
+package repository
+
+import (
+	model "job-portal-api/internal/model"
+	reflect "reflect"
+	time "time"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockScreeningBatchRepository is a mock of ScreeningBatchRepository interface.
+type MockScreeningBatchRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockScreeningBatchRepositoryMockRecorder
+}
+
+// MockScreeningBatchRepositoryMockRecorder is the mock recorder for MockScreeningBatchRepository.
+type MockScreeningBatchRepositoryMockRecorder struct {
+	mock *MockScreeningBatchRepository
+}
+
+// NewMockScreeningBatchRepository creates a new mock instance.
+func NewMockScreeningBatchRepository(ctrl *gomock.Controller) *MockScreeningBatchRepository {
+	mock := &MockScreeningBatchRepository{ctrl: ctrl}
+	mock.recorder = &MockScreeningBatchRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockScreeningBatchRepository) EXPECT() *MockScreeningBatchRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CompleteScreeningBatch mocks base method.
+func (m *MockScreeningBatchRepository) CompleteScreeningBatch(bID uint, completedAt time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CompleteScreeningBatch", bID, completedAt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CompleteScreeningBatch indicates an expected call of CompleteScreeningBatch.
+func (mr *MockScreeningBatchRepositoryMockRecorder) CompleteScreeningBatch(bID, completedAt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CompleteScreeningBatch", reflect.TypeOf((*MockScreeningBatchRepository)(nil).CompleteScreeningBatch), bID, completedAt)
+}
+
+// CreateScreeningBatch mocks base method.
+func (m *MockScreeningBatchRepository) CreateScreeningBatch(batch model.ScreeningBatch, items []model.ScreeningBatchItem) (model.ScreeningBatch, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateScreeningBatch", batch, items)
+	ret0, _ := ret[0].(model.ScreeningBatch)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateScreeningBatch indicates an expected call of CreateScreeningBatch.
+func (mr *MockScreeningBatchRepositoryMockRecorder) CreateScreeningBatch(batch, items any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateScreeningBatch", reflect.TypeOf((*MockScreeningBatchRepository)(nil).CreateScreeningBatch), batch, items)
+}
+
+// GetPendingScreeningBatchItems mocks base method.
+func (m *MockScreeningBatchRepository) GetPendingScreeningBatchItems(bID uint) ([]model.ScreeningBatchItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPendingScreeningBatchItems", bID)
+	ret0, _ := ret[0].([]model.ScreeningBatchItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPendingScreeningBatchItems indicates an expected call of GetPendingScreeningBatchItems.
+func (mr *MockScreeningBatchRepositoryMockRecorder) GetPendingScreeningBatchItems(bID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPendingScreeningBatchItems", reflect.TypeOf((*MockScreeningBatchRepository)(nil).GetPendingScreeningBatchItems), bID)
+}
+
+// GetScreeningBatch mocks base method.
+func (m *MockScreeningBatchRepository) GetScreeningBatch(bID uint) (model.ScreeningBatch, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetScreeningBatch", bID)
+	ret0, _ := ret[0].(model.ScreeningBatch)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetScreeningBatch indicates an expected call of GetScreeningBatch.
+func (mr *MockScreeningBatchRepositoryMockRecorder) GetScreeningBatch(bID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetScreeningBatch", reflect.TypeOf((*MockScreeningBatchRepository)(nil).GetScreeningBatch), bID)
+}
+
+// GetScreeningBatchItems mocks base method.
+func (m *MockScreeningBatchRepository) GetScreeningBatchItems(bID uint, status string, offset, limit int) ([]model.ScreeningBatchItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetScreeningBatchItems", bID, status, offset, limit)
+	ret0, _ := ret[0].([]model.ScreeningBatchItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetScreeningBatchItems indicates an expected call of GetScreeningBatchItems.
+func (mr *MockScreeningBatchRepositoryMockRecorder) GetScreeningBatchItems(bID, status, offset, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetScreeningBatchItems", reflect.TypeOf((*MockScreeningBatchRepository)(nil).GetScreeningBatchItems), bID, status, offset, limit)
+}
+
+// GetUnfinishedScreeningBatchIDs mocks base method.
+func (m *MockScreeningBatchRepository) GetUnfinishedScreeningBatchIDs() ([]uint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUnfinishedScreeningBatchIDs")
+	ret0, _ := ret[0].([]uint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUnfinishedScreeningBatchIDs indicates an expected call of GetUnfinishedScreeningBatchIDs.
+func (mr *MockScreeningBatchRepositoryMockRecorder) GetUnfinishedScreeningBatchIDs() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUnfinishedScreeningBatchIDs", reflect.TypeOf((*MockScreeningBatchRepository)(nil).GetUnfinishedScreeningBatchIDs))
+}
+
+// SaveScreeningBatchItem mocks base method.
+func (m *MockScreeningBatchRepository) SaveScreeningBatchItem(item model.ScreeningBatchItem) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveScreeningBatchItem", item)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveScreeningBatchItem indicates an expected call of SaveScreeningBatchItem.
+func (mr *MockScreeningBatchRepositoryMockRecorder) SaveScreeningBatchItem(item any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveScreeningBatchItem", reflect.TypeOf((*MockScreeningBatchRepository)(nil).SaveScreeningBatchItem), item)
+}
+
+// StartScreeningBatch mocks base method.
+func (m *MockScreeningBatchRepository) StartScreeningBatch(bID uint, staleBefore time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartScreeningBatch", bID, staleBefore)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// StartScreeningBatch indicates an expected call of StartScreeningBatch.
+func (mr *MockScreeningBatchRepositoryMockRecorder) StartScreeningBatch(bID, staleBefore any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartScreeningBatch", reflect.TypeOf((*MockScreeningBatchRepository)(nil).StartScreeningBatch), bID, staleBefore)
+}
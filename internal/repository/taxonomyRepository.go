@@ -0,0 +1,291 @@
+package repository
+
+import (
+	"errors"
+	"job-portal-api/internal/model"
+	"strings"
+	"time"
+
+	"github.com/rs/zerolog/log"
+	"gorm.io/gorm"
+)
+
+var ErrTaxonomyExists = errors.New("reference data already exists")
+
+//go:generate mockgen -source=taxonomyRepository.go -destination=taxonomyRepository_mock.go -package=repository
+type TaxonomyRepository interface {
+	CreateTaxonomy(kind string, name string) (model.Taxonomy, error)
+	GetAllTaxonomies(kind string) ([]model.Taxonomy, error)
+	RenameTaxonomy(kind string, id uint, name string) (model.Taxonomy, error)
+	DeleteTaxonomy(kind string, id uint) error
+	SeedTaxonomies(kind string, names []string) (int, error)
+	FindMissingTaxonomyIDs(kind string, ids []uint) ([]uint, error)
+	SetLocationCoordinates(id uint, coordinates model.Coordinates) (model.Taxonomy, error)
+	SeedLocationCoordinates(coordinates map[string]model.Coordinates) (int, error)
+}
+
+func NewTaxonomyRepo(db *gorm.DB) (TaxonomyRepository, error) {
+	if db == nil {
+		log.Info().Msg("database cannot be nil")
+		return nil, errors.New("database cannot be nil")
+	}
+	return &Repo{
+		db: db,
+	}, nil
+}
+
+// taxonomyColumns maps every reference data kind to its table and to the
+// column holding the display name.
+var taxonomyColumns = map[string]struct {
+	table  string
+	column string
+}{
+	model.TaxonomyLocation:        {table: "locations", column: "place_name"},
+	model.TaxonomyTechnologyStack: {table: "technology_stacks", column: "stack_name"},
+	model.TaxonomyQualification:   {table: "qualifications", column: "qualification_required"},
+	model.TaxonomyShift:           {table: "shifts", column: "shift_type"},
+	model.TaxonomyJobType:         {table: "job_types", column: "job_type_name"},
+}
+
+func (r *Repo) CreateTaxonomy(kind string, name string) (model.Taxonomy, error) {
+
+	taxonomy, ok := taxonomyColumns[kind]
+	if !ok {
+		return model.Taxonomy{}, errors.New("unknown reference data kind")
+	}
+
+	var count int64
+	output := r.db.Table(taxonomy.table).Where("deleted_at IS NULL AND lower("+taxonomy.column+") = ?", strings.ToLower(name)).Count(&count)
+	if output.Error != nil {
+		log.Error().Err(output.Error).Str("kind", kind).Msg("error in checking reference data")
+		return model.Taxonomy{}, errors.New("could not create reference data")
+	}
+	if count != 0 {
+		log.Error().Str("kind", kind).Str("name", name).Msg("reference data already exists")
+		return model.Taxonomy{}, ErrTaxonomyExists
+	}
+
+	//the unique index catches a concurrent create of the same name
+	var id uint
+	output = r.db.Raw("INSERT INTO "+taxonomy.table+" ("+taxonomy.column+", created_at, updated_at) VALUES (?, ?, ?) RETURNING id", name, time.Now(), time.Now()).Scan(&id)
+	if errors.Is(output.Error, gorm.ErrDuplicatedKey) {
+		log.Error().Str("kind", kind).Str("name", name).Msg("reference data already exists")
+		return model.Taxonomy{}, ErrTaxonomyExists
+	}
+	if output.Error != nil {
+		log.Error().Err(output.Error).Str("kind", kind).Msg("error in creating reference data")
+		return model.Taxonomy{}, errors.New("could not create reference data")
+	}
+
+	return model.Taxonomy{
+		ID:   id,
+		Name: name,
+	}, nil
+}
+
+func (r *Repo) GetAllTaxonomies(kind string) ([]model.Taxonomy, error) {
+
+	taxonomy, ok := taxonomyColumns[kind]
+	if !ok {
+		return nil, errors.New("unknown reference data kind")
+	}
+
+	columns := "id, " + taxonomy.column + " AS name"
+	if kind == model.TaxonomyLocation {
+		columns += ", latitude, longitude"
+	}
+
+	taxonomies := []model.Taxonomy{}
+	output := r.db.Table(taxonomy.table).Select(columns).Where("deleted_at IS NULL").Order("id").Scan(&taxonomies)
+	if output.Error != nil {
+		log.Error().Err(output.Error).Str("kind", kind).Msg("error while fetching reference data")
+		return nil, errors.New("error while fetching reference data")
+	}
+
+	return taxonomies, nil
+}
+
+func (r *Repo) RenameTaxonomy(kind string, id uint, name string) (model.Taxonomy, error) {
+
+	taxonomy, ok := taxonomyColumns[kind]
+	if !ok {
+		return model.Taxonomy{}, errors.New("unknown reference data kind")
+	}
+
+	var count int64
+	output := r.db.Table(taxonomy.table).Where("id <> ? AND deleted_at IS NULL AND lower("+taxonomy.column+") = ?", id, strings.ToLower(name)).Count(&count)
+	if output.Error != nil {
+		log.Error().Err(output.Error).Str("kind", kind).Msg("error in checking reference data")
+		return model.Taxonomy{}, errors.New("could not rename reference data")
+	}
+	if count != 0 {
+		log.Error().Str("kind", kind).Str("name", name).Msg("reference data already exists")
+		return model.Taxonomy{}, ErrTaxonomyExists
+	}
+
+	output = r.db.Table(taxonomy.table).Where("id = ? AND deleted_at IS NULL", id).Updates(map[string]interface{}{
+		taxonomy.column: name,
+		"updated_at":    time.Now(),
+	})
+	if errors.Is(output.Error, gorm.ErrDuplicatedKey) {
+		log.Error().Str("kind", kind).Str("name", name).Msg("reference data already exists")
+		return model.Taxonomy{}, ErrTaxonomyExists
+	}
+	if output.Error != nil || output.RowsAffected == 0 {
+		log.Error().Err(output.Error).Str("kind", kind).Msg("error in renaming reference data")
+		return model.Taxonomy{}, errors.New("could not rename reference data")
+	}
+
+	return model.Taxonomy{
+		ID:   id,
+		Name: name,
+	}, nil
+}
+
+func (r *Repo) DeleteTaxonomy(kind string, id uint) error {
+
+	taxonomy, ok := taxonomyColumns[kind]
+	if !ok {
+		return errors.New("unknown reference data kind")
+	}
+
+	output := r.db.Table(taxonomy.table).Where("id = ? AND deleted_at IS NULL", id).Update("deleted_at", time.Now())
+	if output.Error != nil || output.RowsAffected == 0 {
+		log.Error().Err(output.Error).Str("kind", kind).Msg("error in retiring reference data")
+		return errors.New("could not retire reference data")
+	}
+
+	return nil
+}
+
+// SeedTaxonomies inserts the names that are not present yet and returns how
+// many were added. Retired entries count as present, so seeding never brings
+// back something an admin has removed.
+func (r *Repo) SeedTaxonomies(kind string, names []string) (int, error) {
+
+	taxonomy, ok := taxonomyColumns[kind]
+	if !ok {
+		return 0, errors.New("unknown reference data kind")
+	}
+
+	created := 0
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		for _, name := range names {
+			var count int64
+			output := tx.Table(taxonomy.table).Where("lower("+taxonomy.column+") = ?", strings.ToLower(name)).Count(&count)
+			if output.Error != nil {
+				return output.Error
+			}
+			if count != 0 {
+				continue
+			}
+
+			output = tx.Exec("INSERT INTO "+taxonomy.table+" ("+taxonomy.column+", created_at, updated_at) VALUES (?, ?, ?)", name, time.Now(), time.Now())
+			if output.Error != nil {
+				return output.Error
+			}
+			created++
+		}
+		return nil
+	})
+	if err != nil {
+		log.Error().Err(err).Str("kind", kind).Msg("error in seeding reference data")
+		return 0, errors.New("could not seed reference data")
+	}
+
+	return created, nil
+}
+
+// FindMissingTaxonomyIDs returns the ids that do not belong to an active
+// entry of the given kind.
+func (r *Repo) FindMissingTaxonomyIDs(kind string, ids []uint) ([]uint, error) {
+	return findMissingTaxonomyIDs(r.db, kind, ids)
+}
+
+func findMissingTaxonomyIDs(db *gorm.DB, kind string, ids []uint) ([]uint, error) {
+
+	taxonomy, ok := taxonomyColumns[kind]
+	if !ok {
+		return nil, errors.New("unknown reference data kind")
+	}
+	if len(ids) == 0 {
+		return nil, nil
+	}
+
+	var found []uint
+	output := db.Table(taxonomy.table).Where("id IN ? AND deleted_at IS NULL", ids).Pluck("id", &found)
+	if output.Error != nil {
+		log.Error().Err(output.Error).Str("kind", kind).Msg("error while checking reference data ids")
+		return nil, errors.New("error while checking reference data")
+	}
+
+	existing := make(map[uint]bool, len(found))
+	for _, v := range found {
+		existing[v] = true
+	}
+
+	var missing []uint
+	for _, v := range ids {
+		if !existing[v] {
+			missing = append(missing, v)
+			existing[v] = true
+		}
+	}
+
+	return missing, nil
+}
+
+func (r *Repo) SetLocationCoordinates(id uint, coordinates model.Coordinates) (model.Taxonomy, error) {
+
+	var location model.Location
+	output := r.db.Where("id = ?", id).First(&location)
+	if output.Error != nil {
+		log.Error().Err(output.Error).Msg("error in finding location")
+		return model.Taxonomy{}, errors.New("could not find the location")
+	}
+
+	output = r.db.Model(&location).Updates(map[string]interface{}{
+		"latitude":  coordinates.Latitude,
+		"longitude": coordinates.Longitude,
+	})
+	if output.Error != nil {
+		log.Error().Err(output.Error).Msg("error in setting location coordinates")
+		return model.Taxonomy{}, errors.New("could not set location coordinates")
+	}
+
+	return model.Taxonomy{
+		ID:        location.ID,
+		Name:      location.PlaceName,
+		Latitude:  coordinates.Latitude,
+		Longitude: coordinates.Longitude,
+	}, nil
+}
+
+// SeedLocationCoordinates fills in the coordinates of the named locations and
+// returns how many were updated. Locations that already have coordinates are
+// left alone so that corrections made through the API are kept.
+func (r *Repo) SeedLocationCoordinates(coordinates map[string]model.Coordinates) (int, error) {
+
+	updated := 0
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		for name, v := range coordinates {
+			output := tx.Model(&model.Location{}).
+				Where("lower(place_name) = ? AND latitude IS NULL", strings.ToLower(name)).
+				Updates(map[string]interface{}{
+					"latitude":  v.Latitude,
+					"longitude": v.Longitude,
+				})
+			if output.Error != nil {
+				return output.Error
+			}
+			updated += int(output.RowsAffected)
+		}
+		return nil
+	})
+	if err != nil {
+		log.Error().Err(err).Msg("error in seeding location coordinates")
+		return 0, errors.New("could not seed location coordinates")
+	}
+
+	return updated, nil
+}
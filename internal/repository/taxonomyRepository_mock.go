@@ -0,0 +1,158 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: taxonomyRepository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=taxonomyRepository.go -destination=taxonomyRepository_mock.go -package=repository
+//
+// Package repository is a generated GoMock package.
+package repository
+
+import (
+	model "job-portal-api/internal/model"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockTaxonomyRepository is a mock of TaxonomyRepository interface.
+type MockTaxonomyRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockTaxonomyRepositoryMockRecorder
+}
+
+// MockTaxonomyRepositoryMockRecorder is the mock recorder for MockTaxonomyRepository.
+type MockTaxonomyRepositoryMockRecorder struct {
+	mock *MockTaxonomyRepository
+}
+
+// NewMockTaxonomyRepository creates a new mock instance.
+func NewMockTaxonomyRepository(ctrl *gomock.Controller) *MockTaxonomyRepository {
+	mock := &MockTaxonomyRepository{ctrl: ctrl}
+	mock.recorder = &MockTaxonomyRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTaxonomyRepository) EXPECT() *MockTaxonomyRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CreateTaxonomy mocks base method.
+func (m *MockTaxonomyRepository) CreateTaxonomy(kind, name string) (model.Taxonomy, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTaxonomy", kind, name)
+	ret0, _ := ret[0].(model.Taxonomy)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateTaxonomy indicates an expected call of CreateTaxonomy.
+func (mr *MockTaxonomyRepositoryMockRecorder) CreateTaxonomy(kind, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTaxonomy", reflect.TypeOf((*MockTaxonomyRepository)(nil).CreateTaxonomy), kind, name)
+}
+
+// DeleteTaxonomy mocks base method.
+func (m *MockTaxonomyRepository) DeleteTaxonomy(kind string, id uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTaxonomy", kind, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteTaxonomy indicates an expected call of DeleteTaxonomy.
+func (mr *MockTaxonomyRepositoryMockRecorder) DeleteTaxonomy(kind, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTaxonomy", reflect.TypeOf((*MockTaxonomyRepository)(nil).DeleteTaxonomy), kind, id)
+}
+
+// FindMissingTaxonomyIDs mocks base method.
+func (m *MockTaxonomyRepository) FindMissingTaxonomyIDs(kind string, ids []uint) ([]uint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindMissingTaxonomyIDs", kind, ids)
+	ret0, _ := ret[0].([]uint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindMissingTaxonomyIDs indicates an expected call of FindMissingTaxonomyIDs.
+func (mr *MockTaxonomyRepositoryMockRecorder) FindMissingTaxonomyIDs(kind, ids any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindMissingTaxonomyIDs", reflect.TypeOf((*MockTaxonomyRepository)(nil).FindMissingTaxonomyIDs), kind, ids)
+}
+
+// GetAllTaxonomies mocks base method.
+func (m *MockTaxonomyRepository) GetAllTaxonomies(kind string) ([]model.Taxonomy, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllTaxonomies", kind)
+	ret0, _ := ret[0].([]model.Taxonomy)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllTaxonomies indicates an expected call of GetAllTaxonomies.
+func (mr *MockTaxonomyRepositoryMockRecorder) GetAllTaxonomies(kind any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllTaxonomies", reflect.TypeOf((*MockTaxonomyRepository)(nil).GetAllTaxonomies), kind)
+}
+
+// RenameTaxonomy mocks base method.
+func (m *MockTaxonomyRepository) RenameTaxonomy(kind string, id uint, name string) (model.Taxonomy, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RenameTaxonomy", kind, id, name)
+	ret0, _ := ret[0].(model.Taxonomy)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RenameTaxonomy indicates an expected call of RenameTaxonomy.
+func (mr *MockTaxonomyRepositoryMockRecorder) RenameTaxonomy(kind, id, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RenameTaxonomy", reflect.TypeOf((*MockTaxonomyRepository)(nil).RenameTaxonomy), kind, id, name)
+}
+
+// SeedLocationCoordinates mocks base method.
+func (m *MockTaxonomyRepository) SeedLocationCoordinates(coordinates map[string]model.Coordinates) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SeedLocationCoordinates", coordinates)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SeedLocationCoordinates indicates an expected call of SeedLocationCoordinates.
+func (mr *MockTaxonomyRepositoryMockRecorder) SeedLocationCoordinates(coordinates any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SeedLocationCoordinates", reflect.TypeOf((*MockTaxonomyRepository)(nil).SeedLocationCoordinates), coordinates)
+}
+
+// SeedTaxonomies mocks base method.
+func (m *MockTaxonomyRepository) SeedTaxonomies(kind string, names []string) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SeedTaxonomies", kind, names)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SeedTaxonomies indicates an expected call of SeedTaxonomies.
+func (mr *MockTaxonomyRepositoryMockRecorder) SeedTaxonomies(kind, names any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SeedTaxonomies", reflect.TypeOf((*MockTaxonomyRepository)(nil).SeedTaxonomies), kind, names)
+}
+
+// SetLocationCoordinates mocks base method.
+func (m *MockTaxonomyRepository) SetLocationCoordinates(id uint, coordinates model.Coordinates) (model.Taxonomy, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetLocationCoordinates", id, coordinates)
+	ret0, _ := ret[0].(model.Taxonomy)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetLocationCoordinates indicates an expected call of SetLocationCoordinates.
+func (mr *MockTaxonomyRepositoryMockRecorder) SetLocationCoordinates(id, coordinates any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetLocationCoordinates", reflect.TypeOf((*MockTaxonomyRepository)(nil).SetLocationCoordinates), id, coordinates)
+}
@@ -0,0 +1,478 @@
+package resume
+
+import (
+	"archive/zip"
+	"bytes"
+	"compress/zlib"
+	"encoding/xml"
+	"errors"
+	"io"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf16"
+	"unicode/utf8"
+)
+
+var (
+	ErrUnsupported = errors.New("resume format is not supported")
+	ErrUnreadable  = errors.New("resume could not be read")
+	ErrNoText      = errors.New("no text found in the resume")
+)
+
+// skippedPDFStream matches the dictionaries of images, font programs and
+// cross reference streams, and otherPDFFilter the standard filters besides
+// FlateDecode.
+var (
+	skippedPDFStream = regexp.MustCompile(`/Subtype\s*/Image\b|/Length[123]\b|/Type\s*/XRef\b`)
+	otherPDFFilter   = regexp.MustCompile(`/(ASCIIHex|ASCII85|LZW|RunLength|CCITTFax|JBIG2|DCT|JPX)Decode\b|/Crypt\b`)
+)
+
+// maxTextSize caps the extracted text, and maxInflatedSize every
+// decompressed part, so a small crafted file cannot expand without bound.
+const (
+	maxTextSize     = 1 << 20
+	maxInflatedSize = 16 << 20
+)
+
+// ExtractText converts a pdf, docx or plain text resume to text, the
+// format being taken from the file extension. Scanned pdfs without a text
+// layer and text in fonts without a plain encoding come back as ErrNoText.
+func ExtractText(fileName string, data []byte) (string, error) {
+	var text string
+	var err error
+	switch strings.ToLower(filepath.Ext(fileName)) {
+	case ".pdf":
+		text, err = pdfText(data)
+	case ".docx":
+		text, err = docxText(data)
+	case ".txt":
+		text, err = plainText(data)
+	default:
+		return "", ErrUnsupported
+	}
+	if err != nil {
+		return "", err
+	}
+
+	text = tidy(text)
+	if text == "" {
+		return "", ErrNoText
+	}
+	return text, nil
+}
+
+func plainText(data []byte) (string, error) {
+	data = bytes.TrimPrefix(data, []byte("\xef\xbb\xbf"))
+	if !utf8.Valid(data) {
+		return "", ErrUnreadable
+	}
+	return string(data), nil
+}
+
+// docxText reads the paragraphs of word/document.xml, a docx file being a
+// zip archive of xml parts.
+func docxText(data []byte) (string, error) {
+	archive, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		return "", ErrUnreadable
+	}
+
+	for _, f := range archive.File {
+		if f.Name != "word/document.xml" {
+			continue
+		}
+		part, err := f.Open()
+		if err != nil {
+			return "", ErrUnreadable
+		}
+		defer part.Close()
+		return wordprocessingText(io.LimitReader(part, maxInflatedSize))
+	}
+	return "", ErrUnreadable
+}
+
+func wordprocessingText(r io.Reader) (string, error) {
+	var text strings.Builder
+	inText := false
+	decoder := xml.NewDecoder(r)
+	for text.Len() < maxTextSize {
+		token, err := decoder.Token()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return "", ErrUnreadable
+		}
+
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "t":
+				inText = true
+			case "tab":
+				text.WriteByte(' ')
+			case "br", "cr":
+				text.WriteByte('\n')
+			}
+		case xml.EndElement:
+			switch t.Name.Local {
+			case "t":
+				inText = false
+			case "p":
+				text.WriteByte('\n')
+			}
+		case xml.CharData:
+			if inText {
+				text.Write(t)
+			}
+		}
+	}
+	return text.String(), nil
+}
+
+// pdfText collects the strings shown by the text operators of every
+// content stream. It does not follow the document structure, so text can
+// come out of order, which is enough to look for keywords.
+func pdfText(data []byte) (string, error) {
+	if !bytes.HasPrefix(data, []byte("%PDF-")) {
+		return "", ErrUnreadable
+	}
+	if bytes.Contains(data, []byte("/Encrypt")) {
+		return "", ErrUnreadable
+	}
+
+	var text strings.Builder
+	pos := 0
+	for text.Len() < maxTextSize {
+		dict, content, next, ok := nextStream(data, pos)
+		if !ok {
+			break
+		}
+		pos = next
+
+		content, ok = decodeStream(dict, content)
+		if !ok {
+			continue
+		}
+		contentText(content, &text)
+	}
+	return text.String(), nil
+}
+
+// nextStream finds the next stream from pos and returns the dictionary in
+// front of it with its raw content.
+func nextStream(data []byte, pos int) ([]byte, []byte, int, bool) {
+	for {
+		i := bytes.Index(data[pos:], []byte("stream"))
+		if i < 0 {
+			return nil, nil, 0, false
+		}
+		i += pos
+		pos = i + len("stream")
+		if i >= 3 && string(data[i-3:i]) == "end" {
+			continue
+		}
+
+		start := pos
+		if bytes.HasPrefix(data[start:], []byte("\r\n")) {
+			start += 2
+		} else if bytes.HasPrefix(data[start:], []byte("\n")) {
+			start++
+		} else {
+			continue
+		}
+
+		end := bytes.Index(data[start:], []byte("endstream"))
+		if end < 0 {
+			return nil, nil, 0, false
+		}
+		end += start
+
+		dictStart := bytes.LastIndex(data[:i], []byte("obj"))
+		if dictStart < 0 {
+			dictStart = 0
+		}
+		return data[dictStart:i], bytes.TrimRight(data[start:end], "\r\n"), end + len("endstream"), true
+	}
+}
+
+// decodeStream inflates a flate encoded stream and leaves out images, font
+// programs and streams in any other encoding.
+func decodeStream(dict []byte, content []byte) ([]byte, bool) {
+	if skippedPDFStream.Match(dict) {
+		return nil, false
+	}
+
+	if !bytes.Contains(dict, []byte("/Filter")) {
+		return content, true
+	}
+	if !bytes.Contains(dict, []byte("/FlateDecode")) || otherPDFFilter.Match(dict) {
+		return nil, false
+	}
+
+	r, err := zlib.NewReader(bytes.NewReader(content))
+	if err != nil {
+		return nil, false
+	}
+	defer r.Close()
+	inflated, err := io.ReadAll(io.LimitReader(r, maxInflatedSize))
+	if err != nil && !errors.Is(err, io.ErrUnexpectedEOF) {
+		return nil, false
+	}
+	return inflated, true
+}
+
+// contentText interprets the text operators of a content stream, starting
+// a new line when the text position moves down.
+func contentText(content []byte, text *strings.Builder) {
+	var operands []pdfOperand
+	inText := false
+	lex := pdfLexer{data: content}
+	for {
+		token, ok := lex.next()
+		if !ok {
+			return
+		}
+		if token.operator == "" {
+			operands = append(operands, token)
+			continue
+		}
+
+		switch token.operator {
+		case "BT":
+			inText = true
+		case "ET":
+			inText = false
+			text.WriteByte('\n')
+		case "Tj":
+			if inText {
+				writeStrings(text, operands)
+			}
+		case "'", "\"":
+			if inText {
+				text.WriteByte('\n')
+				writeStrings(text, operands)
+			}
+		case "TJ":
+			if inText {
+				for _, v := range operands {
+					if v.isString {
+						text.WriteString(v.value)
+					} else if v.number < -200 {
+						//a wide gap between glyphs reads as a space
+						text.WriteByte(' ')
+					}
+				}
+			}
+		case "Td", "TD":
+			if len(operands) == 2 && operands[1].number != 0 {
+				text.WriteByte('\n')
+			} else {
+				text.WriteByte(' ')
+			}
+		case "T*", "Tm":
+			text.WriteByte('\n')
+		}
+		operands = operands[:0]
+	}
+}
+
+func writeStrings(text *strings.Builder, operands []pdfOperand) {
+	for _, v := range operands {
+		if v.isString {
+			text.WriteString(v.value)
+		}
+	}
+}
+
+type pdfOperand struct {
+	operator string
+	isString bool
+	value    string
+	number   float64
+}
+
+type pdfLexer struct {
+	data []byte
+	pos  int
+}
+
+func isPDFDelimiter(b byte) bool {
+	return strings.IndexByte("()<>[]{}/%", b) >= 0
+}
+
+func isPDFSpace(b byte) bool {
+	return strings.IndexByte(" \t\r\n\f\x00", b) >= 0
+}
+
+// next returns the next operand or operator, names and array or dictionary
+// brackets are skipped.
+func (l *pdfLexer) next() (pdfOperand, bool) {
+	for l.pos < len(l.data) {
+		b := l.data[l.pos]
+		switch {
+		case isPDFSpace(b):
+			l.pos++
+		case b == '%':
+			for l.pos < len(l.data) && l.data[l.pos] != '\n' && l.data[l.pos] != '\r' {
+				l.pos++
+			}
+		case b == '(':
+			l.pos++
+			return pdfOperand{isString: true, value: l.literalString()}, true
+		case b == '<' && l.pos+1 < len(l.data) && l.data[l.pos+1] == '<':
+			l.pos += 2
+		case b == '<':
+			l.pos++
+			return pdfOperand{isString: true, value: l.hexString()}, true
+		case b == '/':
+			l.pos++
+			l.regular()
+		case isPDFDelimiter(b):
+			l.pos++
+		default:
+			word := l.regular()
+			if number, ok := parsePDFNumber(word); ok {
+				return pdfOperand{number: number}, true
+			}
+			return pdfOperand{operator: word}, true
+		}
+	}
+	return pdfOperand{}, false
+}
+
+func (l *pdfLexer) regular() string {
+	start := l.pos
+	for l.pos < len(l.data) && !isPDFSpace(l.data[l.pos]) && !isPDFDelimiter(l.data[l.pos]) {
+		l.pos++
+	}
+	if l.pos == start {
+		l.pos++
+	}
+	return string(l.data[start:l.pos])
+}
+
+// literalString reads a (string) with its escapes, the bytes being taken
+// as Latin-1 which matches the standard encodings for plain ASCII text.
+func (l *pdfLexer) literalString() string {
+	var s []rune
+	depth := 1
+	for l.pos < len(l.data) {
+		b := l.data[l.pos]
+		l.pos++
+		switch b {
+		case '(':
+			depth++
+		case ')':
+			depth--
+			if depth == 0 {
+				return string(s)
+			}
+		case '\\':
+			if l.pos >= len(l.data) {
+				return string(s)
+			}
+			e := l.data[l.pos]
+			l.pos++
+			switch e {
+			case 'n':
+				s = append(s, '\n')
+			case 'r':
+				s = append(s, '\r')
+			case 't':
+				s = append(s, '\t')
+			case 'b', 'f':
+			case '\r':
+				if l.pos < len(l.data) && l.data[l.pos] == '\n' {
+					l.pos++
+				}
+			case '\n':
+			case '0', '1', '2', '3', '4', '5', '6', '7':
+				code := int(e - '0')
+				for i := 0; i < 2 && l.pos < len(l.data) && l.data[l.pos] >= '0' && l.data[l.pos] <= '7'; i++ {
+					code = code*8 + int(l.data[l.pos]-'0')
+					l.pos++
+				}
+				s = append(s, rune(code&0xff))
+			default:
+				s = append(s, rune(e))
+			}
+			continue
+		}
+		s = append(s, rune(b))
+	}
+	return string(s)
+}
+
+// hexString reads a <hex string>. Strings in UTF-16 with a byte order mark
+// are decoded, anything that is not printable ASCII is a glyph id of an
+// embedded font and is dropped.
+func (l *pdfLexer) hexString() string {
+	var digits []byte
+	for l.pos < len(l.data) && l.data[l.pos] != '>' {
+		if b := l.data[l.pos]; unicode.Is(unicode.ASCII_Hex_Digit, rune(b)) {
+			digits = append(digits, b)
+		}
+		l.pos++
+	}
+	l.pos++
+	if len(digits)%2 == 1 {
+		digits = append(digits, '0')
+	}
+
+	raw := make([]byte, len(digits)/2)
+	for i := range raw {
+		raw[i] = hexValue(digits[2*i])<<4 | hexValue(digits[2*i+1])
+	}
+
+	if len(raw) >= 2 && raw[0] == 0xfe && raw[1] == 0xff {
+		units := make([]uint16, 0, len(raw)/2)
+		for i := 2; i+1 < len(raw); i += 2 {
+			units = append(units, uint16(raw[i])<<8|uint16(raw[i+1]))
+		}
+		return string(utf16.Decode(units))
+	}
+	for _, b := range raw {
+		if b < 0x20 || b > 0x7e {
+			return ""
+		}
+	}
+	return string(raw)
+}
+
+func hexValue(b byte) byte {
+	switch {
+	case b >= 'a':
+		return b - 'a' + 10
+	case b >= 'A':
+		return b - 'A' + 10
+	}
+	return b - '0'
+}
+
+func parsePDFNumber(word string) (float64, bool) {
+	if word == "" || strings.Trim(word, "+-.0123456789") != "" {
+		return 0, false
+	}
+	number, err := strconv.ParseFloat(word, 64)
+	return number, err == nil
+}
+
+// tidy collapses runs of blanks and empty lines and caps the length.
+func tidy(text string) string {
+	var lines []string
+	for _, line := range strings.Split(text, "\n") {
+		line = strings.Join(strings.Fields(line), " ")
+		if line != "" {
+			lines = append(lines, line)
+		}
+	}
+	text = strings.Join(lines, "\n")
+	if len(text) > maxTextSize {
+		text = strings.ToValidUTF8(text[:maxTextSize], "")
+	}
+	return text
+}
@@ -0,0 +1,120 @@
+package resume
+
+import (
+	"archive/zip"
+	"bytes"
+	"compress/zlib"
+	"errors"
+	"testing"
+)
+
+func testPDF() []byte {
+	var compressed bytes.Buffer
+	w := zlib.NewWriter(&compressed)
+	w.Write([]byte("BT /F1 12 Tf 72 700 Td [(Go)-20(lang)-400(and)] TJ 0 -14 Td <FEFF0050006F0073007400670072006500530051004C0020> Tj <0012> Tj ET"))
+	w.Close()
+
+	var pdf bytes.Buffer
+	pdf.WriteString("%PDF-1.4\n")
+	pdf.WriteString("1 0 obj\n<< /Length 49 >>\nstream\nBT /F1 12 Tf 72 720 Td (Worked in \\(Pune\\)) Tj ET\nendstream\nendobj\n")
+	pdf.WriteString("2 0 obj\n<< /Length 10 /Filter /FlateDecode >>\nstream\n")
+	pdf.Write(compressed.Bytes())
+	pdf.WriteString("\nendstream\nendobj\n")
+	pdf.WriteString("3 0 obj\n<< /Type /XObject /Subtype /Image /Length 31 >>\nstream\nBT (not text) Tj ET\nendstream\nendobj\n")
+	pdf.WriteString("4 0 obj\n<< /Length 20 /Filter /DCTDecode >>\nstream\nBT (jpeg) Tj ET\nendstream\nendobj\n")
+	pdf.WriteString("trailer\n<< /Root 5 0 R >>\n%%EOF\n")
+	return pdf.Bytes()
+}
+
+func testDOCX(t *testing.T) []byte {
+	var docx bytes.Buffer
+	w := zip.NewWriter(&docx)
+	part, err := w.Create("word/document.xml")
+	if err != nil {
+		t.Fatalf("zip.Create() error = %v", err)
+	}
+	part.Write([]byte(`<?xml version="1.0" encoding="UTF-8"?>
+<w:document xmlns:w="http://schemas.openxmlformats.org/wordprocessingml/2006/main"><w:body>
+<w:p><w:r><w:t>Skills:</w:t></w:r><w:r><w:tab/><w:t xml:space="preserve">Java, </w:t></w:r><w:r><w:t>Docker</w:t></w:r></w:p>
+<w:p><w:r><w:t>MCA</w:t><w:br/><w:t>Hyderabad</w:t></w:r></w:p>
+</w:body></w:document>`))
+	w.Close()
+	return docx.Bytes()
+}
+
+func TestExtractText(t *testing.T) {
+	tests := []struct {
+		name     string
+		fileName string
+		data     []byte
+		want     string
+		wantErr  error
+	}{
+		{
+			name:     "pdf",
+			fileName: "Resume.PDF",
+			data:     testPDF(),
+			want:     "Worked in (Pune)\nGolang and\nPostgreSQL",
+		},
+		{
+			name:     "docx",
+			fileName: "resume.docx",
+			data:     testDOCX(t),
+			want:     "Skills: Java, Docker\nMCA\nHyderabad",
+		},
+		{
+			name:     "plain text",
+			fileName: "resume.txt",
+			data:     []byte("\xef\xbb\xbfRedis   and\r\n\r\n  AWS  \n"),
+			want:     "Redis and\nAWS",
+		},
+		{
+			name:     "unsupported format",
+			fileName: "resume.doc",
+			data:     []byte("Go"),
+			wantErr:  ErrUnsupported,
+		},
+		{
+			name:     "not a pdf",
+			fileName: "resume.pdf",
+			data:     []byte("Go"),
+			wantErr:  ErrUnreadable,
+		},
+		{
+			name:     "encrypted pdf",
+			fileName: "resume.pdf",
+			data:     []byte("%PDF-1.4\ntrailer\n<< /Encrypt 6 0 R >>\n"),
+			wantErr:  ErrUnreadable,
+		},
+		{
+			name:     "not a docx",
+			fileName: "resume.docx",
+			data:     []byte("Go"),
+			wantErr:  ErrUnreadable,
+		},
+		{
+			name:     "text that is not utf-8",
+			fileName: "resume.txt",
+			data:     []byte("Go \xff"),
+			wantErr:  ErrUnreadable,
+		},
+		{
+			name:     "no text",
+			fileName: "resume.txt",
+			data:     []byte(" \n\t"),
+			wantErr:  ErrNoText,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ExtractText(tt.fileName, tt.data)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("ExtractText() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("ExtractText() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
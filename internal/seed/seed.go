@@ -0,0 +1,33 @@
+package seed
+
+import (
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"job-portal-api/internal/model"
+	"os"
+)
+
+//go:embed catalogue.json
+var defaultCatalogue []byte
+
+// LoadCatalogue reads a reference data catalogue from path, or the catalogue
+// bundled with the binary when path is empty.
+func LoadCatalogue(path string) (model.TaxonomyCatalogue, error) {
+	data := defaultCatalogue
+	if path != "" {
+		fileData, err := os.ReadFile(path)
+		if err != nil {
+			return model.TaxonomyCatalogue{}, fmt.Errorf("error in reading catalogue file : %w", err)
+		}
+		data = fileData
+	}
+
+	var catalogue model.TaxonomyCatalogue
+	err := json.Unmarshal(data, &catalogue)
+	if err != nil {
+		return model.TaxonomyCatalogue{}, fmt.Errorf("error in parsing catalogue : %w", err)
+	}
+
+	return catalogue, nil
+}
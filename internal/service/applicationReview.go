@@ -0,0 +1,192 @@
+package service
+
+import (
+	"errors"
+	"job-portal-api/internal/model"
+	"job-portal-api/internal/repository"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/rs/zerolog/log"
+)
+
+//go:generate mockgen -source=applicationReview.go -destination=applicationReview_mock.go -package=service
+type ApplicationReviewService interface {
+	AddApplicationNote(userID uint, aID uint, noteData model.NewApplicationNote) (model.ApplicationNote, error)
+	ViewApplicationReview(userID uint, aID uint) (model.ApplicationReview, error)
+	TagApplication(userID uint, aID uint, tagData model.NewApplicationTags) (model.ApplicationReview, error)
+	RateApplication(userID uint, aID uint, ratingData model.NewApplicationRating) (model.ApplicationReview, error)
+	FilterApplications(userID uint, jID uint, tag string, minRating int) ([]model.Application, error)
+}
+
+func NewApplicationReviewService(reviewRepo repository.ApplicationReviewRepository, applicationRepo repository.ApplicationRepository, jobRepo repository.JobRepository, companyRepo repository.ComapnyRepo) (ApplicationReviewService, error) {
+	if reviewRepo == nil || applicationRepo == nil || jobRepo == nil || companyRepo == nil {
+		log.Info().Msg("review, application, job and company repositories cannot be nil")
+		return nil, errors.New("review, application, job and company repositories cannot be nil")
+	}
+	return &Service{
+		reviewRepo:      reviewRepo,
+		applicationRepo: applicationRepo,
+		jobRepo:         jobRepo,
+		comapnayRepo:    companyRepo,
+	}, nil
+}
+
+// reviewableApplication loads an application the user recruits for. Notes,
+// tags and ratings are private to the hiring team, the candidate cannot
+// see them.
+func (s *Service) reviewableApplication(userID uint, aID uint) (model.Application, error) {
+
+	application, err := s.applicationRepo.GetApplicationByID(aID)
+	if err != nil {
+		return model.Application{}, err
+	}
+
+	err = s.authorizeRecruiter(userID, application.JobID)
+	if err != nil {
+		return model.Application{}, err
+	}
+
+	return application, nil
+}
+
+func (s *Service) AddApplicationNote(userID uint, aID uint, noteData model.NewApplicationNote) (model.ApplicationNote, error) {
+
+	_, err := s.reviewableApplication(userID, aID)
+	if err != nil {
+		return model.ApplicationNote{}, err
+	}
+
+	body := strings.TrimSpace(noteData.Body)
+	if body == "" {
+		return model.ApplicationNote{}, errors.New("note cannot be empty")
+	}
+
+	return s.reviewRepo.CreateApplicationNote(model.ApplicationNote{
+		ApplicationID: aID,
+		AuthorID:      userID,
+		Body:          body,
+		CreatedAt:     time.Now(),
+	})
+}
+
+func (s *Service) ViewApplicationReview(userID uint, aID uint) (model.ApplicationReview, error) {
+
+	_, err := s.reviewableApplication(userID, aID)
+	if err != nil {
+		return model.ApplicationReview{}, err
+	}
+
+	return s.applicationReview(aID)
+}
+
+// TagApplication replaces the tags of the application. Tags are trimmed,
+// lower cased and deduplicated, an empty list removes every tag.
+func (s *Service) TagApplication(userID uint, aID uint, tagData model.NewApplicationTags) (model.ApplicationReview, error) {
+
+	_, err := s.reviewableApplication(userID, aID)
+	if err != nil {
+		return model.ApplicationReview{}, err
+	}
+
+	tags, err := normaliseTags(tagData.Tags)
+	if err != nil {
+		return model.ApplicationReview{}, err
+	}
+
+	err = s.reviewRepo.ReplaceApplicationTags(aID, tags, userID)
+	if err != nil {
+		return model.ApplicationReview{}, err
+	}
+
+	return s.applicationReview(aID)
+}
+
+func (s *Service) RateApplication(userID uint, aID uint, ratingData model.NewApplicationRating) (model.ApplicationReview, error) {
+
+	_, err := s.reviewableApplication(userID, aID)
+	if err != nil {
+		return model.ApplicationReview{}, err
+	}
+
+	if ratingData.Rating < 1 || ratingData.Rating > 5 {
+		log.Error().Int("rating", ratingData.Rating).Msg("invalid application rating")
+		return model.ApplicationReview{}, errors.New("rating must be between 1 and 5")
+	}
+
+	err = s.reviewRepo.SaveApplicationRating(model.ApplicationRating{
+		ApplicationID: aID,
+		Rating:        ratingData.Rating,
+		RatedBy:       userID,
+		RatedAt:       time.Now(),
+	})
+	if err != nil {
+		return model.ApplicationReview{}, err
+	}
+
+	return s.applicationReview(aID)
+}
+
+// FilterApplications lists the applications of a job that carry the tag
+// and are rated at least minRating. Either filter can be left out.
+func (s *Service) FilterApplications(userID uint, jID uint, tag string, minRating int) ([]model.Application, error) {
+
+	if minRating < 0 || minRating > 5 {
+		log.Error().Int("min rating", minRating).Msg("invalid minimum rating")
+		return nil, errors.New("minimum rating must be between 1 and 5")
+	}
+
+	err := s.authorizeRecruiter(userID, jID)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.reviewRepo.FilterApplications(jID, strings.ToLower(strings.TrimSpace(tag)), minRating)
+}
+
+func (s *Service) applicationReview(aID uint) (model.ApplicationReview, error) {
+
+	review := model.ApplicationReview{
+		ApplicationID: aID,
+	}
+
+	rating, err := s.reviewRepo.GetApplicationRating(aID)
+	if err == nil {
+		review.Rating = &rating
+	} else if !errors.Is(err, repository.ErrRatingNotFound) {
+		return model.ApplicationReview{}, err
+	}
+
+	review.Tags, err = s.reviewRepo.GetApplicationTags(aID)
+	if err != nil {
+		return model.ApplicationReview{}, err
+	}
+
+	review.Notes, err = s.reviewRepo.GetApplicationNotes(aID)
+	if err != nil {
+		return model.ApplicationReview{}, err
+	}
+	for i := range review.Notes {
+		review.Notes[i].AuthorName = review.Notes[i].Author.UserName
+	}
+
+	return review, nil
+}
+
+func normaliseTags(tags []string) ([]string, error) {
+	seen := make(map[string]bool, len(tags))
+	normalised := []string{}
+	for _, v := range tags {
+		tag := strings.ToLower(strings.TrimSpace(v))
+		if tag == "" {
+			return nil, errors.New("tag cannot be empty")
+		}
+		if !seen[tag] {
+			seen[tag] = true
+			normalised = append(normalised, tag)
+		}
+	}
+	sort.Strings(normalised)
+	return normalised, nil
+}
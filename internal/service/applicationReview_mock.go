@@ -0,0 +1,114 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: applicationReview.go
+//
+// Generated by this command:
+//
+//	mockgen -source=applicationReview.go -destination=applicationReview_mock.go -package=service
+//
+// Package service is a generated GoMock package.
+package service
+
+import (
+	model "job-portal-api/internal/model"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockApplicationReviewService is a mock of ApplicationReviewService interface.
+type MockApplicationReviewService struct {
+	ctrl     *gomock.Controller
+	recorder *MockApplicationReviewServiceMockRecorder
+}
+
+// MockApplicationReviewServiceMockRecorder is the mock recorder for MockApplicationReviewService.
+type MockApplicationReviewServiceMockRecorder struct {
+	mock *MockApplicationReviewService
+}
+
+// NewMockApplicationReviewService creates a new mock instance.
+func NewMockApplicationReviewService(ctrl *gomock.Controller) *MockApplicationReviewService {
+	mock := &MockApplicationReviewService{ctrl: ctrl}
+	mock.recorder = &MockApplicationReviewServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockApplicationReviewService) EXPECT() *MockApplicationReviewServiceMockRecorder {
+	return m.recorder
+}
+
+// AddApplicationNote mocks base method.
+func (m *MockApplicationReviewService) AddApplicationNote(userID, aID uint, noteData model.NewApplicationNote) (model.ApplicationNote, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddApplicationNote", userID, aID, noteData)
+	ret0, _ := ret[0].(model.ApplicationNote)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddApplicationNote indicates an expected call of AddApplicationNote.
+func (mr *MockApplicationReviewServiceMockRecorder) AddApplicationNote(userID, aID, noteData any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddApplicationNote", reflect.TypeOf((*MockApplicationReviewService)(nil).AddApplicationNote), userID, aID, noteData)
+}
+
+// FilterApplications mocks base method.
+func (m *MockApplicationReviewService) FilterApplications(userID, jID uint, tag string, minRating int) ([]model.Application, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FilterApplications", userID, jID, tag, minRating)
+	ret0, _ := ret[0].([]model.Application)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FilterApplications indicates an expected call of FilterApplications.
+func (mr *MockApplicationReviewServiceMockRecorder) FilterApplications(userID, jID, tag, minRating any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FilterApplications", reflect.TypeOf((*MockApplicationReviewService)(nil).FilterApplications), userID, jID, tag, minRating)
+}
+
+// RateApplication mocks base method.
+func (m *MockApplicationReviewService) RateApplication(userID, aID uint, ratingData model.NewApplicationRating) (model.ApplicationReview, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RateApplication", userID, aID, ratingData)
+	ret0, _ := ret[0].(model.ApplicationReview)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RateApplication indicates an expected call of RateApplication.
+func (mr *MockApplicationReviewServiceMockRecorder) RateApplication(userID, aID, ratingData any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RateApplication", reflect.TypeOf((*MockApplicationReviewService)(nil).RateApplication), userID, aID, ratingData)
+}
+
+// TagApplication mocks base method.
+func (m *MockApplicationReviewService) TagApplication(userID, aID uint, tagData model.NewApplicationTags) (model.ApplicationReview, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TagApplication", userID, aID, tagData)
+	ret0, _ := ret[0].(model.ApplicationReview)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TagApplication indicates an expected call of TagApplication.
+func (mr *MockApplicationReviewServiceMockRecorder) TagApplication(userID, aID, tagData any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TagApplication", reflect.TypeOf((*MockApplicationReviewService)(nil).TagApplication), userID, aID, tagData)
+}
+
+// ViewApplicationReview mocks base method.
+func (m *MockApplicationReviewService) ViewApplicationReview(userID, aID uint) (model.ApplicationReview, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ViewApplicationReview", userID, aID)
+	ret0, _ := ret[0].(model.ApplicationReview)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ViewApplicationReview indicates an expected call of ViewApplicationReview.
+func (mr *MockApplicationReviewServiceMockRecorder) ViewApplicationReview(userID, aID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ViewApplicationReview", reflect.TypeOf((*MockApplicationReviewService)(nil).ViewApplicationReview), userID, aID)
+}
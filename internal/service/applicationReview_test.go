@@ -0,0 +1,345 @@
+package service
+
+import (
+	"errors"
+	"job-portal-api/internal/model"
+	"job-portal-api/internal/repository"
+	"reflect"
+	"testing"
+	"time"
+
+	gomock "go.uber.org/mock/gomock"
+	"gorm.io/gorm"
+)
+
+func expectRecruiter(ma *repository.MockApplicationRepository, mj *repository.MockJobRepository, mco *repository.MockComapnyRepo) {
+	ma.EXPECT().GetApplicationByID(uint(3)).Return(model.Application{Model: gorm.Model{ID: 3}, UserID: 1, JobID: 2}, nil)
+	mj.EXPECT().GetJobByJobID(uint(2)).Return(model.Job{Cid: 4}, nil)
+	mco.EXPECT().GetCompanyByID(uint64(4)).Return(model.Company{OwnerID: 8}, nil)
+}
+
+func TestService_AddApplicationNote(t *testing.T) {
+	tests := []struct {
+		name         string
+		userID       uint
+		noteData     model.NewApplicationNote
+		want         model.ApplicationNote
+		wantErr      bool
+		errIs        error
+		mockResponse func(mr *repository.MockApplicationReviewRepository, ma *repository.MockApplicationRepository, mj *repository.MockJobRepository, mco *repository.MockComapnyRepo)
+	}{
+		{
+			name:     "application not found",
+			userID:   8,
+			noteData: model.NewApplicationNote{Body: "good fit"},
+			wantErr:  true,
+			mockResponse: func(mr *repository.MockApplicationReviewRepository, ma *repository.MockApplicationRepository, mj *repository.MockJobRepository, mco *repository.MockComapnyRepo) {
+				ma.EXPECT().GetApplicationByID(uint(3)).Return(model.Application{}, errors.New("error"))
+			},
+		},
+		{
+			name:     "candidate cannot add notes",
+			userID:   1,
+			noteData: model.NewApplicationNote{Body: "good fit"},
+			wantErr:  true,
+			errIs:    ErrForbidden,
+			mockResponse: func(mr *repository.MockApplicationReviewRepository, ma *repository.MockApplicationRepository, mj *repository.MockJobRepository, mco *repository.MockComapnyRepo) {
+				expectRecruiter(ma, mj, mco)
+			},
+		},
+		{
+			name:     "empty note",
+			userID:   8,
+			noteData: model.NewApplicationNote{Body: "  \n"},
+			wantErr:  true,
+			mockResponse: func(mr *repository.MockApplicationReviewRepository, ma *repository.MockApplicationRepository, mj *repository.MockJobRepository, mco *repository.MockComapnyRepo) {
+				expectRecruiter(ma, mj, mco)
+			},
+		},
+		{
+			name:     "success",
+			userID:   8,
+			noteData: model.NewApplicationNote{Body: " strong on go "},
+			want:     model.ApplicationNote{ID: 1, ApplicationID: 3, AuthorID: 8, Body: "strong on go"},
+			mockResponse: func(mr *repository.MockApplicationReviewRepository, ma *repository.MockApplicationRepository, mj *repository.MockJobRepository, mco *repository.MockComapnyRepo) {
+				expectRecruiter(ma, mj, mco)
+				mr.EXPECT().CreateApplicationNote(gomock.Any()).DoAndReturn(func(note model.ApplicationNote) (model.ApplicationNote, error) {
+					note.ID = 1
+					note.CreatedAt = time.Time{}
+					return note, nil
+				})
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			mr := repository.NewMockApplicationReviewRepository(mc)
+			ma := repository.NewMockApplicationRepository(mc)
+			mj := repository.NewMockJobRepository(mc)
+			mco := repository.NewMockComapnyRepo(mc)
+			tt.mockResponse(mr, ma, mj, mco)
+			s, _ := NewApplicationReviewService(mr, ma, mj, mco)
+			got, err := s.AddApplicationNote(tt.userID, 3, tt.noteData)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Service.AddApplicationNote() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.errIs != nil && !errors.Is(err, tt.errIs) {
+				t.Errorf("Service.AddApplicationNote() error = %v, want %v", err, tt.errIs)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Service.AddApplicationNote() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestService_ViewApplicationReview(t *testing.T) {
+	tests := []struct {
+		name         string
+		want         model.ApplicationReview
+		wantErr      bool
+		mockResponse func(mr *repository.MockApplicationReviewRepository)
+	}{
+		{
+			name:    "failure in fetching rating",
+			wantErr: true,
+			mockResponse: func(mr *repository.MockApplicationReviewRepository) {
+				mr.EXPECT().GetApplicationRating(uint(3)).Return(model.ApplicationRating{}, errors.New("error"))
+			},
+		},
+		{
+			name: "not rated yet",
+			want: model.ApplicationReview{ApplicationID: 3, Tags: []string{}, Notes: []model.ApplicationNote{}},
+			mockResponse: func(mr *repository.MockApplicationReviewRepository) {
+				mr.EXPECT().GetApplicationRating(uint(3)).Return(model.ApplicationRating{}, repository.ErrRatingNotFound)
+				mr.EXPECT().GetApplicationTags(uint(3)).Return([]string{}, nil)
+				mr.EXPECT().GetApplicationNotes(uint(3)).Return([]model.ApplicationNote{}, nil)
+			},
+		},
+		{
+			name: "success",
+			want: model.ApplicationReview{
+				ApplicationID: 3,
+				Rating:        &model.ApplicationRating{ApplicationID: 3, Rating: 4, RatedBy: 8},
+				Tags:          []string{"backend", "referral"},
+				Notes: []model.ApplicationNote{
+					{ID: 1, ApplicationID: 3, AuthorID: 8, Author: model.User{UserName: "ravi"}, AuthorName: "ravi", Body: "strong on go"},
+				},
+			},
+			mockResponse: func(mr *repository.MockApplicationReviewRepository) {
+				mr.EXPECT().GetApplicationRating(uint(3)).Return(model.ApplicationRating{ApplicationID: 3, Rating: 4, RatedBy: 8}, nil)
+				mr.EXPECT().GetApplicationTags(uint(3)).Return([]string{"backend", "referral"}, nil)
+				mr.EXPECT().GetApplicationNotes(uint(3)).Return([]model.ApplicationNote{
+					{ID: 1, ApplicationID: 3, AuthorID: 8, Author: model.User{UserName: "ravi"}, Body: "strong on go"},
+				}, nil)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			mr := repository.NewMockApplicationReviewRepository(mc)
+			ma := repository.NewMockApplicationRepository(mc)
+			mj := repository.NewMockJobRepository(mc)
+			mco := repository.NewMockComapnyRepo(mc)
+			expectRecruiter(ma, mj, mco)
+			tt.mockResponse(mr)
+			s, _ := NewApplicationReviewService(mr, ma, mj, mco)
+			got, err := s.ViewApplicationReview(8, 3)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Service.ViewApplicationReview() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Service.ViewApplicationReview() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestService_TagApplication(t *testing.T) {
+	tests := []struct {
+		name         string
+		tagData      model.NewApplicationTags
+		want         model.ApplicationReview
+		wantErr      bool
+		mockResponse func(mr *repository.MockApplicationReviewRepository)
+	}{
+		{
+			name:         "empty tag",
+			tagData:      model.NewApplicationTags{Tags: []string{"backend", " "}},
+			wantErr:      true,
+			mockResponse: func(mr *repository.MockApplicationReviewRepository) {},
+		},
+		{
+			name:    "failure in saving tags",
+			tagData: model.NewApplicationTags{Tags: []string{"backend"}},
+			wantErr: true,
+			mockResponse: func(mr *repository.MockApplicationReviewRepository) {
+				mr.EXPECT().ReplaceApplicationTags(uint(3), []string{"backend"}, uint(8)).Return(errors.New("error"))
+			},
+		},
+		{
+			name:    "success",
+			tagData: model.NewApplicationTags{Tags: []string{" Referral", "backend", "BACKEND"}},
+			want:    model.ApplicationReview{ApplicationID: 3, Tags: []string{"backend", "referral"}, Notes: []model.ApplicationNote{}},
+			mockResponse: func(mr *repository.MockApplicationReviewRepository) {
+				mr.EXPECT().ReplaceApplicationTags(uint(3), []string{"backend", "referral"}, uint(8)).Return(nil)
+				mr.EXPECT().GetApplicationRating(uint(3)).Return(model.ApplicationRating{}, repository.ErrRatingNotFound)
+				mr.EXPECT().GetApplicationTags(uint(3)).Return([]string{"backend", "referral"}, nil)
+				mr.EXPECT().GetApplicationNotes(uint(3)).Return([]model.ApplicationNote{}, nil)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			mr := repository.NewMockApplicationReviewRepository(mc)
+			ma := repository.NewMockApplicationRepository(mc)
+			mj := repository.NewMockJobRepository(mc)
+			mco := repository.NewMockComapnyRepo(mc)
+			expectRecruiter(ma, mj, mco)
+			tt.mockResponse(mr)
+			s, _ := NewApplicationReviewService(mr, ma, mj, mco)
+			got, err := s.TagApplication(8, 3, tt.tagData)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Service.TagApplication() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Service.TagApplication() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestService_RateApplication(t *testing.T) {
+	tests := []struct {
+		name         string
+		ratingData   model.NewApplicationRating
+		want         model.ApplicationReview
+		wantErr      bool
+		mockResponse func(mr *repository.MockApplicationReviewRepository)
+	}{
+		{
+			name:         "rating out of range",
+			ratingData:   model.NewApplicationRating{Rating: 6},
+			wantErr:      true,
+			mockResponse: func(mr *repository.MockApplicationReviewRepository) {},
+		},
+		{
+			name:       "failure in saving rating",
+			ratingData: model.NewApplicationRating{Rating: 4},
+			wantErr:    true,
+			mockResponse: func(mr *repository.MockApplicationReviewRepository) {
+				mr.EXPECT().SaveApplicationRating(gomock.Any()).Return(errors.New("error"))
+			},
+		},
+		{
+			name:       "success",
+			ratingData: model.NewApplicationRating{Rating: 4},
+			want: model.ApplicationReview{
+				ApplicationID: 3,
+				Rating:        &model.ApplicationRating{ApplicationID: 3, Rating: 4, RatedBy: 8},
+				Tags:          []string{},
+				Notes:         []model.ApplicationNote{},
+			},
+			mockResponse: func(mr *repository.MockApplicationReviewRepository) {
+				mr.EXPECT().SaveApplicationRating(gomock.Cond(func(x any) bool {
+					rating := x.(model.ApplicationRating)
+					return rating.ApplicationID == 3 && rating.Rating == 4 && rating.RatedBy == 8
+				})).Return(nil)
+				mr.EXPECT().GetApplicationRating(uint(3)).Return(model.ApplicationRating{ApplicationID: 3, Rating: 4, RatedBy: 8}, nil)
+				mr.EXPECT().GetApplicationTags(uint(3)).Return([]string{}, nil)
+				mr.EXPECT().GetApplicationNotes(uint(3)).Return([]model.ApplicationNote{}, nil)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			mr := repository.NewMockApplicationReviewRepository(mc)
+			ma := repository.NewMockApplicationRepository(mc)
+			mj := repository.NewMockJobRepository(mc)
+			mco := repository.NewMockComapnyRepo(mc)
+			expectRecruiter(ma, mj, mco)
+			tt.mockResponse(mr)
+			s, _ := NewApplicationReviewService(mr, ma, mj, mco)
+			got, err := s.RateApplication(8, 3, tt.ratingData)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Service.RateApplication() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Service.RateApplication() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestService_FilterApplications(t *testing.T) {
+	tests := []struct {
+		name         string
+		userID       uint
+		tag          string
+		minRating    int
+		want         []model.Application
+		wantErr      bool
+		errIs        error
+		mockResponse func(mr *repository.MockApplicationReviewRepository, mj *repository.MockJobRepository, mco *repository.MockComapnyRepo)
+	}{
+		{
+			name:      "minimum rating out of range",
+			userID:    8,
+			minRating: 9,
+			wantErr:   true,
+			mockResponse: func(mr *repository.MockApplicationReviewRepository, mj *repository.MockJobRepository, mco *repository.MockComapnyRepo) {
+			},
+		},
+		{
+			name:    "not the recruiter",
+			userID:  1,
+			wantErr: true,
+			errIs:   ErrForbidden,
+			mockResponse: func(mr *repository.MockApplicationReviewRepository, mj *repository.MockJobRepository, mco *repository.MockComapnyRepo) {
+				mj.EXPECT().GetJobByJobID(uint(2)).Return(model.Job{Cid: 4}, nil)
+				mco.EXPECT().GetCompanyByID(uint64(4)).Return(model.Company{OwnerID: 8}, nil)
+			},
+		},
+		{
+			name:      "success",
+			userID:    8,
+			tag:       " Backend ",
+			minRating: 3,
+			want:      []model.Application{{Model: gorm.Model{ID: 3}, UserID: 1, JobID: 2}},
+			mockResponse: func(mr *repository.MockApplicationReviewRepository, mj *repository.MockJobRepository, mco *repository.MockComapnyRepo) {
+				mj.EXPECT().GetJobByJobID(uint(2)).Return(model.Job{Cid: 4}, nil)
+				mco.EXPECT().GetCompanyByID(uint64(4)).Return(model.Company{OwnerID: 8}, nil)
+				mr.EXPECT().FilterApplications(uint(2), "backend", 3).Return([]model.Application{{Model: gorm.Model{ID: 3}, UserID: 1, JobID: 2}}, nil)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			mr := repository.NewMockApplicationReviewRepository(mc)
+			mj := repository.NewMockJobRepository(mc)
+			mco := repository.NewMockComapnyRepo(mc)
+			tt.mockResponse(mr, mj, mco)
+			s, _ := NewApplicationReviewService(mr, repository.NewMockApplicationRepository(mc), mj, mco)
+			got, err := s.FilterApplications(tt.userID, 2, tt.tag, tt.minRating)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Service.FilterApplications() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.errIs != nil && !errors.Is(err, tt.errIs) {
+				t.Errorf("Service.FilterApplications() error = %v, want %v", err, tt.errIs)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Service.FilterApplications() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,240 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"job-portal-api/internal/cache"
+	"job-portal-api/internal/model"
+	"job-portal-api/internal/repository"
+	"reflect"
+	"time"
+
+	"github.com/rs/zerolog/log"
+)
+
+//go:generate mockgen -source=applicationService.go -destination=applicationService_mock.go -package=service
+type ApplicationService interface {
+	SubmitApplication(ctx context.Context, userID uint, applicationData model.NewUserApplication) (model.Application, error)
+	ResubmitApplication(ctx context.Context, userID uint, applicationData model.NewUserApplication) (model.Application, error)
+	ViewApplication(userID uint, aID uint) (model.Application, error)
+	ViewApplicationsByJobID(userID uint, jID uint) ([]model.Application, error)
+	ChangeApplicationStage(aID uint, changedBy uint, stage string) (model.Application, error)
+	ViewApplicationPipeline(userID uint, jID uint) (model.ApplicationPipeline, error)
+	ViewApplicationStageHistory(userID uint, aID uint) ([]model.ApplicationStageHistory, error)
+	ViewShortlist(ctx context.Context, userID uint, jID uint, offset int, limit int) (model.Shortlist, error)
+	ViewMyApplications(userID uint) ([]model.CandidateApplication, error)
+	WithdrawApplication(userID uint, aID uint) (model.Application, error)
+}
+
+func NewApplicationService(applicationRepo repository.ApplicationRepository, jobRepo repository.JobRepository, companyRepo repository.ComapnyRepo, rdb cache.Caching) (ApplicationService, error) {
+	if applicationRepo == nil {
+		log.Info().Msg("application repository cannot be nil")
+		return nil, errors.New("application repository cannot be nil")
+	}
+	return &Service{
+		applicationRepo: applicationRepo,
+		jobRepo:         jobRepo,
+		comapnayRepo:    companyRepo,
+		rdb:             rdb,
+	}, nil
+}
+
+// ApplicationConflictError is returned when a candidate applies again to a
+// job with different details. The existing application has to be
+// resubmitted instead.
+type ApplicationConflictError struct {
+	ApplicationID uint
+}
+
+func (e *ApplicationConflictError) Error() string {
+	return fmt.Sprintf("already applied to this job with application %d", e.ApplicationID)
+}
+
+// SubmitApplication screens the application against the job and stores it
+// with the outcome, whether or not it passed. Applying again with the same
+// details returns the existing application, applying with other details is
+// an ApplicationConflictError.
+func (s *Service) SubmitApplication(ctx context.Context, userID uint, applicationData model.NewUserApplication) (model.Application, error) {
+
+	existing, err := s.applicationRepo.GetApplicationByUserAndJob(userID, applicationData.Jid)
+	if err == nil && existing.Stage == model.ApplicationStageWithdrawn {
+		return s.reopenApplication(ctx, userID, existing, applicationData)
+	}
+	if err == nil {
+		return sameApplication(existing, applicationData)
+	}
+	if !errors.Is(err, repository.ErrApplicationNotFound) {
+		return model.Application{}, err
+	}
+
+	jobData, err := s.cachedJob(ctx, applicationData.Jid)
+	if err != nil {
+		return model.Application{}, errors.New("could not find the job")
+	}
+
+	if !acceptingApplications(jobData) {
+		log.Info().Uint("job id", applicationData.Jid).Msg("job is not accepting applications")
+		return model.Application{}, errors.New("job is not accepting applications")
+	}
+
+	result := CompareData(applicationData, jobData)
+	now := time.Now()
+	application := model.Application{
+		UserID:         userID,
+		JobID:          applicationData.Jid,
+		Name:           applicationData.Name,
+		Age:            applicationData.Age,
+		Details:        applicationData.Jobs,
+		Accepted:       result.Accepted,
+		Screening:      result,
+		ScreenedAt:     now,
+		Stage:          model.ApplicationStageApplied,
+		StageChangedAt: now,
+	}
+
+	application, err = s.applicationRepo.CreateApplication(application)
+	if errors.Is(err, repository.ErrDuplicateApplication) {
+		//another request of the same candidate created it first
+		existing, err = s.applicationRepo.GetApplicationByUserAndJob(userID, applicationData.Jid)
+		if err != nil {
+			return model.Application{}, err
+		}
+		return sameApplication(existing, applicationData)
+	}
+	if err != nil {
+		return model.Application{}, err
+	}
+
+	s.invalidateShortlist(application.JobID)
+
+	return application, nil
+}
+
+// sameApplication returns the existing application when it was submitted
+// with the same details, so that a retried apply is harmless.
+func sameApplication(existing model.Application, applicationData model.NewUserApplication) (model.Application, error) {
+	if existing.Name == applicationData.Name && existing.Age == applicationData.Age &&
+		reflect.DeepEqual(existing.Details, applicationData.Jobs) {
+		return existing, nil
+	}
+	log.Info().Uint("application id", existing.ID).Msg("candidate already applied to the job")
+	return model.Application{}, &ApplicationConflictError{ApplicationID: existing.ID}
+}
+
+// reopenApplication applies again with a withdrawn application. It is
+// screened with the new details and moved back to applied, the move is
+// recorded in the stage history like any other.
+func (s *Service) reopenApplication(ctx context.Context, userID uint, application model.Application, applicationData model.NewUserApplication) (model.Application, error) {
+
+	jobData, err := s.cachedJob(ctx, applicationData.Jid)
+	if err != nil {
+		return model.Application{}, errors.New("could not find the job")
+	}
+
+	if !acceptingApplications(jobData) {
+		log.Info().Uint("job id", applicationData.Jid).Msg("job is not accepting applications")
+		return model.Application{}, errors.New("job is not accepting applications")
+	}
+
+	result := CompareData(applicationData, jobData)
+	now := time.Now()
+	application.Name = applicationData.Name
+	application.Age = applicationData.Age
+	application.Details = applicationData.Jobs
+	application.Accepted = result.Accepted
+	application.Screening = result
+	application.ScreenedAt = now
+
+	application, err = s.applicationRepo.ReopenApplication(application, model.ApplicationStageHistory{
+		ApplicationID: application.ID,
+		From:          model.ApplicationStageWithdrawn,
+		To:            model.ApplicationStageApplied,
+		ChangedBy:     userID,
+		ChangedAt:     now,
+	})
+	if err != nil {
+		return model.Application{}, err
+	}
+
+	s.invalidateShortlist(application.JobID)
+
+	return application, nil
+}
+
+// ResubmitApplication replaces the details of the candidate's application
+// to the job and screens it again. The application keeps its stage, it can
+// no longer be changed once it is hired, rejected or withdrawn.
+func (s *Service) ResubmitApplication(ctx context.Context, userID uint, applicationData model.NewUserApplication) (model.Application, error) {
+
+	application, err := s.applicationRepo.GetApplicationByUserAndJob(userID, applicationData.Jid)
+	if err != nil {
+		return model.Application{}, err
+	}
+
+	if len(applicationStageTransitions[application.Stage]) == 0 {
+		log.Error().Uint("application id", application.ID).Str("stage", application.Stage).Msg("application can no longer be changed")
+		return model.Application{}, errors.New("application can no longer be changed")
+	}
+
+	jobData, err := s.cachedJob(ctx, applicationData.Jid)
+	if err != nil {
+		return model.Application{}, errors.New("could not find the job")
+	}
+
+	if !acceptingApplications(jobData) {
+		log.Info().Uint("job id", applicationData.Jid).Msg("job is not accepting applications")
+		return model.Application{}, errors.New("job is not accepting applications")
+	}
+
+	result := CompareData(applicationData, jobData)
+	application.Name = applicationData.Name
+	application.Age = applicationData.Age
+	application.Details = applicationData.Jobs
+	application.Accepted = result.Accepted
+	application.Screening = result
+	application.ScreenedAt = time.Now()
+
+	application, err = s.applicationRepo.UpdateApplicationDetails(application)
+	if err != nil {
+		return model.Application{}, err
+	}
+
+	s.invalidateShortlist(application.JobID)
+
+	return application, nil
+}
+
+// ViewApplication returns an application to the candidate who submitted it
+// and to the owner of the company that posted the job.
+func (s *Service) ViewApplication(userID uint, aID uint) (model.Application, error) {
+
+	application, err := s.applicationRepo.GetApplicationByID(aID)
+	if err != nil {
+		return model.Application{}, err
+	}
+
+	err = s.authorizeApplication(userID, application)
+	if err != nil {
+		return model.Application{}, err
+	}
+
+	return application, nil
+}
+
+// ViewApplicationsByJobID lists the applications of a job to the owner of
+// the company that posted it.
+func (s *Service) ViewApplicationsByJobID(userID uint, jID uint) ([]model.Application, error) {
+
+	err := s.authorizeRecruiter(userID, jID)
+	if err != nil {
+		return nil, err
+	}
+
+	applications, err := s.applicationRepo.GetApplicationsByJobID(jID)
+	if err != nil {
+		return nil, err
+	}
+
+	return applications, nil
+}
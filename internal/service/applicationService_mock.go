@@ -0,0 +1,190 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: applicationService.go
+//
+// Generated by this command:
+//
+//	mockgen -source=applicationService.go -destination=applicationService_mock.go -package=service
+//
+// Package service is a generated GoMock package.
+package service
+
+import (
+	context "context"
+	model "job-portal-api/internal/model"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockApplicationService is a mock of ApplicationService interface.
+type MockApplicationService struct {
+	ctrl     *gomock.Controller
+	recorder *MockApplicationServiceMockRecorder
+}
+
+// MockApplicationServiceMockRecorder is the mock recorder for MockApplicationService.
+type MockApplicationServiceMockRecorder struct {
+	mock *MockApplicationService
+}
+
+// NewMockApplicationService creates a new mock instance.
+func NewMockApplicationService(ctrl *gomock.Controller) *MockApplicationService {
+	mock := &MockApplicationService{ctrl: ctrl}
+	mock.recorder = &MockApplicationServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockApplicationService) EXPECT() *MockApplicationServiceMockRecorder {
+	return m.recorder
+}
+
+// ChangeApplicationStage mocks base method.
+func (m *MockApplicationService) ChangeApplicationStage(aID, changedBy uint, stage string) (model.Application, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ChangeApplicationStage", aID, changedBy, stage)
+	ret0, _ := ret[0].(model.Application)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ChangeApplicationStage indicates an expected call of ChangeApplicationStage.
+func (mr *MockApplicationServiceMockRecorder) ChangeApplicationStage(aID, changedBy, stage any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangeApplicationStage", reflect.TypeOf((*MockApplicationService)(nil).ChangeApplicationStage), aID, changedBy, stage)
+}
+
+// ResubmitApplication mocks base method.
+func (m *MockApplicationService) ResubmitApplication(ctx context.Context, userID uint, applicationData model.NewUserApplication) (model.Application, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResubmitApplication", ctx, userID, applicationData)
+	ret0, _ := ret[0].(model.Application)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResubmitApplication indicates an expected call of ResubmitApplication.
+func (mr *MockApplicationServiceMockRecorder) ResubmitApplication(ctx, userID, applicationData any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResubmitApplication", reflect.TypeOf((*MockApplicationService)(nil).ResubmitApplication), ctx, userID, applicationData)
+}
+
+// SubmitApplication mocks base method.
+func (m *MockApplicationService) SubmitApplication(ctx context.Context, userID uint, applicationData model.NewUserApplication) (model.Application, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SubmitApplication", ctx, userID, applicationData)
+	ret0, _ := ret[0].(model.Application)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SubmitApplication indicates an expected call of SubmitApplication.
+func (mr *MockApplicationServiceMockRecorder) SubmitApplication(ctx, userID, applicationData any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubmitApplication", reflect.TypeOf((*MockApplicationService)(nil).SubmitApplication), ctx, userID, applicationData)
+}
+
+// ViewApplication mocks base method.
+func (m *MockApplicationService) ViewApplication(userID, aID uint) (model.Application, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ViewApplication", userID, aID)
+	ret0, _ := ret[0].(model.Application)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ViewApplication indicates an expected call of ViewApplication.
+func (mr *MockApplicationServiceMockRecorder) ViewApplication(userID, aID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ViewApplication", reflect.TypeOf((*MockApplicationService)(nil).ViewApplication), userID, aID)
+}
+
+// ViewApplicationPipeline mocks base method.
+func (m *MockApplicationService) ViewApplicationPipeline(userID, jID uint) (model.ApplicationPipeline, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ViewApplicationPipeline", userID, jID)
+	ret0, _ := ret[0].(model.ApplicationPipeline)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ViewApplicationPipeline indicates an expected call of ViewApplicationPipeline.
+func (mr *MockApplicationServiceMockRecorder) ViewApplicationPipeline(userID, jID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ViewApplicationPipeline", reflect.TypeOf((*MockApplicationService)(nil).ViewApplicationPipeline), userID, jID)
+}
+
+// ViewApplicationStageHistory mocks base method.
+func (m *MockApplicationService) ViewApplicationStageHistory(userID, aID uint) ([]model.ApplicationStageHistory, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ViewApplicationStageHistory", userID, aID)
+	ret0, _ := ret[0].([]model.ApplicationStageHistory)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ViewApplicationStageHistory indicates an expected call of ViewApplicationStageHistory.
+func (mr *MockApplicationServiceMockRecorder) ViewApplicationStageHistory(userID, aID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ViewApplicationStageHistory", reflect.TypeOf((*MockApplicationService)(nil).ViewApplicationStageHistory), userID, aID)
+}
+
+// ViewApplicationsByJobID mocks base method.
+func (m *MockApplicationService) ViewApplicationsByJobID(userID, jID uint) ([]model.Application, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ViewApplicationsByJobID", userID, jID)
+	ret0, _ := ret[0].([]model.Application)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ViewApplicationsByJobID indicates an expected call of ViewApplicationsByJobID.
+func (mr *MockApplicationServiceMockRecorder) ViewApplicationsByJobID(userID, jID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ViewApplicationsByJobID", reflect.TypeOf((*MockApplicationService)(nil).ViewApplicationsByJobID), userID, jID)
+}
+
+// ViewMyApplications mocks base method.
+func (m *MockApplicationService) ViewMyApplications(userID uint) ([]model.CandidateApplication, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ViewMyApplications", userID)
+	ret0, _ := ret[0].([]model.CandidateApplication)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ViewMyApplications indicates an expected call of ViewMyApplications.
+func (mr *MockApplicationServiceMockRecorder) ViewMyApplications(userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ViewMyApplications", reflect.TypeOf((*MockApplicationService)(nil).ViewMyApplications), userID)
+}
+
+// ViewShortlist mocks base method.
+func (m *MockApplicationService) ViewShortlist(ctx context.Context, userID, jID uint, offset, limit int) (model.Shortlist, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ViewShortlist", ctx, userID, jID, offset, limit)
+	ret0, _ := ret[0].(model.Shortlist)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ViewShortlist indicates an expected call of ViewShortlist.
+func (mr *MockApplicationServiceMockRecorder) ViewShortlist(ctx, userID, jID, offset, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ViewShortlist", reflect.TypeOf((*MockApplicationService)(nil).ViewShortlist), ctx, userID, jID, offset, limit)
+}
+
+// WithdrawApplication mocks base method.
+func (m *MockApplicationService) WithdrawApplication(userID, aID uint) (model.Application, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WithdrawApplication", userID, aID)
+	ret0, _ := ret[0].(model.Application)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WithdrawApplication indicates an expected call of WithdrawApplication.
+func (mr *MockApplicationServiceMockRecorder) WithdrawApplication(userID, aID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WithdrawApplication", reflect.TypeOf((*MockApplicationService)(nil).WithdrawApplication), userID, aID)
+}
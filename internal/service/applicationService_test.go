@@ -0,0 +1,448 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"job-portal-api/internal/cache"
+	"job-portal-api/internal/model"
+	"job-portal-api/internal/repository"
+	"reflect"
+	"testing"
+	"time"
+
+	gomock "go.uber.org/mock/gomock"
+	"gorm.io/gorm"
+)
+
+func TestService_SubmitApplication(t *testing.T) {
+	applicationData := model.NewUserApplication{
+		Name: "asha",
+		Age:  "25",
+		Jid:  2,
+		Jobs: model.Requestfield{NoticePeriod: 30, Experience: 2, Location: []uint{1}, TechnologyStack: []uint{1}},
+	}
+	tests := []struct {
+		name         string
+		want         model.Application
+		wantErr      bool
+		mockResponse func(ma *repository.MockApplicationRepository, mj *repository.MockJobRepository, mca *cache.MockCaching)
+	}{
+		{
+			name:    "job not found",
+			want:    model.Application{},
+			wantErr: true,
+			mockResponse: func(ma *repository.MockApplicationRepository, mj *repository.MockJobRepository, mca *cache.MockCaching) {
+				ma.EXPECT().GetApplicationByUserAndJob(uint(1), uint(2)).Return(model.Application{}, repository.ErrApplicationNotFound)
+				mca.EXPECT().GetTheCacheData(gomock.Any(), uint(2)).Return("", errors.New("cache miss"))
+				mj.EXPECT().GetJobByJobID(uint(2)).Return(model.Job{}, errors.New("error"))
+			},
+		},
+		{
+			name:    "job not published",
+			want:    model.Application{},
+			wantErr: true,
+			mockResponse: func(ma *repository.MockApplicationRepository, mj *repository.MockJobRepository, mca *cache.MockCaching) {
+				ma.EXPECT().GetApplicationByUserAndJob(uint(1), uint(2)).Return(model.Application{}, repository.ErrApplicationNotFound)
+				mca.EXPECT().GetTheCacheData(gomock.Any(), uint(2)).Return(`{"ID":2,"status":"closed"}`, nil)
+			},
+		},
+		{
+			name:    "job past its expiry date",
+			want:    model.Application{},
+			wantErr: true,
+			mockResponse: func(ma *repository.MockApplicationRepository, mj *repository.MockJobRepository, mca *cache.MockCaching) {
+				ma.EXPECT().GetApplicationByUserAndJob(uint(1), uint(2)).Return(model.Application{}, repository.ErrApplicationNotFound)
+				mca.EXPECT().GetTheCacheData(gomock.Any(), uint(2)).Return(`{"ID":2,"status":"published","expires_at":"2020-01-01T00:00:00Z"}`, nil)
+			},
+		},
+		{
+			name:    "failure in saving",
+			want:    model.Application{},
+			wantErr: true,
+			mockResponse: func(ma *repository.MockApplicationRepository, mj *repository.MockJobRepository, mca *cache.MockCaching) {
+				ma.EXPECT().GetApplicationByUserAndJob(uint(1), uint(2)).Return(model.Application{}, repository.ErrApplicationNotFound)
+				mca.EXPECT().GetTheCacheData(gomock.Any(), uint(2)).Return(`{"ID":2,"status":"published"}`, nil)
+				ma.EXPECT().CreateApplication(gomock.Any()).Return(model.Application{}, errors.New("error"))
+			},
+		},
+		{
+			name: "rejected application is stored",
+			want: model.Application{
+				Model:   gorm.Model{ID: 1},
+				UserID:  1,
+				JobID:   2,
+				Name:    "asha",
+				Age:     "25",
+				Details: model.Requestfield{NoticePeriod: 30, Experience: 2, Location: []uint{1}, TechnologyStack: []uint{1}},
+				Screening: model.ScreeningResult{
+					Score:     0,
+					MaxScore:  7,
+					Threshold: 3.5,
+				},
+				Stage: model.ApplicationStageApplied,
+			},
+			wantErr: false,
+			mockResponse: func(ma *repository.MockApplicationRepository, mj *repository.MockJobRepository, mca *cache.MockCaching) {
+				ma.EXPECT().GetApplicationByUserAndJob(uint(1), uint(2)).Return(model.Application{}, repository.ErrApplicationNotFound)
+				mca.EXPECT().GetTheCacheData(gomock.Any(), uint(2)).Return(`{"ID":2,"status":"published"}`, nil)
+				ma.EXPECT().CreateApplication(gomock.Any()).DoAndReturn(func(application model.Application) (model.Application, error) {
+					application.ID = 1
+					return application, nil
+				})
+				mca.EXPECT().DeleteShortlist(gomock.Any(), uint(2)).Return(nil)
+			},
+		},
+		{
+			name: "accepted application from the database",
+			want: model.Application{
+				Model:    gorm.Model{ID: 1},
+				UserID:   1,
+				JobID:    2,
+				Name:     "asha",
+				Age:      "25",
+				Details:  model.Requestfield{NoticePeriod: 30, Experience: 2, Location: []uint{1}, TechnologyStack: []uint{1}},
+				Accepted: true,
+				Screening: model.ScreeningResult{
+					Accepted:  true,
+					Score:     4,
+					MaxScore:  7,
+					Threshold: 3.5,
+				},
+				Stage: model.ApplicationStageApplied,
+			},
+			wantErr: false,
+			mockResponse: func(ma *repository.MockApplicationRepository, mj *repository.MockJobRepository, mca *cache.MockCaching) {
+				ma.EXPECT().GetApplicationByUserAndJob(uint(1), uint(2)).Return(model.Application{}, repository.ErrApplicationNotFound)
+				jobData := model.Job{
+					Model:           gorm.Model{ID: 2},
+					Status:          model.JobStatusPublished,
+					MaxNoticePeriod: 60,
+					MaxExperience:   5,
+					Location:        []model.Location{{Model: gorm.Model{ID: 1}}},
+					TechnologyStack: []model.TechnologyStack{{Model: gorm.Model{ID: 1}}},
+				}
+				mca.EXPECT().GetTheCacheData(gomock.Any(), uint(2)).Return("", errors.New("cache miss"))
+				mj.EXPECT().GetJobByJobID(uint(2)).Return(jobData, nil)
+				mca.EXPECT().AddToTheCache(gomock.Any(), uint(2), jobData).Return(nil)
+				ma.EXPECT().CreateApplication(gomock.Any()).DoAndReturn(func(application model.Application) (model.Application, error) {
+					application.ID = 1
+					return application, nil
+				})
+				mca.EXPECT().DeleteShortlist(gomock.Any(), uint(2)).Return(nil)
+			},
+		},
+		{
+			name:    "failure in looking up an existing application",
+			want:    model.Application{},
+			wantErr: true,
+			mockResponse: func(ma *repository.MockApplicationRepository, mj *repository.MockJobRepository, mca *cache.MockCaching) {
+				ma.EXPECT().GetApplicationByUserAndJob(uint(1), uint(2)).Return(model.Application{}, errors.New("error"))
+			},
+		},
+		{
+			name:    "applying again with other details",
+			want:    model.Application{},
+			wantErr: true,
+			mockResponse: func(ma *repository.MockApplicationRepository, mj *repository.MockJobRepository, mca *cache.MockCaching) {
+				ma.EXPECT().GetApplicationByUserAndJob(uint(1), uint(2)).Return(model.Application{Model: gorm.Model{ID: 5}, UserID: 1, JobID: 2, Name: "asha", Age: "25", Details: model.Requestfield{NoticePeriod: 90}}, nil)
+			},
+		},
+		{
+			name:    "applying again with the same details",
+			want:    model.Application{Model: gorm.Model{ID: 5}, UserID: 1, JobID: 2, Name: "asha", Age: "25", Details: applicationData.Jobs, Stage: model.ApplicationStageInterview},
+			wantErr: false,
+			mockResponse: func(ma *repository.MockApplicationRepository, mj *repository.MockJobRepository, mca *cache.MockCaching) {
+				ma.EXPECT().GetApplicationByUserAndJob(uint(1), uint(2)).Return(model.Application{Model: gorm.Model{ID: 5}, UserID: 1, JobID: 2, Name: "asha", Age: "25", Details: applicationData.Jobs, Stage: model.ApplicationStageInterview, ScreenedAt: time.Now()}, nil)
+			},
+		},
+		{
+			name:    "applying again after withdrawing to a closed job",
+			want:    model.Application{},
+			wantErr: true,
+			mockResponse: func(ma *repository.MockApplicationRepository, mj *repository.MockJobRepository, mca *cache.MockCaching) {
+				ma.EXPECT().GetApplicationByUserAndJob(uint(1), uint(2)).Return(model.Application{Model: gorm.Model{ID: 5}, UserID: 1, JobID: 2, Name: "asha", Age: "25", Details: applicationData.Jobs, Stage: model.ApplicationStageWithdrawn}, nil)
+				mca.EXPECT().GetTheCacheData(gomock.Any(), uint(2)).Return(`{"ID":2,"status":"closed"}`, nil)
+			},
+		},
+		{
+			name: "applying again after withdrawing reopens the application",
+			want: model.Application{
+				Model:   gorm.Model{ID: 5},
+				UserID:  1,
+				JobID:   2,
+				Name:    "asha",
+				Age:     "25",
+				Details: applicationData.Jobs,
+				Screening: model.ScreeningResult{
+					Score:     0,
+					MaxScore:  7,
+					Threshold: 3.5,
+				},
+				Stage: model.ApplicationStageApplied,
+			},
+			wantErr: false,
+			mockResponse: func(ma *repository.MockApplicationRepository, mj *repository.MockJobRepository, mca *cache.MockCaching) {
+				withdrawn := model.Application{Model: gorm.Model{ID: 5}, UserID: 1, JobID: 2, Name: "asha", Age: "25", Details: applicationData.Jobs, Stage: model.ApplicationStageWithdrawn}
+				ma.EXPECT().GetApplicationByUserAndJob(uint(1), uint(2)).Return(withdrawn, nil)
+				mca.EXPECT().GetTheCacheData(gomock.Any(), uint(2)).Return(`{"ID":2,"status":"published"}`, nil)
+				ma.EXPECT().ReopenApplication(gomock.Any(), gomock.Cond(func(x any) bool {
+					change := x.(model.ApplicationStageHistory)
+					return change.ApplicationID == 5 && change.From == model.ApplicationStageWithdrawn &&
+						change.To == model.ApplicationStageApplied && change.ChangedBy == 1 && !change.ChangedAt.IsZero()
+				})).DoAndReturn(func(application model.Application, change model.ApplicationStageHistory) (model.Application, error) {
+					application.Stage = change.To
+					application.StageChangedAt = change.ChangedAt
+					return application, nil
+				})
+				mca.EXPECT().DeleteShortlist(gomock.Any(), uint(2)).Return(nil)
+			},
+		},
+		{
+			name:    "created by a concurrent apply",
+			want:    model.Application{Model: gorm.Model{ID: 5}, UserID: 1, JobID: 2, Name: "asha", Age: "25", Details: applicationData.Jobs, Stage: model.ApplicationStageApplied},
+			wantErr: false,
+			mockResponse: func(ma *repository.MockApplicationRepository, mj *repository.MockJobRepository, mca *cache.MockCaching) {
+				gomock.InOrder(
+					ma.EXPECT().GetApplicationByUserAndJob(uint(1), uint(2)).Return(model.Application{}, repository.ErrApplicationNotFound),
+					ma.EXPECT().GetApplicationByUserAndJob(uint(1), uint(2)).Return(model.Application{Model: gorm.Model{ID: 5}, UserID: 1, JobID: 2, Name: "asha", Age: "25", Details: applicationData.Jobs, Stage: model.ApplicationStageApplied, ScreenedAt: time.Now()}, nil),
+				)
+				mca.EXPECT().GetTheCacheData(gomock.Any(), uint(2)).Return(`{"ID":2,"status":"published"}`, nil)
+				ma.EXPECT().CreateApplication(gomock.Any()).Return(model.Application{}, repository.ErrDuplicateApplication)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			ma := repository.NewMockApplicationRepository(mc)
+			mj := repository.NewMockJobRepository(mc)
+			mca := cache.NewMockCaching(mc)
+			s, _ := NewApplicationService(ma, mj, nil, mca)
+			tt.mockResponse(ma, mj, mca)
+			got, err := s.SubmitApplication(context.Background(), 1, applicationData)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Service.SubmitApplication() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr && time.Since(got.ScreenedAt) > time.Minute {
+				t.Errorf("Service.SubmitApplication() screened at = %v", got.ScreenedAt)
+			}
+			got.ScreenedAt = time.Time{}
+			got.StageChangedAt = time.Time{}
+			got.Screening.Criteria = nil
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Service.SubmitApplication() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestService_ViewApplication(t *testing.T) {
+	tests := []struct {
+		name         string
+		userID       uint
+		want         model.Application
+		wantErr      bool
+		errIs        error
+		mockResponse func(ma *repository.MockApplicationRepository)
+	}{
+		{
+			name:    "failure",
+			userID:  1,
+			want:    model.Application{},
+			wantErr: true,
+			mockResponse: func(ma *repository.MockApplicationRepository) {
+				ma.EXPECT().GetApplicationByID(uint(4)).Return(model.Application{}, errors.New("error"))
+			},
+		},
+		{
+			name:    "another user's application",
+			userID:  5,
+			want:    model.Application{},
+			wantErr: true,
+			errIs:   ErrForbidden,
+			mockResponse: func(ma *repository.MockApplicationRepository) {
+				ma.EXPECT().GetApplicationByID(uint(4)).Return(model.Application{Model: gorm.Model{ID: 4}, UserID: 1, JobID: 2}, nil)
+			},
+		},
+		{
+			name:    "success - company owner",
+			userID:  8,
+			want:    model.Application{Model: gorm.Model{ID: 4}, UserID: 1, JobID: 2},
+			wantErr: false,
+			mockResponse: func(ma *repository.MockApplicationRepository) {
+				ma.EXPECT().GetApplicationByID(uint(4)).Return(model.Application{Model: gorm.Model{ID: 4}, UserID: 1, JobID: 2}, nil)
+			},
+		},
+		{
+			name:    "success",
+			userID:  1,
+			want:    model.Application{Model: gorm.Model{ID: 4}, UserID: 1, JobID: 2},
+			wantErr: false,
+			mockResponse: func(ma *repository.MockApplicationRepository) {
+				ma.EXPECT().GetApplicationByID(uint(4)).Return(model.Application{Model: gorm.Model{ID: 4}, UserID: 1, JobID: 2}, nil)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			ma := repository.NewMockApplicationRepository(mc)
+			mj := repository.NewMockJobRepository(mc)
+			mco := repository.NewMockComapnyRepo(mc)
+			mj.EXPECT().GetJobByJobID(uint(2)).Return(model.Job{Cid: 4}, nil).AnyTimes()
+			mco.EXPECT().GetCompanyByID(uint64(4)).Return(model.Company{OwnerID: 8}, nil).AnyTimes()
+			s, _ := NewApplicationService(ma, mj, mco, nil)
+			tt.mockResponse(ma)
+			got, err := s.ViewApplication(tt.userID, 4)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Service.ViewApplication() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.errIs != nil && !errors.Is(err, tt.errIs) {
+				t.Errorf("Service.ViewApplication() error = %v, want %v", err, tt.errIs)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Service.ViewApplication() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestService_ViewApplicationsByJobID(t *testing.T) {
+	tests := []struct {
+		name         string
+		userID       uint
+		want         []model.Application
+		wantErr      bool
+		errIs        error
+		mockResponse func(ma *repository.MockApplicationRepository)
+	}{
+		{
+			name:         "not the company owner",
+			userID:       1,
+			want:         nil,
+			wantErr:      true,
+			errIs:        ErrForbidden,
+			mockResponse: func(ma *repository.MockApplicationRepository) {},
+		},
+		{
+			name:    "failure",
+			userID:  8,
+			want:    nil,
+			wantErr: true,
+			mockResponse: func(ma *repository.MockApplicationRepository) {
+				ma.EXPECT().GetApplicationsByJobID(uint(2)).Return(nil, errors.New("error"))
+			},
+		},
+		{
+			name:    "success",
+			userID:  8,
+			want:    []model.Application{{UserID: 1, JobID: 2}, {UserID: 3, JobID: 2}},
+			wantErr: false,
+			mockResponse: func(ma *repository.MockApplicationRepository) {
+				ma.EXPECT().GetApplicationsByJobID(uint(2)).Return([]model.Application{{UserID: 1, JobID: 2}, {UserID: 3, JobID: 2}}, nil)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			ma := repository.NewMockApplicationRepository(mc)
+			mj := repository.NewMockJobRepository(mc)
+			mco := repository.NewMockComapnyRepo(mc)
+			mj.EXPECT().GetJobByJobID(uint(2)).Return(model.Job{Cid: 4}, nil)
+			mco.EXPECT().GetCompanyByID(uint64(4)).Return(model.Company{OwnerID: 8}, nil)
+			s, _ := NewApplicationService(ma, mj, mco, nil)
+			tt.mockResponse(ma)
+			got, err := s.ViewApplicationsByJobID(tt.userID, 2)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Service.ViewApplicationsByJobID() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.errIs != nil && !errors.Is(err, tt.errIs) {
+				t.Errorf("Service.ViewApplicationsByJobID() error = %v, want %v", err, tt.errIs)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Service.ViewApplicationsByJobID() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestService_ResubmitApplication(t *testing.T) {
+	applicationData := model.NewUserApplication{
+		Name: "asha",
+		Age:  "26",
+		Jid:  2,
+		Jobs: model.Requestfield{NoticePeriod: 30, Experience: 3},
+	}
+	existing := model.Application{Model: gorm.Model{ID: 5}, UserID: 1, JobID: 2, Name: "asha", Age: "25", Stage: model.ApplicationStageScreening}
+	tests := []struct {
+		name         string
+		wantErr      bool
+		mockResponse func(ma *repository.MockApplicationRepository, mj *repository.MockJobRepository, mca *cache.MockCaching)
+	}{
+		{
+			name:    "not applied yet",
+			wantErr: true,
+			mockResponse: func(ma *repository.MockApplicationRepository, mj *repository.MockJobRepository, mca *cache.MockCaching) {
+				ma.EXPECT().GetApplicationByUserAndJob(uint(1), uint(2)).Return(model.Application{}, repository.ErrApplicationNotFound)
+			},
+		},
+		{
+			name:    "application in a final stage",
+			wantErr: true,
+			mockResponse: func(ma *repository.MockApplicationRepository, mj *repository.MockJobRepository, mca *cache.MockCaching) {
+				ma.EXPECT().GetApplicationByUserAndJob(uint(1), uint(2)).Return(model.Application{Model: gorm.Model{ID: 5}, Stage: model.ApplicationStageRejected}, nil)
+			},
+		},
+		{
+			name:    "job closed",
+			wantErr: true,
+			mockResponse: func(ma *repository.MockApplicationRepository, mj *repository.MockJobRepository, mca *cache.MockCaching) {
+				ma.EXPECT().GetApplicationByUserAndJob(uint(1), uint(2)).Return(existing, nil)
+				mca.EXPECT().GetTheCacheData(gomock.Any(), uint(2)).Return(`{"ID":2,"status":"closed"}`, nil)
+			},
+		},
+		{
+			name:    "failure in updating",
+			wantErr: true,
+			mockResponse: func(ma *repository.MockApplicationRepository, mj *repository.MockJobRepository, mca *cache.MockCaching) {
+				ma.EXPECT().GetApplicationByUserAndJob(uint(1), uint(2)).Return(existing, nil)
+				mca.EXPECT().GetTheCacheData(gomock.Any(), uint(2)).Return(`{"ID":2,"status":"published"}`, nil)
+				ma.EXPECT().UpdateApplicationDetails(gomock.Any()).Return(model.Application{}, errors.New("error"))
+			},
+		},
+		{
+			name:    "success",
+			wantErr: false,
+			mockResponse: func(ma *repository.MockApplicationRepository, mj *repository.MockJobRepository, mca *cache.MockCaching) {
+				ma.EXPECT().GetApplicationByUserAndJob(uint(1), uint(2)).Return(existing, nil)
+				mca.EXPECT().GetTheCacheData(gomock.Any(), uint(2)).Return(`{"ID":2,"status":"published"}`, nil)
+				ma.EXPECT().UpdateApplicationDetails(gomock.Cond(func(x any) bool {
+					application := x.(model.Application)
+					return application.ID == 5 && application.Age == "26" && application.Stage == model.ApplicationStageScreening &&
+						reflect.DeepEqual(application.Details, applicationData.Jobs) && !application.ScreenedAt.IsZero()
+				})).DoAndReturn(func(application model.Application) (model.Application, error) {
+					return application, nil
+				})
+				mca.EXPECT().DeleteShortlist(gomock.Any(), uint(2)).Return(nil)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			ma := repository.NewMockApplicationRepository(mc)
+			mj := repository.NewMockJobRepository(mc)
+			mca := cache.NewMockCaching(mc)
+			s, _ := NewApplicationService(ma, mj, nil, mca)
+			tt.mockResponse(ma, mj, mca)
+			_, err := s.ResubmitApplication(context.Background(), 1, applicationData)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Service.ResubmitApplication() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,131 @@
+package service
+
+import (
+	"errors"
+	"job-portal-api/internal/model"
+	"time"
+
+	"github.com/rs/zerolog/log"
+)
+
+// applicationStageTransitions lists the stages an application can move to
+// from each stage. Hired, rejected and withdrawn applications are final, a
+// withdrawn application only comes back when the candidate applies again.
+var applicationStageTransitions = map[string][]string{
+	model.ApplicationStageApplied:   {model.ApplicationStageScreening, model.ApplicationStageInterview, model.ApplicationStageRejected, model.ApplicationStageWithdrawn},
+	model.ApplicationStageScreening: {model.ApplicationStageInterview, model.ApplicationStageRejected, model.ApplicationStageWithdrawn},
+	model.ApplicationStageInterview: {model.ApplicationStageOffer, model.ApplicationStageRejected, model.ApplicationStageWithdrawn},
+	model.ApplicationStageOffer:     {model.ApplicationStageHired, model.ApplicationStageRejected, model.ApplicationStageWithdrawn},
+	model.ApplicationStageHired:     {},
+	model.ApplicationStageRejected:  {},
+	model.ApplicationStageWithdrawn: {},
+}
+
+func canChangeApplicationStage(from string, to string) bool {
+	for _, v := range applicationStageTransitions[from] {
+		if v == to {
+			return true
+		}
+	}
+	return false
+}
+
+// ChangeApplicationStage moves an application through the hiring pipeline,
+// recording changedBy as the user who moved it. Only the company that posted
+// the job moves applications, candidates withdraw through
+// WithdrawApplication so the company is notified.
+func (s *Service) ChangeApplicationStage(aID uint, changedBy uint, stage string) (model.Application, error) {
+
+	if stage == model.ApplicationStageWithdrawn {
+		log.Error().Uint("application id", aID).Msg("application withdrawn through a stage change")
+		return model.Application{}, errors.New("applications are withdrawn by the candidate")
+	}
+
+	application, err := s.applicationRepo.GetApplicationByID(aID)
+	if err != nil {
+		return model.Application{}, err
+	}
+
+	err = s.authorizeRecruiter(changedBy, application.JobID)
+	if err != nil {
+		return model.Application{}, err
+	}
+
+	if !canChangeApplicationStage(application.Stage, stage) {
+		log.Error().Str("from", application.Stage).Str("to", stage).Msg("invalid application stage transition")
+		return model.Application{}, errors.New("invalid application stage transition")
+	}
+
+	application, err = s.applicationRepo.UpdateApplicationStage(application, model.ApplicationStageHistory{
+		ApplicationID: aID,
+		From:          application.Stage,
+		To:            stage,
+		ChangedBy:     changedBy,
+		ChangedAt:     time.Now(),
+	})
+	if err != nil {
+		return model.Application{}, err
+	}
+
+	//the shortlist shows the stage and leaves withdrawn applications out
+	s.invalidateShortlist(application.JobID)
+
+	return application, nil
+}
+
+// ViewApplicationPipeline lists the applications of a job grouped by stage,
+// in pipeline order. Every stage is present, even when it is empty.
+func (s *Service) ViewApplicationPipeline(userID uint, jID uint) (model.ApplicationPipeline, error) {
+
+	err := s.authorizeRecruiter(userID, jID)
+	if err != nil {
+		return model.ApplicationPipeline{}, err
+	}
+
+	applications, err := s.applicationRepo.GetApplicationsByJobID(jID)
+	if err != nil {
+		return model.ApplicationPipeline{}, err
+	}
+
+	byStage := make(map[string][]model.Application, len(model.ApplicationStages))
+	for _, v := range applications {
+		byStage[v.Stage] = append(byStage[v.Stage], v)
+	}
+
+	pipeline := model.ApplicationPipeline{
+		JobID:  jID,
+		Stages: make([]model.ApplicationStageGroup, 0, len(model.ApplicationStages)),
+	}
+	for _, v := range model.ApplicationStages {
+		group := model.ApplicationStageGroup{
+			Stage:        v,
+			Applications: byStage[v],
+		}
+		if group.Applications == nil {
+			group.Applications = []model.Application{}
+		}
+		pipeline.Stages = append(pipeline.Stages, group)
+	}
+
+	return pipeline, nil
+}
+
+func (s *Service) ViewApplicationStageHistory(userID uint, aID uint) ([]model.ApplicationStageHistory, error) {
+
+	application, err := s.applicationRepo.GetApplicationByID(aID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.authorizeApplication(userID, application)
+	if err != nil {
+		return nil, err
+	}
+
+	history, err := s.applicationRepo.GetApplicationStageHistory(aID)
+	if err != nil {
+		return nil, err
+	}
+
+	return history, nil
+}
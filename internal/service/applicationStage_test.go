@@ -0,0 +1,283 @@
+package service
+
+import (
+	"errors"
+	"job-portal-api/internal/cache"
+	"job-portal-api/internal/model"
+	"job-portal-api/internal/repository"
+	"reflect"
+	"testing"
+
+	gomock "go.uber.org/mock/gomock"
+	"gorm.io/gorm"
+)
+
+func TestService_ChangeApplicationStage(t *testing.T) {
+	tests := []struct {
+		name         string
+		stage        string
+		want         model.Application
+		wantErr      bool
+		errIs        error
+		mockResponse func(ma *repository.MockApplicationRepository, mj *repository.MockJobRepository, mco *repository.MockComapnyRepo, mca *cache.MockCaching)
+	}{
+		{
+			name:    "application not found",
+			stage:   model.ApplicationStageScreening,
+			want:    model.Application{},
+			wantErr: true,
+			mockResponse: func(ma *repository.MockApplicationRepository, mj *repository.MockJobRepository, mco *repository.MockComapnyRepo, mca *cache.MockCaching) {
+				ma.EXPECT().GetApplicationByID(uint(1)).Return(model.Application{}, errors.New("error"))
+			},
+		},
+		{
+			name:    "not the company owner",
+			stage:   model.ApplicationStageHired,
+			want:    model.Application{},
+			wantErr: true,
+			errIs:   ErrForbidden,
+			mockResponse: func(ma *repository.MockApplicationRepository, mj *repository.MockJobRepository, mco *repository.MockComapnyRepo, mca *cache.MockCaching) {
+				ma.EXPECT().GetApplicationByID(uint(1)).Return(model.Application{Model: gorm.Model{ID: 1}, JobID: 2, Stage: model.ApplicationStageOffer}, nil)
+				mj.EXPECT().GetJobByJobID(uint(2)).Return(model.Job{Cid: 4}, nil)
+				mco.EXPECT().GetCompanyByID(uint64(4)).Return(model.Company{OwnerID: 8}, nil)
+			},
+		},
+		{
+			name:    "withdrawn through a stage change",
+			stage:   model.ApplicationStageWithdrawn,
+			want:    model.Application{},
+			wantErr: true,
+			mockResponse: func(ma *repository.MockApplicationRepository, mj *repository.MockJobRepository, mco *repository.MockComapnyRepo, mca *cache.MockCaching) {
+			},
+		},
+		{
+			name:    "invalid transition",
+			stage:   model.ApplicationStageHired,
+			want:    model.Application{},
+			wantErr: true,
+			mockResponse: func(ma *repository.MockApplicationRepository, mj *repository.MockJobRepository, mco *repository.MockComapnyRepo, mca *cache.MockCaching) {
+				ma.EXPECT().GetApplicationByID(uint(1)).Return(model.Application{Model: gorm.Model{ID: 1}, JobID: 2, Stage: model.ApplicationStageApplied}, nil)
+				mj.EXPECT().GetJobByJobID(uint(2)).Return(model.Job{Cid: 4}, nil)
+				mco.EXPECT().GetCompanyByID(uint64(4)).Return(model.Company{OwnerID: 7}, nil)
+			},
+		},
+		{
+			name:    "final stage",
+			stage:   model.ApplicationStageInterview,
+			want:    model.Application{},
+			wantErr: true,
+			mockResponse: func(ma *repository.MockApplicationRepository, mj *repository.MockJobRepository, mco *repository.MockComapnyRepo, mca *cache.MockCaching) {
+				ma.EXPECT().GetApplicationByID(uint(1)).Return(model.Application{Model: gorm.Model{ID: 1}, JobID: 2, Stage: model.ApplicationStageWithdrawn}, nil)
+				mj.EXPECT().GetJobByJobID(uint(2)).Return(model.Job{Cid: 4}, nil)
+				mco.EXPECT().GetCompanyByID(uint64(4)).Return(model.Company{OwnerID: 7}, nil)
+			},
+		},
+		{
+			name:    "failure in updating",
+			stage:   model.ApplicationStageScreening,
+			want:    model.Application{},
+			wantErr: true,
+			mockResponse: func(ma *repository.MockApplicationRepository, mj *repository.MockJobRepository, mco *repository.MockComapnyRepo, mca *cache.MockCaching) {
+				ma.EXPECT().GetApplicationByID(uint(1)).Return(model.Application{Model: gorm.Model{ID: 1}, JobID: 2, Stage: model.ApplicationStageApplied}, nil)
+				mj.EXPECT().GetJobByJobID(uint(2)).Return(model.Job{Cid: 4}, nil)
+				mco.EXPECT().GetCompanyByID(uint64(4)).Return(model.Company{OwnerID: 7}, nil)
+				ma.EXPECT().UpdateApplicationStage(gomock.Any(), gomock.Any()).Return(model.Application{}, errors.New("error"))
+			},
+		},
+		{
+			name:    "success",
+			stage:   model.ApplicationStageOffer,
+			want:    model.Application{Model: gorm.Model{ID: 1}, JobID: 2, Stage: model.ApplicationStageOffer},
+			wantErr: false,
+			mockResponse: func(ma *repository.MockApplicationRepository, mj *repository.MockJobRepository, mco *repository.MockComapnyRepo, mca *cache.MockCaching) {
+				application := model.Application{Model: gorm.Model{ID: 1}, JobID: 2, Stage: model.ApplicationStageInterview}
+				ma.EXPECT().GetApplicationByID(uint(1)).Return(application, nil)
+				mj.EXPECT().GetJobByJobID(uint(2)).Return(model.Job{Cid: 4}, nil)
+				mco.EXPECT().GetCompanyByID(uint64(4)).Return(model.Company{OwnerID: 7}, nil)
+				ma.EXPECT().UpdateApplicationStage(application, gomock.Cond(func(x any) bool {
+					change := x.(model.ApplicationStageHistory)
+					return change.ApplicationID == 1 && change.From == model.ApplicationStageInterview &&
+						change.To == model.ApplicationStageOffer && change.ChangedBy == 7 && !change.ChangedAt.IsZero()
+				})).Return(model.Application{Model: gorm.Model{ID: 1}, JobID: 2, Stage: model.ApplicationStageOffer}, nil)
+				mca.EXPECT().DeleteShortlist(gomock.Any(), uint(2)).Return(nil)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			ma := repository.NewMockApplicationRepository(mc)
+			mj := repository.NewMockJobRepository(mc)
+			mco := repository.NewMockComapnyRepo(mc)
+			mca := cache.NewMockCaching(mc)
+			s, _ := NewApplicationService(ma, mj, mco, mca)
+			tt.mockResponse(ma, mj, mco, mca)
+			got, err := s.ChangeApplicationStage(1, 7, tt.stage)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Service.ChangeApplicationStage() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.errIs != nil && !errors.Is(err, tt.errIs) {
+				t.Errorf("Service.ChangeApplicationStage() error = %v, want %v", err, tt.errIs)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Service.ChangeApplicationStage() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestService_ViewApplicationPipeline(t *testing.T) {
+	tests := []struct {
+		name         string
+		want         model.ApplicationPipeline
+		wantErr      bool
+		errIs        error
+		mockResponse func(ma *repository.MockApplicationRepository, mj *repository.MockJobRepository, mco *repository.MockComapnyRepo)
+	}{
+		{
+			name:    "not the company owner",
+			want:    model.ApplicationPipeline{},
+			wantErr: true,
+			errIs:   ErrForbidden,
+			mockResponse: func(ma *repository.MockApplicationRepository, mj *repository.MockJobRepository, mco *repository.MockComapnyRepo) {
+				mj.EXPECT().GetJobByJobID(uint(2)).Return(model.Job{Cid: 4}, nil)
+				mco.EXPECT().GetCompanyByID(uint64(4)).Return(model.Company{OwnerID: 3}, nil)
+			},
+		},
+		{
+			name:    "failure",
+			want:    model.ApplicationPipeline{},
+			wantErr: true,
+			mockResponse: func(ma *repository.MockApplicationRepository, mj *repository.MockJobRepository, mco *repository.MockComapnyRepo) {
+				mj.EXPECT().GetJobByJobID(uint(2)).Return(model.Job{Cid: 4}, nil)
+				mco.EXPECT().GetCompanyByID(uint64(4)).Return(model.Company{OwnerID: 8}, nil)
+				ma.EXPECT().GetApplicationsByJobID(uint(2)).Return(nil, errors.New("error"))
+			},
+		},
+		{
+			name: "success",
+			want: model.ApplicationPipeline{
+				JobID: 2,
+				Stages: []model.ApplicationStageGroup{
+					{Stage: model.ApplicationStageApplied, Applications: []model.Application{{UserID: 1, Stage: model.ApplicationStageApplied}, {UserID: 3, Stage: model.ApplicationStageApplied}}},
+					{Stage: model.ApplicationStageScreening, Applications: []model.Application{}},
+					{Stage: model.ApplicationStageInterview, Applications: []model.Application{{UserID: 2, Stage: model.ApplicationStageInterview}}},
+					{Stage: model.ApplicationStageOffer, Applications: []model.Application{}},
+					{Stage: model.ApplicationStageHired, Applications: []model.Application{}},
+					{Stage: model.ApplicationStageRejected, Applications: []model.Application{}},
+					{Stage: model.ApplicationStageWithdrawn, Applications: []model.Application{}},
+				},
+			},
+			wantErr: false,
+			mockResponse: func(ma *repository.MockApplicationRepository, mj *repository.MockJobRepository, mco *repository.MockComapnyRepo) {
+				mj.EXPECT().GetJobByJobID(uint(2)).Return(model.Job{Cid: 4}, nil)
+				mco.EXPECT().GetCompanyByID(uint64(4)).Return(model.Company{OwnerID: 8}, nil)
+				ma.EXPECT().GetApplicationsByJobID(uint(2)).Return([]model.Application{
+					{UserID: 1, Stage: model.ApplicationStageApplied},
+					{UserID: 2, Stage: model.ApplicationStageInterview},
+					{UserID: 3, Stage: model.ApplicationStageApplied},
+				}, nil)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			ma := repository.NewMockApplicationRepository(mc)
+			mj := repository.NewMockJobRepository(mc)
+			mco := repository.NewMockComapnyRepo(mc)
+			s, _ := NewApplicationService(ma, mj, mco, nil)
+			tt.mockResponse(ma, mj, mco)
+			got, err := s.ViewApplicationPipeline(8, 2)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Service.ViewApplicationPipeline() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.errIs != nil && !errors.Is(err, tt.errIs) {
+				t.Errorf("Service.ViewApplicationPipeline() error = %v, want %v", err, tt.errIs)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Service.ViewApplicationPipeline() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestService_ViewApplicationStageHistory(t *testing.T) {
+	tests := []struct {
+		name         string
+		want         []model.ApplicationStageHistory
+		wantErr      bool
+		errIs        error
+		mockResponse func(ma *repository.MockApplicationRepository, mj *repository.MockJobRepository, mco *repository.MockComapnyRepo)
+	}{
+		{
+			name:    "application not found",
+			want:    nil,
+			wantErr: true,
+			mockResponse: func(ma *repository.MockApplicationRepository, mj *repository.MockJobRepository, mco *repository.MockComapnyRepo) {
+				ma.EXPECT().GetApplicationByID(uint(1)).Return(model.Application{}, errors.New("error"))
+			},
+		},
+		{
+			name:    "another user's application",
+			want:    nil,
+			wantErr: true,
+			errIs:   ErrForbidden,
+			mockResponse: func(ma *repository.MockApplicationRepository, mj *repository.MockJobRepository, mco *repository.MockComapnyRepo) {
+				ma.EXPECT().GetApplicationByID(uint(1)).Return(model.Application{Model: gorm.Model{ID: 1}, UserID: 5, JobID: 2}, nil)
+				mj.EXPECT().GetJobByJobID(uint(2)).Return(model.Job{Cid: 4}, nil)
+				mco.EXPECT().GetCompanyByID(uint64(4)).Return(model.Company{OwnerID: 8}, nil)
+			},
+		},
+		{
+			name:    "failure",
+			want:    nil,
+			wantErr: true,
+			mockResponse: func(ma *repository.MockApplicationRepository, mj *repository.MockJobRepository, mco *repository.MockComapnyRepo) {
+				ma.EXPECT().GetApplicationByID(uint(1)).Return(model.Application{Model: gorm.Model{ID: 1}, UserID: 3}, nil)
+				ma.EXPECT().GetApplicationStageHistory(uint(1)).Return(nil, errors.New("error"))
+			},
+		},
+		{
+			name: "success",
+			want: []model.ApplicationStageHistory{
+				{ApplicationID: 1, To: model.ApplicationStageApplied, ChangedBy: 3},
+				{ApplicationID: 1, From: model.ApplicationStageApplied, To: model.ApplicationStageScreening, ChangedBy: 7},
+			},
+			wantErr: false,
+			mockResponse: func(ma *repository.MockApplicationRepository, mj *repository.MockJobRepository, mco *repository.MockComapnyRepo) {
+				ma.EXPECT().GetApplicationByID(uint(1)).Return(model.Application{Model: gorm.Model{ID: 1}, UserID: 3}, nil)
+				ma.EXPECT().GetApplicationStageHistory(uint(1)).Return([]model.ApplicationStageHistory{
+					{ApplicationID: 1, To: model.ApplicationStageApplied, ChangedBy: 3},
+					{ApplicationID: 1, From: model.ApplicationStageApplied, To: model.ApplicationStageScreening, ChangedBy: 7},
+				}, nil)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			ma := repository.NewMockApplicationRepository(mc)
+			mj := repository.NewMockJobRepository(mc)
+			mco := repository.NewMockComapnyRepo(mc)
+			s, _ := NewApplicationService(ma, mj, mco, nil)
+			tt.mockResponse(ma, mj, mco)
+			got, err := s.ViewApplicationStageHistory(3, 1)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Service.ViewApplicationStageHistory() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.errIs != nil && !errors.Is(err, tt.errIs) {
+				t.Errorf("Service.ViewApplicationStageHistory() error = %v, want %v", err, tt.errIs)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Service.ViewApplicationStageHistory() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,199 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"job-portal-api/internal/model"
+	"job-portal-api/internal/repository"
+	"job-portal-api/internal/storage"
+	"net/http"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/rs/zerolog/log"
+)
+
+var (
+	ErrAttachmentTooLarge    = errors.New("attachment is too large")
+	ErrAttachmentUnsupported = errors.New("attachment type is not supported")
+)
+
+// attachmentTypes maps the accepted file extensions to the content type
+// they are stored with.
+var attachmentTypes = map[string]string{
+	".pdf":  "application/pdf",
+	".docx": "application/vnd.openxmlformats-officedocument.wordprocessingml.document",
+	".txt":  "text/plain",
+}
+
+// sniffedAttachmentTypes is what http.DetectContentType reports for each
+// accepted extension, a docx file being a zip archive.
+var sniffedAttachmentTypes = map[string]string{
+	".pdf":  "application/pdf",
+	".docx": "application/zip",
+	".txt":  "text/plain",
+}
+
+//go:generate mockgen -source=attachmentService.go -destination=attachmentService_mock.go -package=service
+type AttachmentService interface {
+	UploadAttachment(ctx context.Context, userID uint, aID uint, kind string, fileName string, size int64, data io.Reader) (model.Attachment, error)
+	ViewAttachments(userID uint, aID uint) ([]model.Attachment, error)
+	DownloadAttachment(ctx context.Context, userID uint, atID uint) (model.Attachment, io.ReadCloser, error)
+}
+
+func NewAttachmentService(attachmentRepo repository.AttachmentRepository, applicationRepo repository.ApplicationRepository, jobRepo repository.JobRepository, companyRepo repository.ComapnyRepo, store storage.Storage, maxAttachmentSize int64) (AttachmentService, error) {
+	if attachmentRepo == nil || store == nil {
+		log.Info().Msg("attachment repository and storage cannot be nil")
+		return nil, errors.New("attachment repository and storage cannot be nil")
+	}
+	return &Service{
+		attachmentRepo:    attachmentRepo,
+		applicationRepo:   applicationRepo,
+		jobRepo:           jobRepo,
+		comapnayRepo:      companyRepo,
+		store:             store,
+		maxAttachmentSize: maxAttachmentSize,
+	}, nil
+}
+
+// UploadAttachment stores a file with the candidate's own application.
+func (s *Service) UploadAttachment(ctx context.Context, userID uint, aID uint, kind string, fileName string, size int64, data io.Reader) (model.Attachment, error) {
+
+	application, err := s.applicationRepo.GetApplicationByID(aID)
+	if err != nil {
+		return model.Attachment{}, err
+	}
+	if application.UserID != userID {
+		log.Error().Uint("application id", aID).Uint("user id", userID).Msg("attachment upload by another user")
+		return model.Attachment{}, ErrForbidden
+	}
+
+	if size <= 0 || size > s.maxAttachmentSize {
+		log.Error().Int64("size", size).Int64("max size", s.maxAttachmentSize).Msg("invalid attachment size")
+		return model.Attachment{}, ErrAttachmentTooLarge
+	}
+
+	head := make([]byte, 512)
+	n, err := io.ReadFull(data, head)
+	if err != nil && !errors.Is(err, io.ErrUnexpectedEOF) {
+		log.Error().Err(err).Msg("error in reading attachment")
+		return model.Attachment{}, errors.New("could not read the attachment")
+	}
+	head = head[:n]
+
+	fileName, contentType, err := attachmentType(fileName, head)
+	if err != nil {
+		return model.Attachment{}, err
+	}
+
+	key, err := attachmentKey(aID)
+	if err != nil {
+		return model.Attachment{}, err
+	}
+
+	err = s.store.Put(ctx, key, io.MultiReader(bytes.NewReader(head), data), size, contentType)
+	if err != nil {
+		return model.Attachment{}, err
+	}
+
+	attachment, err := s.attachmentRepo.CreateAttachment(model.Attachment{
+		ApplicationID: aID,
+		Kind:          kind,
+		FileName:      fileName,
+		ContentType:   contentType,
+		Size:          size,
+		StorageKey:    key,
+		UploadedBy:    userID,
+		CreatedAt:     time.Now(),
+	})
+	if err != nil {
+		//the file is of no use without its record
+		delErr := s.store.Delete(context.Background(), key)
+		if delErr != nil {
+			log.Error().Err(delErr).Str("key", key).Msg("error in removing orphaned attachment")
+		}
+		return model.Attachment{}, err
+	}
+
+	return attachment, nil
+}
+
+func (s *Service) ViewAttachments(userID uint, aID uint) ([]model.Attachment, error) {
+
+	application, err := s.applicationRepo.GetApplicationByID(aID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.authorizeApplication(userID, application)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.attachmentRepo.GetAttachmentsByApplicationID(aID)
+}
+
+// DownloadAttachment opens the stored file for the candidate or the
+// company that posted the job. The caller closes the returned reader.
+func (s *Service) DownloadAttachment(ctx context.Context, userID uint, atID uint) (model.Attachment, io.ReadCloser, error) {
+
+	attachment, err := s.attachmentRepo.GetAttachmentByID(atID)
+	if err != nil {
+		return model.Attachment{}, nil, err
+	}
+
+	application, err := s.applicationRepo.GetApplicationByID(attachment.ApplicationID)
+	if err != nil {
+		return model.Attachment{}, nil, err
+	}
+
+	err = s.authorizeApplication(userID, application)
+	if err != nil {
+		return model.Attachment{}, nil, err
+	}
+
+	data, err := s.store.Get(ctx, attachment.StorageKey)
+	if err != nil {
+		return model.Attachment{}, nil, err
+	}
+
+	return attachment, data, nil
+}
+
+// attachmentType strips the directories off an uploaded file name and
+// returns it with the content type it is stored with. The content has to
+// match the file extension, a renamed executable is not accepted as a pdf.
+func attachmentType(fileName string, head []byte) (string, string, error) {
+	fileName = filepath.Base(strings.ReplaceAll(fileName, `\`, "/"))
+	ext := strings.ToLower(filepath.Ext(fileName))
+	contentType, ok := attachmentTypes[ext]
+	if !ok {
+		log.Error().Str("file name", fileName).Msg("unsupported attachment extension")
+		return "", "", ErrAttachmentUnsupported
+	}
+
+	if sniffed := http.DetectContentType(head); !strings.HasPrefix(sniffed, sniffedAttachmentTypes[ext]) {
+		log.Error().Str("file name", fileName).Str("content type", sniffed).Msg("attachment content does not match its extension")
+		return "", "", ErrAttachmentUnsupported
+	}
+
+	return fileName, contentType, nil
+}
+
+// attachmentKey names the stored file after the application and a random
+// id, the uploaded file name is only kept in the database.
+func attachmentKey(aID uint) (string, error) {
+	id := make([]byte, 16)
+	_, err := rand.Read(id)
+	if err != nil {
+		log.Error().Err(err).Msg("error in generating attachment key")
+		return "", errors.New("could not store the attachment")
+	}
+	return fmt.Sprintf("applications/%d/%s", aID, hex.EncodeToString(id)), nil
+}
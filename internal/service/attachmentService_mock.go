@@ -0,0 +1,87 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: attachmentService.go
+//
+// Generated by this command:
+//
+//	mockgen -source=attachmentService.go -destination=attachmentService_mock.go -package=service
+//
+// Package service is a generated GoMock package.
+package service
+
+import (
+	context "context"
+	io "io"
+	model "job-portal-api/internal/model"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockAttachmentService is a mock of AttachmentService interface.
+type MockAttachmentService struct {
+	ctrl     *gomock.Controller
+	recorder *MockAttachmentServiceMockRecorder
+}
+
+// MockAttachmentServiceMockRecorder is the mock recorder for MockAttachmentService.
+type MockAttachmentServiceMockRecorder struct {
+	mock *MockAttachmentService
+}
+
+// NewMockAttachmentService creates a new mock instance.
+func NewMockAttachmentService(ctrl *gomock.Controller) *MockAttachmentService {
+	mock := &MockAttachmentService{ctrl: ctrl}
+	mock.recorder = &MockAttachmentServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAttachmentService) EXPECT() *MockAttachmentServiceMockRecorder {
+	return m.recorder
+}
+
+// DownloadAttachment mocks base method.
+func (m *MockAttachmentService) DownloadAttachment(ctx context.Context, userID, atID uint) (model.Attachment, io.ReadCloser, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DownloadAttachment", ctx, userID, atID)
+	ret0, _ := ret[0].(model.Attachment)
+	ret1, _ := ret[1].(io.ReadCloser)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// DownloadAttachment indicates an expected call of DownloadAttachment.
+func (mr *MockAttachmentServiceMockRecorder) DownloadAttachment(ctx, userID, atID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DownloadAttachment", reflect.TypeOf((*MockAttachmentService)(nil).DownloadAttachment), ctx, userID, atID)
+}
+
+// UploadAttachment mocks base method.
+func (m *MockAttachmentService) UploadAttachment(ctx context.Context, userID, aID uint, kind, fileName string, size int64, data io.Reader) (model.Attachment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UploadAttachment", ctx, userID, aID, kind, fileName, size, data)
+	ret0, _ := ret[0].(model.Attachment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UploadAttachment indicates an expected call of UploadAttachment.
+func (mr *MockAttachmentServiceMockRecorder) UploadAttachment(ctx, userID, aID, kind, fileName, size, data any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UploadAttachment", reflect.TypeOf((*MockAttachmentService)(nil).UploadAttachment), ctx, userID, aID, kind, fileName, size, data)
+}
+
+// ViewAttachments mocks base method.
+func (m *MockAttachmentService) ViewAttachments(userID, aID uint) ([]model.Attachment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ViewAttachments", userID, aID)
+	ret0, _ := ret[0].([]model.Attachment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ViewAttachments indicates an expected call of ViewAttachments.
+func (mr *MockAttachmentServiceMockRecorder) ViewAttachments(userID, aID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ViewAttachments", reflect.TypeOf((*MockAttachmentService)(nil).ViewAttachments), userID, aID)
+}
@@ -0,0 +1,248 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"io"
+	"job-portal-api/internal/model"
+	"job-portal-api/internal/repository"
+	"job-portal-api/internal/storage"
+	"reflect"
+	"strings"
+	"testing"
+
+	gomock "go.uber.org/mock/gomock"
+	"gorm.io/gorm"
+)
+
+func TestService_UploadAttachment(t *testing.T) {
+	pdf := "%PDF-1.4\n1 0 obj\n<< >>\nendobj\n"
+	application := model.Application{Model: gorm.Model{ID: 3}, UserID: 1, JobID: 2}
+	tests := []struct {
+		name         string
+		userID       uint
+		fileName     string
+		content      string
+		size         int64
+		want         model.Attachment
+		wantErr      bool
+		errIs        error
+		mockResponse func(mat *repository.MockAttachmentRepository, ma *repository.MockApplicationRepository, ms *storage.MockStorage)
+	}{
+		{
+			name:     "application not found",
+			userID:   1,
+			fileName: "resume.pdf",
+			content:  pdf,
+			size:     int64(len(pdf)),
+			wantErr:  true,
+			mockResponse: func(mat *repository.MockAttachmentRepository, ma *repository.MockApplicationRepository, ms *storage.MockStorage) {
+				ma.EXPECT().GetApplicationByID(uint(3)).Return(model.Application{}, errors.New("error"))
+			},
+		},
+		{
+			name:     "another user's application",
+			userID:   9,
+			fileName: "resume.pdf",
+			content:  pdf,
+			size:     int64(len(pdf)),
+			wantErr:  true,
+			errIs:    ErrForbidden,
+			mockResponse: func(mat *repository.MockAttachmentRepository, ma *repository.MockApplicationRepository, ms *storage.MockStorage) {
+				ma.EXPECT().GetApplicationByID(uint(3)).Return(application, nil)
+			},
+		},
+		{
+			name:     "too large",
+			userID:   1,
+			fileName: "resume.pdf",
+			content:  pdf,
+			size:     1025,
+			wantErr:  true,
+			errIs:    ErrAttachmentTooLarge,
+			mockResponse: func(mat *repository.MockAttachmentRepository, ma *repository.MockApplicationRepository, ms *storage.MockStorage) {
+				ma.EXPECT().GetApplicationByID(uint(3)).Return(application, nil)
+			},
+		},
+		{
+			name:     "unsupported extension",
+			userID:   1,
+			fileName: "resume.exe",
+			content:  pdf,
+			size:     int64(len(pdf)),
+			wantErr:  true,
+			errIs:    ErrAttachmentUnsupported,
+			mockResponse: func(mat *repository.MockAttachmentRepository, ma *repository.MockApplicationRepository, ms *storage.MockStorage) {
+				ma.EXPECT().GetApplicationByID(uint(3)).Return(application, nil)
+			},
+		},
+		{
+			name:     "content does not match the extension",
+			userID:   1,
+			fileName: "resume.pdf",
+			content:  "MZ\x90\x00\x03\x00\x00\x00\x04\x00\x00\x00\xff\xff",
+			size:     14,
+			wantErr:  true,
+			errIs:    ErrAttachmentUnsupported,
+			mockResponse: func(mat *repository.MockAttachmentRepository, ma *repository.MockApplicationRepository, ms *storage.MockStorage) {
+				ma.EXPECT().GetApplicationByID(uint(3)).Return(application, nil)
+			},
+		},
+		{
+			name:     "failure in saving removes the stored file",
+			userID:   1,
+			fileName: "resume.pdf",
+			content:  pdf,
+			size:     int64(len(pdf)),
+			wantErr:  true,
+			mockResponse: func(mat *repository.MockAttachmentRepository, ma *repository.MockApplicationRepository, ms *storage.MockStorage) {
+				ma.EXPECT().GetApplicationByID(uint(3)).Return(application, nil)
+				ms.EXPECT().Put(gomock.Any(), gomock.Any(), gomock.Any(), int64(len(pdf)), "application/pdf").Return(nil)
+				mat.EXPECT().CreateAttachment(gomock.Any()).Return(model.Attachment{}, errors.New("error"))
+				ms.EXPECT().Delete(gomock.Any(), gomock.Any()).Return(nil)
+			},
+		},
+		{
+			name:     "success",
+			userID:   1,
+			fileName: `C:\docs\resume.pdf`,
+			content:  pdf,
+			size:     int64(len(pdf)),
+			want:     model.Attachment{ID: 7, ApplicationID: 3, Kind: model.AttachmentKindResume, FileName: "resume.pdf", ContentType: "application/pdf", Size: int64(len(pdf)), UploadedBy: 1},
+			mockResponse: func(mat *repository.MockAttachmentRepository, ma *repository.MockApplicationRepository, ms *storage.MockStorage) {
+				ma.EXPECT().GetApplicationByID(uint(3)).Return(application, nil)
+				ms.EXPECT().Put(gomock.Any(), gomock.Cond(func(x any) bool { return strings.HasPrefix(x.(string), "applications/3/") }), gomock.Any(), int64(len(pdf)), "application/pdf").
+					DoAndReturn(func(ctx context.Context, key string, data io.Reader, size int64, contentType string) error {
+						stored, _ := io.ReadAll(data)
+						if string(stored) != pdf {
+							t.Errorf("stored %q, want %q", stored, pdf)
+						}
+						return nil
+					})
+				mat.EXPECT().CreateAttachment(gomock.Any()).DoAndReturn(func(attachment model.Attachment) (model.Attachment, error) {
+					attachment.ID = 7
+					return attachment, nil
+				})
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			mat := repository.NewMockAttachmentRepository(mc)
+			ma := repository.NewMockApplicationRepository(mc)
+			ms := storage.NewMockStorage(mc)
+			tt.mockResponse(mat, ma, ms)
+			s, _ := NewAttachmentService(mat, ma, nil, nil, ms, 1024)
+			got, err := s.UploadAttachment(context.Background(), tt.userID, 3, model.AttachmentKindResume, tt.fileName, tt.size, strings.NewReader(tt.content))
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Service.UploadAttachment() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.errIs != nil && !errors.Is(err, tt.errIs) {
+				t.Errorf("Service.UploadAttachment() error = %v, want %v", err, tt.errIs)
+			}
+			if !tt.wantErr && !strings.HasPrefix(got.StorageKey, "applications/3/") {
+				t.Errorf("Service.UploadAttachment() storage key = %v", got.StorageKey)
+			}
+			got.StorageKey = ""
+			got.CreatedAt = tt.want.CreatedAt
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Service.UploadAttachment() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestService_DownloadAttachment(t *testing.T) {
+	attachment := model.Attachment{ID: 7, ApplicationID: 3, FileName: "resume.pdf", StorageKey: "applications/3/abc"}
+	application := model.Application{Model: gorm.Model{ID: 3}, UserID: 1, JobID: 2}
+	tests := []struct {
+		name         string
+		userID       uint
+		wantErr      bool
+		errIs        error
+		mockResponse func(mat *repository.MockAttachmentRepository, ma *repository.MockApplicationRepository, mj *repository.MockJobRepository, mcr *repository.MockComapnyRepo, ms *storage.MockStorage)
+	}{
+		{
+			name:    "attachment not found",
+			userID:  1,
+			wantErr: true,
+			mockResponse: func(mat *repository.MockAttachmentRepository, ma *repository.MockApplicationRepository, mj *repository.MockJobRepository, mcr *repository.MockComapnyRepo, ms *storage.MockStorage) {
+				mat.EXPECT().GetAttachmentByID(uint(7)).Return(model.Attachment{}, errors.New("error"))
+			},
+		},
+		{
+			name:    "candidate",
+			userID:  1,
+			wantErr: false,
+			mockResponse: func(mat *repository.MockAttachmentRepository, ma *repository.MockApplicationRepository, mj *repository.MockJobRepository, mcr *repository.MockComapnyRepo, ms *storage.MockStorage) {
+				mat.EXPECT().GetAttachmentByID(uint(7)).Return(attachment, nil)
+				ma.EXPECT().GetApplicationByID(uint(3)).Return(application, nil)
+				ms.EXPECT().Get(gomock.Any(), "applications/3/abc").Return(io.NopCloser(strings.NewReader("%PDF")), nil)
+			},
+		},
+		{
+			name:    "hiring company",
+			userID:  5,
+			wantErr: false,
+			mockResponse: func(mat *repository.MockAttachmentRepository, ma *repository.MockApplicationRepository, mj *repository.MockJobRepository, mcr *repository.MockComapnyRepo, ms *storage.MockStorage) {
+				mat.EXPECT().GetAttachmentByID(uint(7)).Return(attachment, nil)
+				ma.EXPECT().GetApplicationByID(uint(3)).Return(application, nil)
+				mj.EXPECT().GetJobByJobID(uint(2)).Return(model.Job{Model: gorm.Model{ID: 2}, Cid: 4}, nil)
+				mcr.EXPECT().GetCompanyByID(uint64(4)).Return(model.Company{Model: gorm.Model{ID: 4}, OwnerID: 5}, nil)
+				ms.EXPECT().Get(gomock.Any(), "applications/3/abc").Return(io.NopCloser(strings.NewReader("%PDF")), nil)
+			},
+		},
+		{
+			name:    "another user",
+			userID:  9,
+			wantErr: true,
+			errIs:   ErrForbidden,
+			mockResponse: func(mat *repository.MockAttachmentRepository, ma *repository.MockApplicationRepository, mj *repository.MockJobRepository, mcr *repository.MockComapnyRepo, ms *storage.MockStorage) {
+				mat.EXPECT().GetAttachmentByID(uint(7)).Return(attachment, nil)
+				ma.EXPECT().GetApplicationByID(uint(3)).Return(application, nil)
+				mj.EXPECT().GetJobByJobID(uint(2)).Return(model.Job{Model: gorm.Model{ID: 2}, Cid: 4}, nil)
+				mcr.EXPECT().GetCompanyByID(uint64(4)).Return(model.Company{Model: gorm.Model{ID: 4}, OwnerID: 5}, nil)
+			},
+		},
+		{
+			name:    "stored file missing",
+			userID:  1,
+			wantErr: true,
+			errIs:   storage.ErrNotFound,
+			mockResponse: func(mat *repository.MockAttachmentRepository, ma *repository.MockApplicationRepository, mj *repository.MockJobRepository, mcr *repository.MockComapnyRepo, ms *storage.MockStorage) {
+				mat.EXPECT().GetAttachmentByID(uint(7)).Return(attachment, nil)
+				ma.EXPECT().GetApplicationByID(uint(3)).Return(application, nil)
+				ms.EXPECT().Get(gomock.Any(), "applications/3/abc").Return(nil, storage.ErrNotFound)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			mat := repository.NewMockAttachmentRepository(mc)
+			ma := repository.NewMockApplicationRepository(mc)
+			mj := repository.NewMockJobRepository(mc)
+			mcr := repository.NewMockComapnyRepo(mc)
+			ms := storage.NewMockStorage(mc)
+			tt.mockResponse(mat, ma, mj, mcr, ms)
+			s, _ := NewAttachmentService(mat, ma, mj, mcr, ms, 1024)
+			got, data, err := s.DownloadAttachment(context.Background(), tt.userID, 7)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Service.DownloadAttachment() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.errIs != nil && !errors.Is(err, tt.errIs) {
+				t.Errorf("Service.DownloadAttachment() error = %v, want %v", err, tt.errIs)
+			}
+			if tt.wantErr {
+				return
+			}
+			defer data.Close()
+			if !reflect.DeepEqual(got, attachment) {
+				t.Errorf("Service.DownloadAttachment() = %v, want %v", got, attachment)
+			}
+		})
+	}
+}
@@ -0,0 +1,50 @@
+package service
+
+import (
+	"errors"
+	"job-portal-api/internal/model"
+
+	"github.com/rs/zerolog/log"
+)
+
+// ErrForbidden is returned when the user is logged in but does not act for
+// the company, or the candidate, the request is about.
+var ErrForbidden = errors.New("not allowed to access this resource")
+
+// authorizeApplication allows the candidate who applied and the owner of
+// the company that posted the job.
+func (s *Service) authorizeApplication(userID uint, application model.Application) error {
+	if application.UserID == userID {
+		return nil
+	}
+	return s.authorizeRecruiter(userID, application.JobID)
+}
+
+// authorizeRecruiter allows only the owner of the company that posted the
+// job.
+func (s *Service) authorizeRecruiter(userID uint, jID uint) error {
+
+	jobData, err := s.jobRepo.GetJobByJobID(jID)
+	if err != nil {
+		return err
+	}
+
+	return s.authorizeCompany(userID, jobData.Cid)
+}
+
+// authorizeCompany allows only the owner of the company. Companies without
+// an owner are not managed by anyone until one is assigned.
+func (s *Service) authorizeCompany(userID uint, cID uint) error {
+
+	company, err := s.comapnayRepo.GetCompanyByID(uint64(cID))
+	if err != nil {
+		return err
+	}
+
+	if company.OwnerID == 0 || company.OwnerID != userID {
+		log.Error().Uint("company id", cID).Uint("user id", userID).Msg("user does not act for the company")
+		return ErrForbidden
+	}
+
+	return nil
+}
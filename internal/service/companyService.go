@@ -10,27 +10,30 @@ import (
 
 //go:generate mockgen -source=companyService.go -destination=companyService_mock.go -package=service
 type ComapnyService interface {
-	AddingCompany(company model.AddCompany) (model.Company, error)
+	AddingCompany(company model.AddCompany, ownerID uint) (model.Company, error)
 	ViewCompanyById(Id uint64) (model.Company, error)
 	ViewAllCompanies() ([]model.Company, error)
+	ViewCompanyNotifications(userID uint, cID uint, offset int, limit int) (model.CompanyNotificationPage, error)
 }
 
-func NewCompanyService(comapnyRepo repository.ComapnyRepo) (ComapnyService, error) {
+func NewCompanyService(comapnyRepo repository.ComapnyRepo, notificationRepo repository.NotificationRepository) (ComapnyService, error) {
 	if comapnyRepo == nil {
 		log.Info().Msg("comapny service cannot be nil")
 		return nil, errors.New("company service cannot be nil")
 	}
 	return &Service{
-		comapnayRepo: comapnyRepo,
+		comapnayRepo:     comapnyRepo,
+		notificationRepo: notificationRepo,
 	}, nil
 }
 
-func (s *Service) AddingCompany(company model.AddCompany) (model.Company, error) {
+func (s *Service) AddingCompany(company model.AddCompany, ownerID uint) (model.Company, error) {
 
 	companyData := model.Company{
 		CompanyName: company.CompanyName,
 		Address:     company.Address,
 		Domain:      company.Domain,
+		OwnerID:     ownerID,
 	}
 
 	companyData, err := s.comapnayRepo.CreateComapny(companyData)
@@ -59,3 +62,34 @@ func (s *Service) ViewAllCompanies() ([]model.Company, error) {
 
 	return companiesData, nil
 }
+
+// ViewCompanyNotifications pages through the company's notifications,
+// newest first. Only the owner of the company can read them.
+func (s *Service) ViewCompanyNotifications(userID uint, cID uint, offset int, limit int) (model.CompanyNotificationPage, error) {
+
+	if offset < 0 {
+		log.Error().Int("offset", offset).Msg("invalid notification offset")
+		return model.CompanyNotificationPage{}, errors.New("invalid offset")
+	}
+
+	companyData, err := s.comapnayRepo.GetCompanyByID(uint64(cID))
+	if err != nil {
+		return model.CompanyNotificationPage{}, err
+	}
+	if companyData.OwnerID == 0 || companyData.OwnerID != userID {
+		log.Error().Uint("company id", cID).Uint("user id", userID).Msg("user cannot read the company notifications")
+		return model.CompanyNotificationPage{}, ErrForbidden
+	}
+
+	limit = jobPageSize(limit)
+
+	notifications, err := s.notificationRepo.GetCompanyNotifications(cID, offset, limit+1)
+	if err != nil {
+		return model.CompanyNotificationPage{}, err
+	}
+
+	var page model.CompanyNotificationPage
+	page.Notifications, page.NextOffset = pageOf(notifications, offset, limit)
+
+	return page, nil
+}
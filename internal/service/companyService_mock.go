@@ -39,18 +39,18 @@ func (m *MockComapnyService) EXPECT() *MockComapnyServiceMockRecorder {
 }
 
 // AddingCompany mocks base method.
-func (m *MockComapnyService) AddingCompany(company model.AddCompany) (model.Company, error) {
+func (m *MockComapnyService) AddingCompany(company model.AddCompany, ownerID uint) (model.Company, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "AddingCompany", company)
+	ret := m.ctrl.Call(m, "AddingCompany", company, ownerID)
 	ret0, _ := ret[0].(model.Company)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // AddingCompany indicates an expected call of AddingCompany.
-func (mr *MockComapnyServiceMockRecorder) AddingCompany(company any) *gomock.Call {
+func (mr *MockComapnyServiceMockRecorder) AddingCompany(company, ownerID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddingCompany", reflect.TypeOf((*MockComapnyService)(nil).AddingCompany), company)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddingCompany", reflect.TypeOf((*MockComapnyService)(nil).AddingCompany), company, ownerID)
 }
 
 // ViewAllCompanies mocks base method.
@@ -82,3 +82,18 @@ func (mr *MockComapnyServiceMockRecorder) ViewCompanyById(Id any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ViewCompanyById", reflect.TypeOf((*MockComapnyService)(nil).ViewCompanyById), Id)
 }
+
+// ViewCompanyNotifications mocks base method.
+func (m *MockComapnyService) ViewCompanyNotifications(userID, cID uint, offset, limit int) (model.CompanyNotificationPage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ViewCompanyNotifications", userID, cID, offset, limit)
+	ret0, _ := ret[0].(model.CompanyNotificationPage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ViewCompanyNotifications indicates an expected call of ViewCompanyNotifications.
+func (mr *MockComapnyServiceMockRecorder) ViewCompanyNotifications(userID, cID, offset, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ViewCompanyNotifications", reflect.TypeOf((*MockComapnyService)(nil).ViewCompanyNotifications), userID, cID, offset, limit)
+}
@@ -33,10 +33,10 @@ func TestService_AddingCompany(t *testing.T) {
 		{
 			name:    "success",
 			args:    args{company: model.AddCompany{CompanyName: "wertyu", Address: "qwertyui", Domain: "wertyui"}},
-			want:    model.Company{CompanyName: "wertyu", Address: "qwertyui", Domain: "wertyui"},
+			want:    model.Company{CompanyName: "wertyu", Address: "qwertyui", Domain: "wertyui", OwnerID: 1},
 			wantErr: false,
 			mockUserResponse: func() (model.Company, error) {
-				return model.Company{CompanyName: "wertyu", Address: "qwertyui", Domain: "wertyui"}, nil
+				return model.Company{CompanyName: "wertyu", Address: "qwertyui", Domain: "wertyui", OwnerID: 1}, nil
 			},
 		},
 	}
@@ -44,11 +44,11 @@ func TestService_AddingCompany(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			mc := gomock.NewController(t)
 			ms := repository.NewMockComapnyRepo(mc)
-			s, _ := NewCompanyService(ms)
+			s, _ := NewCompanyService(ms, nil)
 			if tt.mockUserResponse != nil {
-				ms.EXPECT().CreateComapny(gomock.Any()).Return(tt.mockUserResponse()).AnyTimes()
+				ms.EXPECT().CreateComapny(gomock.Cond(func(x any) bool { return x.(model.Company).OwnerID == 1 })).Return(tt.mockUserResponse()).AnyTimes()
 			}
-			got, err := s.AddingCompany(tt.args.company)
+			got, err := s.AddingCompany(tt.args.company, 1)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("Service.UserSignup() error = %v, wantErr %v", err, tt.wantErr)
 				return
@@ -88,7 +88,7 @@ func TestService_ViewAllCompanies(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			mc := gomock.NewController(t)
 			ms := repository.NewMockComapnyRepo(mc)
-			s, _ := NewCompanyService(ms)
+			s, _ := NewCompanyService(ms, nil)
 			if tt.mockUserResponse != nil {
 				ms.EXPECT().GetAllCompanies().Return(tt.mockUserResponse()).AnyTimes()
 			}
@@ -138,7 +138,7 @@ func TestService_ViewCompanyById(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			mc := gomock.NewController(t)
 			ms := repository.NewMockComapnyRepo(mc)
-			s, _ := NewCompanyService(ms)
+			s, _ := NewCompanyService(ms, nil)
 			if tt.mockUserResponse != nil {
 				ms.EXPECT().GetCompanyByID(gomock.Any()).Return(tt.mockUserResponse()).AnyTimes()
 			}
@@ -153,3 +153,105 @@ func TestService_ViewCompanyById(t *testing.T) {
 		})
 	}
 }
+
+func TestService_ViewCompanyNotifications(t *testing.T) {
+	notifications := []model.CompanyNotification{
+		{ID: 3, CompanyID: 1, JobID: 2, ApplicationID: 7, Kind: model.NotificationApplicationWithdrawn, Message: "asha withdrew application 7 to golang developer"},
+		{ID: 2, CompanyID: 1, JobID: 2, ApplicationID: 6, Kind: model.NotificationApplicationWithdrawn, Message: "ravi withdrew application 6 to golang developer"},
+	}
+	nextOffset := 1
+	tests := []struct {
+		name         string
+		userID       uint
+		offset       int
+		limit        int
+		want         model.CompanyNotificationPage
+		wantErr      bool
+		mockResponse func(ms *repository.MockComapnyRepo, mn *repository.MockNotificationRepository)
+	}{
+		{
+			name:    "invalid offset",
+			userID:  1,
+			offset:  -1,
+			want:    model.CompanyNotificationPage{},
+			wantErr: true,
+		},
+		{
+			name:    "company not found",
+			userID:  1,
+			want:    model.CompanyNotificationPage{},
+			wantErr: true,
+			mockResponse: func(ms *repository.MockComapnyRepo, mn *repository.MockNotificationRepository) {
+				ms.EXPECT().GetCompanyByID(uint64(1)).Return(model.Company{}, errors.New("error"))
+			},
+		},
+		{
+			name:    "not the owner",
+			userID:  9,
+			want:    model.CompanyNotificationPage{},
+			wantErr: true,
+			mockResponse: func(ms *repository.MockComapnyRepo, mn *repository.MockNotificationRepository) {
+				ms.EXPECT().GetCompanyByID(uint64(1)).Return(model.Company{OwnerID: 1}, nil)
+			},
+		},
+		{
+			name:    "company without an owner",
+			userID:  0,
+			want:    model.CompanyNotificationPage{},
+			wantErr: true,
+			mockResponse: func(ms *repository.MockComapnyRepo, mn *repository.MockNotificationRepository) {
+				ms.EXPECT().GetCompanyByID(uint64(1)).Return(model.Company{}, nil)
+			},
+		},
+		{
+			name:    "failure in fetching notifications",
+			userID:  1,
+			want:    model.CompanyNotificationPage{},
+			wantErr: true,
+			mockResponse: func(ms *repository.MockComapnyRepo, mn *repository.MockNotificationRepository) {
+				ms.EXPECT().GetCompanyByID(uint64(1)).Return(model.Company{OwnerID: 1}, nil)
+				mn.EXPECT().GetCompanyNotifications(uint(1), 0, 21).Return(nil, errors.New("error"))
+			},
+		},
+		{
+			name:    "no notifications",
+			userID:  1,
+			want:    model.CompanyNotificationPage{Notifications: []model.CompanyNotification{}},
+			wantErr: false,
+			mockResponse: func(ms *repository.MockComapnyRepo, mn *repository.MockNotificationRepository) {
+				ms.EXPECT().GetCompanyByID(uint64(1)).Return(model.Company{OwnerID: 1}, nil)
+				mn.EXPECT().GetCompanyNotifications(uint(1), 0, 21).Return(nil, nil)
+			},
+		},
+		{
+			name:    "first page",
+			userID:  1,
+			limit:   1,
+			want:    model.CompanyNotificationPage{Notifications: notifications[:1], NextOffset: &nextOffset},
+			wantErr: false,
+			mockResponse: func(ms *repository.MockComapnyRepo, mn *repository.MockNotificationRepository) {
+				ms.EXPECT().GetCompanyByID(uint64(1)).Return(model.Company{OwnerID: 1}, nil)
+				mn.EXPECT().GetCompanyNotifications(uint(1), 0, 2).Return(notifications, nil)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			ms := repository.NewMockComapnyRepo(mc)
+			mn := repository.NewMockNotificationRepository(mc)
+			if tt.mockResponse != nil {
+				tt.mockResponse(ms, mn)
+			}
+			s, _ := NewCompanyService(ms, mn)
+			got, err := s.ViewCompanyNotifications(tt.userID, 1, tt.offset, tt.limit)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Service.ViewCompanyNotifications() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Service.ViewCompanyNotifications() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
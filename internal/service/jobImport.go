@@ -0,0 +1,410 @@
+package service
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"job-portal-api/internal/model"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/rs/zerolog/log"
+)
+
+// maxImportRows caps the size of one import so that it fits in one transaction
+const maxImportRows = 1000
+
+// importRow is a parsed row of an import, with the problems found while
+// reading it.
+type importRow struct {
+	number int
+	job    model.ImportJobRow
+	errors []model.FieldError
+}
+
+// ImportJobs reads jobs from a CSV or JSON Lines file and creates every valid
+// one in a single transaction. Rows with errors are reported back and
+// skipped. In dry run mode nothing is written. Only the owner of the company
+// can import, the company and the reference data are looked up once for the
+// whole file.
+func (s *Service) ImportJobs(userID uint, cID uint, format string, data io.Reader, dryRun bool) (model.ImportResult, error) {
+
+	var rows []importRow
+	var err error
+	switch format {
+	case model.ImportFormatCSV:
+		rows, err = readCSVJobs(data)
+	case model.ImportFormatJSONL:
+		rows, err = readJSONLJobs(data)
+	default:
+		log.Error().Str("format", format).Msg("unsupported import format")
+		return model.ImportResult{}, errors.New("unsupported import format")
+	}
+	if err != nil {
+		return model.ImportResult{}, err
+	}
+	if len(rows) == 0 {
+		return model.ImportResult{}, errors.New("import file has no jobs")
+	}
+
+	err = s.authorizeCompany(userID, cID)
+	if errors.Is(err, ErrForbidden) {
+		return model.ImportResult{}, err
+	}
+	if err != nil {
+		validationErr := &ValidationError{}
+		validationErr.add("companyID", "company does not exist")
+		return model.ImportResult{}, validationErr
+	}
+
+	names, err := s.taxonomyNames()
+	if err != nil {
+		return model.ImportResult{}, err
+	}
+
+	result := model.ImportResult{
+		DryRun: dryRun,
+		Total:  len(rows),
+		JobIDs: []uint{},
+		Errors: []model.ImportRowError{},
+	}
+
+	var jobs []model.Job
+	for _, v := range rows {
+		jobData, fieldErrs := s.importJob(cID, v, names)
+		if len(fieldErrs) != 0 {
+			result.Errors = append(result.Errors, model.ImportRowError{
+				Row:    v.number,
+				Errors: fieldErrs,
+			})
+			continue
+		}
+		jobs = append(jobs, jobData)
+	}
+	result.Valid = len(jobs)
+
+	if dryRun || len(jobs) == 0 {
+		return result, nil
+	}
+
+	jobIDs, err := s.jobRepo.CreateJobs(jobs)
+	if err != nil {
+		return model.ImportResult{}, err
+	}
+	result.Imported = len(jobIDs)
+	result.JobIDs = jobIDs
+
+	return result, nil
+}
+
+// importJob builds the job for one row and checks it the same way a single
+// posting is checked. The reference data names were already resolved
+// against the active entries, so only the lookup free checks are left.
+func (s *Service) importJob(cID uint, row importRow, names taxonomyNameIndex) (model.Job, []model.FieldError) {
+	if len(row.errors) != 0 {
+		return model.Job{}, row.errors
+	}
+
+	fieldErrs := validateImportRow(row.job)
+	if len(fieldErrs) != 0 {
+		return model.Job{}, fieldErrs
+	}
+
+	jobDetails, fieldErrs := names.resolve(row.job)
+	if len(fieldErrs) != 0 {
+		return model.Job{}, fieldErrs
+	}
+
+	jobData := newJob(jobDetails, cID)
+	validationErr := &ValidationError{}
+	s.validateJobFields(jobData, true, validationErr)
+	if len(validationErr.Errors) != 0 {
+		return model.Job{}, validationErr.Errors
+	}
+	s.normaliseSalary(&jobData)
+
+	return jobData, nil
+}
+
+// validateImportRow runs the struct validation that the handler does for a
+// single job, reporting the fields by their json names.
+func validateImportRow(row model.ImportJobRow) []model.FieldError {
+	validate := validator.New()
+	validate.RegisterTagNameFunc(func(field reflect.StructField) string {
+		return strings.Split(field.Tag.Get("json"), ",")[0]
+	})
+
+	err := validate.Struct(row)
+	var validationErrs validator.ValidationErrors
+	if !errors.As(err, &validationErrs) {
+		return nil
+	}
+
+	var fieldErrs []model.FieldError
+	for _, v := range validationErrs {
+		message := "is required"
+		if v.Tag() == "oneof" {
+			message = "must be one of " + v.Param()
+		}
+		fieldErrs = append(fieldErrs, model.FieldError{
+			Field:   v.Field(),
+			Message: message,
+		})
+	}
+	return fieldErrs
+}
+
+// taxonomyNameIndex maps every reference data kind to its active entries,
+// keyed by lower case name.
+type taxonomyNameIndex map[string]map[string]uint
+
+func (s *Service) taxonomyNames() (taxonomyNameIndex, error) {
+	index := taxonomyNameIndex{}
+	for _, kind := range []string{model.TaxonomyLocation, model.TaxonomyTechnologyStack, model.TaxonomyQualification, model.TaxonomyShift, model.TaxonomyJobType} {
+		taxonomies, err := s.taxonomyRepo.GetAllTaxonomies(kind)
+		if err != nil {
+			return nil, err
+		}
+		index[kind] = make(map[string]uint, len(taxonomies))
+		for _, v := range taxonomies {
+			index[kind][strings.ToLower(v.Name)] = v.ID
+		}
+	}
+	return index, nil
+}
+
+func (t taxonomyNameIndex) ids(kind string, field string, names []string, fieldErrs *[]model.FieldError) []uint {
+	var ids []uint
+	var unknown []string
+	for _, v := range names {
+		id, ok := t[kind][strings.ToLower(strings.TrimSpace(v))]
+		if !ok {
+			unknown = append(unknown, v)
+			continue
+		}
+		ids = append(ids, id)
+	}
+	if len(unknown) != 0 {
+		*fieldErrs = append(*fieldErrs, model.FieldError{
+			Field:   field,
+			Message: "unknown names " + strings.Join(unknown, ", "),
+		})
+	}
+	return ids
+}
+
+// resolve turns the reference data names of a row into ids.
+func (t taxonomyNameIndex) resolve(row model.ImportJobRow) (model.NewJobs, []model.FieldError) {
+	var fieldErrs []model.FieldError
+	jobDetails := model.NewJobs{
+		Jobname:           row.Jobname,
+		MinNoticePeriod:   row.MinNoticePeriod,
+		MaxNoticePeriod:   row.MaxNoticePeriod,
+		Location:          t.ids(model.TaxonomyLocation, "location", row.Location, &fieldErrs),
+		TechnologyStack:   t.ids(model.TaxonomyTechnologyStack, "technologyStack", row.TechnologyStack, &fieldErrs),
+		Description:       row.Description,
+		MinExperience:     row.MinExperience,
+		MaxExperience:     row.MaxExperience,
+		Qualifications:    t.ids(model.TaxonomyQualification, "qualifications", row.Qualifications, &fieldErrs),
+		Shift:             t.ids(model.TaxonomyShift, "shifts", row.Shift, &fieldErrs),
+		Jobtype:           t.ids(model.TaxonomyJobType, "jobtype", row.Jobtype, &fieldErrs),
+		Status:            row.Status,
+		ExpiresAt:         row.ExpiresAt,
+		WorkMode:          row.WorkMode,
+		MinSalary:         row.MinSalary,
+		MaxSalary:         row.MaxSalary,
+		Currency:          row.Currency,
+		PayPeriod:         row.PayPeriod,
+		SalaryUndisclosed: row.SalaryUndisclosed,
+	}
+	return jobDetails, fieldErrs
+}
+
+func readJSONLJobs(data io.Reader) ([]importRow, error) {
+	var rows []importRow
+
+	scanner := bufio.NewScanner(data)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	line := 0
+	for scanner.Scan() {
+		line++
+		text := bytes.TrimSpace(scanner.Bytes())
+		if len(text) == 0 {
+			continue
+		}
+		if len(rows) == maxImportRows {
+			return nil, fmt.Errorf("import is limited to %d jobs", maxImportRows)
+		}
+
+		row := importRow{number: line}
+		decoder := json.NewDecoder(bytes.NewReader(text))
+		decoder.DisallowUnknownFields()
+		err := decoder.Decode(&row.job)
+		if err != nil {
+			row.errors = []model.FieldError{{Field: "row", Message: "invalid json : " + err.Error()}}
+		}
+		rows = append(rows, row)
+	}
+	err := scanner.Err()
+	if err != nil {
+		log.Error().Err(err).Msg("error in reading import file")
+		return nil, errors.New("could not read import file")
+	}
+
+	return rows, nil
+}
+
+func readCSVJobs(data io.Reader) ([]importRow, error) {
+	reader := csv.NewReader(data)
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err != nil {
+		log.Error().Err(err).Msg("error in reading import header")
+		return nil, errors.New("could not read import header")
+	}
+
+	columns := make([]string, len(header))
+	for i, v := range header {
+		name, ok := importColumns[strings.ToLower(strings.TrimSpace(v))]
+		if !ok {
+			return nil, fmt.Errorf("unknown import column %q", v)
+		}
+		columns[i] = name
+	}
+
+	var rows []importRow
+	line := 1
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		line++
+		if err != nil {
+			if !errors.Is(err, csv.ErrFieldCount) {
+				log.Error().Err(err).Msg("error in reading import file")
+				return nil, errors.New("could not read import file")
+			}
+			rows = append(rows, importRow{number: line, errors: []model.FieldError{{Field: "row", Message: "wrong number of columns"}}})
+			continue
+		}
+		if len(rows) == maxImportRows {
+			return nil, fmt.Errorf("import is limited to %d jobs", maxImportRows)
+		}
+
+		rows = append(rows, csvJobRow(line, columns, record))
+	}
+
+	return rows, nil
+}
+
+// importColumns maps the lower case CSV header names to the json names of
+// model.ImportJobRow.
+var importColumns = map[string]string{
+	"jobname":           "jobName",
+	"minnoticeperiod":   "minNoticePeriod",
+	"maxnoticeperiod":   "maxNoticePeriod",
+	"location":          "location",
+	"technologystack":   "technologyStack",
+	"description":       "description",
+	"minexperience":     "minExperience",
+	"maxexperience":     "maxExperience",
+	"qualifications":    "qualifications",
+	"shifts":            "shifts",
+	"jobtype":           "jobtype",
+	"status":            "status",
+	"expiresat":         "expiresAt",
+	"workmode":          "workMode",
+	"minsalary":         "minSalary",
+	"maxsalary":         "maxSalary",
+	"currency":          "currency",
+	"payperiod":         "payPeriod",
+	"salaryundisclosed": "salaryUndisclosed",
+}
+
+func csvJobRow(line int, columns []string, record []string) importRow {
+	row := importRow{number: line}
+	job := &row.job
+
+	for i, v := range record {
+		v = strings.TrimSpace(v)
+		if v == "" {
+			continue
+		}
+
+		var err error
+		switch columns[i] {
+		case "jobName":
+			job.Jobname = v
+		case "description":
+			job.Description = v
+		case "status":
+			job.Status = v
+		case "workMode":
+			job.WorkMode = v
+		case "currency":
+			job.Currency = v
+		case "payPeriod":
+			job.PayPeriod = v
+		case "minNoticePeriod":
+			job.MinNoticePeriod, err = strconv.Atoi(v)
+		case "minExperience":
+			job.MinExperience, err = strconv.Atoi(v)
+		case "maxNoticePeriod":
+			var n uint64
+			n, err = strconv.ParseUint(v, 10, 32)
+			job.MaxNoticePeriod = uint(n)
+		case "maxExperience":
+			var n uint64
+			n, err = strconv.ParseUint(v, 10, 32)
+			job.MaxExperience = uint(n)
+		case "minSalary":
+			job.MinSalary, err = strconv.ParseFloat(v, 64)
+		case "maxSalary":
+			job.MaxSalary, err = strconv.ParseFloat(v, 64)
+		case "salaryUndisclosed":
+			job.SalaryUndisclosed, err = strconv.ParseBool(v)
+		case "expiresAt":
+			var expiresAt time.Time
+			expiresAt, err = time.Parse(time.RFC3339, v)
+			job.ExpiresAt = &expiresAt
+		case "location":
+			job.Location = splitImportList(v)
+		case "technologyStack":
+			job.TechnologyStack = splitImportList(v)
+		case "qualifications":
+			job.Qualifications = splitImportList(v)
+		case "shifts":
+			job.Shift = splitImportList(v)
+		case "jobtype":
+			job.Jobtype = splitImportList(v)
+		}
+		if err != nil {
+			row.errors = append(row.errors, model.FieldError{
+				Field:   columns[i],
+				Message: fmt.Sprintf("invalid value %q", v),
+			})
+		}
+	}
+
+	return row
+}
+
+func splitImportList(value string) []string {
+	var values []string
+	for _, v := range strings.Split(value, ";") {
+		v = strings.TrimSpace(v)
+		if v != "" {
+			values = append(values, v)
+		}
+	}
+	return values
+}
@@ -0,0 +1,159 @@
+package service
+
+import (
+	"errors"
+	"job-portal-api/internal/cache"
+	"job-portal-api/internal/model"
+	"job-portal-api/internal/repository"
+	"reflect"
+	"strings"
+	"testing"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+func TestService_ImportJobs(t *testing.T) {
+	type args struct {
+		userID uint
+		format string
+		data   string
+		dryRun bool
+	}
+	tests := []struct {
+		name         string
+		args         args
+		want         model.ImportResult
+		wantErr      bool
+		companyErr   error
+		mockResponse func(mj *repository.MockJobRepository)
+	}{
+		{
+			name:    "failure - unsupported format",
+			args:    args{userID: 8, format: "xlsx", data: "jobName\n"},
+			want:    model.ImportResult{},
+			wantErr: true,
+		},
+		{
+			name:    "failure - unknown column",
+			args:    args{userID: 8, format: model.ImportFormatCSV, data: "jobName,salary\ngolang developer,10\n"},
+			want:    model.ImportResult{},
+			wantErr: true,
+		},
+		{
+			name:    "failure - no jobs",
+			args:    args{userID: 8, format: model.ImportFormatJSONL, data: "\n\n"},
+			want:    model.ImportResult{},
+			wantErr: true,
+		},
+		{
+			name:       "failure - unknown company is reported once",
+			args:       args{userID: 8, format: model.ImportFormatCSV, data: "jobName,description\ngolang developer,build apis\njava developer,build apis\n"},
+			want:       model.ImportResult{},
+			wantErr:    true,
+			companyErr: errors.New("error"),
+		},
+		{
+			name:    "failure - not the company owner",
+			args:    args{userID: 3, format: model.ImportFormatCSV, data: "jobName,description\ngolang developer,build apis\n"},
+			want:    model.ImportResult{},
+			wantErr: true,
+		},
+		{
+			name: "success - dry run reports every row",
+			args: args{userID: 8, format: model.ImportFormatCSV, dryRun: true, data: `jobName,description,minNoticePeriod,maxNoticePeriod,minExperience,maxExperience,location,technologyStack
+golang developer,build apis,15,60,1,5,bengaluru; Pune,Go
+java developer,build apis,15,60,1,five,Pune,Java
+python developer,build apis,15,60,1,5,Mysuru,Go;Rust
+,build apis,15,60,1,5,,
+rust developer,build apis,,60,0,5,Pune,Go
+short,row
+`},
+			want: model.ImportResult{
+				DryRun: true,
+				Total:  6,
+				Valid:  1,
+				JobIDs: []uint{},
+				Errors: []model.ImportRowError{
+					{Row: 3, Errors: []model.FieldError{{Field: "maxExperience", Message: `invalid value "five"`}}},
+					{Row: 4, Errors: []model.FieldError{
+						{Field: "location", Message: "unknown names Mysuru"},
+						{Field: "technologyStack", Message: "unknown names Rust"},
+					}},
+					{Row: 5, Errors: []model.FieldError{{Field: "jobName", Message: "is required"}}},
+					{Row: 6, Errors: []model.FieldError{
+						{Field: "minNoticePeriod", Message: "is required"},
+						{Field: "minExperience", Message: "is required"},
+					}},
+					{Row: 7, Errors: []model.FieldError{{Field: "row", Message: "wrong number of columns"}}},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "success - valid rows are created together",
+			args: args{userID: 8, format: model.ImportFormatJSONL, data: `{"jobName": "golang developer", "description": "build apis", "minNoticePeriod": 15, "maxNoticePeriod": 60, "minExperience": 1, "maxExperience": 5, "location": ["Pune"]}
+
+{"jobName": "java developer", "description": "build apis", "minNoticePeriod": 15, "maxNoticePeriod": 60, "minExperience": 1, "maxExperience": 5, "salary": 10}
+{"jobName": "go developer", "description": "build apis", "minNoticePeriod": 15, "maxNoticePeriod": 60, "minExperience": 6, "maxExperience": 5}
+{"jobName": "rust developer", "description": "build apis", "minNoticePeriod": 15, "maxNoticePeriod": 60, "minExperience": 1, "maxExperience": 5, "workMode": "remote"}
+`},
+			want: model.ImportResult{
+				Total:    4,
+				Valid:    2,
+				Imported: 2,
+				JobIDs:   []uint{10, 11},
+				Errors: []model.ImportRowError{
+					{Row: 3, Errors: []model.FieldError{{Field: "row", Message: `invalid json : json: unknown field "salary"`}}},
+					{Row: 4, Errors: []model.FieldError{{Field: "minExperience", Message: "cannot be greater than maxExperience"}}},
+				},
+			},
+			wantErr: false,
+			mockResponse: func(mj *repository.MockJobRepository) {
+				mj.EXPECT().CreateJobs(gomock.Any()).DoAndReturn(func(jobData []model.Job) ([]uint, error) {
+					if len(jobData) != 2 || jobData[0].Location[0].ID != 2 || jobData[1].WorkMode != model.WorkModeRemote {
+						t.Errorf("Service.ImportJobs() created %v", jobData)
+					}
+					return []uint{10, 11}, nil
+				})
+			},
+		},
+		{
+			name:    "failure - create jobs",
+			args:    args{userID: 8, format: model.ImportFormatJSONL, data: `{"jobName": "golang developer", "description": "build apis", "minNoticePeriod": 15, "maxNoticePeriod": 60, "minExperience": 1, "maxExperience": 5}`},
+			want:    model.ImportResult{},
+			wantErr: true,
+			mockResponse: func(mj *repository.MockJobRepository) {
+				mj.EXPECT().CreateJobs(gomock.Any()).Return(nil, errors.New("error"))
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			mj := repository.NewMockJobRepository(mc)
+			mcr := repository.NewMockComapnyRepo(mc)
+			mt := repository.NewMockTaxonomyRepository(mc)
+			mca := cache.NewMockCaching(mc)
+			s, _ := NewJobService(mj, mcr, mt, mca, testRates)
+			mcr.EXPECT().GetCompanyByID(uint64(1)).Return(model.Company{OwnerID: 8}, tt.companyErr).MaxTimes(1)
+			mt.EXPECT().GetAllTaxonomies(model.TaxonomyLocation).Return([]model.Taxonomy{{ID: 1, Name: "Bengaluru"}, {ID: 2, Name: "Pune"}}, nil).MaxTimes(1)
+			mt.EXPECT().GetAllTaxonomies(model.TaxonomyTechnologyStack).Return([]model.Taxonomy{{ID: 1, Name: "Go"}, {ID: 2, Name: "Java"}}, nil).MaxTimes(1)
+			mt.EXPECT().GetAllTaxonomies(gomock.Any()).Return([]model.Taxonomy{}, nil).MaxTimes(3)
+			if tt.mockResponse != nil {
+				tt.mockResponse(mj)
+			}
+			got, err := s.ImportJobs(tt.args.userID, 1, tt.args.format, strings.NewReader(tt.args.data), tt.args.dryRun)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Service.ImportJobs() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			var validationErr *ValidationError
+			if tt.companyErr != nil && (!errors.As(err, &validationErr) || len(validationErr.Errors) != 1) {
+				t.Errorf("Service.ImportJobs() error = %v, want one companyID error", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Service.ImportJobs() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
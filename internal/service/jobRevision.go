@@ -0,0 +1,157 @@
+package service
+
+import (
+	"encoding/json"
+	"errors"
+	"job-portal-api/internal/model"
+	"reflect"
+	"sort"
+
+	"github.com/rs/zerolog/log"
+)
+
+func (s *Service) ViewJobRevisions(jID uint) ([]model.JobRevision, error) {
+
+	revisions, err := s.jobRepo.GetJobRevisions(jID)
+	if err != nil {
+		return nil, err
+	}
+
+	return revisions, nil
+}
+
+func (s *Service) DiffJobRevisions(jID uint, from int, to int) (model.JobRevisionDiff, error) {
+
+	fromRevision, err := s.jobRepo.GetJobRevision(jID, from)
+	if err != nil {
+		return model.JobRevisionDiff{}, err
+	}
+	toRevision, err := s.jobRepo.GetJobRevision(jID, to)
+	if err != nil {
+		return model.JobRevisionDiff{}, err
+	}
+
+	changes, err := diffJobSnapshots(fromRevision.Snapshot, toRevision.Snapshot)
+	if err != nil {
+		return model.JobRevisionDiff{}, err
+	}
+
+	return model.JobRevisionDiff{
+		JobID:   jID,
+		From:    from,
+		To:      to,
+		Changes: changes,
+	}, nil
+}
+
+// diffJobSnapshots lists the fields that differ between two snapshots, keyed
+// by their json names and sorted so that the output is stable.
+func diffJobSnapshots(from model.JobSnapshot, to model.JobSnapshot) ([]model.JobRevisionChange, error) {
+	fromFields, err := snapshotFields(from)
+	if err != nil {
+		return nil, err
+	}
+	toFields, err := snapshotFields(to)
+	if err != nil {
+		return nil, err
+	}
+
+	var fields []string
+	for k := range fromFields {
+		fields = append(fields, k)
+	}
+	sort.Strings(fields)
+
+	changes := []model.JobRevisionChange{}
+	for _, v := range fields {
+		if reflect.DeepEqual(fromFields[v], toFields[v]) {
+			continue
+		}
+		changes = append(changes, model.JobRevisionChange{
+			Field: v,
+			From:  fromFields[v],
+			To:    toFields[v],
+		})
+	}
+
+	return changes, nil
+}
+
+func snapshotFields(snapshot model.JobSnapshot) (map[string]interface{}, error) {
+	val, err := json.Marshal(snapshot)
+	if err != nil {
+		log.Error().Err(err).Msg("error in marshaling job snapshot")
+		return nil, errors.New("could not compare job revisions")
+	}
+
+	var fields map[string]interface{}
+	err = json.Unmarshal(val, &fields)
+	if err != nil {
+		log.Error().Err(err).Msg("error in un marshaling job snapshot")
+		return nil, errors.New("could not compare job revisions")
+	}
+
+	return fields, nil
+}
+
+// RestoreJobRevision puts the content of an old revision back on the job,
+// which is stored as a new revision. The status is left as it is, restoring
+// the wording of a closed job does not open it again.
+func (s *Service) RestoreJobRevision(userID uint, jID uint, revision int) (model.Response, error) {
+
+	jobData, err := s.jobRepo.GetJobByJobID(jID)
+	if err != nil {
+		return model.Response{}, err
+	}
+
+	err = s.authorizeCompany(userID, jobData.Cid)
+	if err != nil {
+		return model.Response{}, err
+	}
+
+	jobRevision, err := s.jobRepo.GetJobRevision(jID, revision)
+	if err != nil {
+		return model.Response{}, err
+	}
+
+	snapshot := jobRevision.Snapshot
+	jobData.Jobname = snapshot.Jobname
+	jobData.MinNoticePeriod = snapshot.MinNoticePeriod
+	jobData.MaxNoticePeriod = snapshot.MaxNoticePeriod
+	jobData.Location = toLocations(snapshot.Location)
+	jobData.TechnologyStack = toTechnologyStacks(snapshot.TechnologyStack)
+	jobData.Description = snapshot.Description
+	jobData.MinExperience = snapshot.MinExperience
+	jobData.MaxExperience = snapshot.MaxExperience
+	jobData.Qualifications = toQualifications(snapshot.Qualifications)
+	jobData.Shift = toShifts(snapshot.Shift)
+	jobData.Jobtype = toJobTypes(snapshot.Jobtype)
+	jobData.ExpiresAt = snapshot.ExpiresAt
+	jobData.WorkMode = snapshot.WorkMode
+	jobData.MinSalary = snapshot.MinSalary
+	jobData.MaxSalary = snapshot.MaxSalary
+	jobData.Currency = snapshot.Currency
+	jobData.PayPeriod = snapshot.PayPeriod
+	jobData.SalaryUndisclosed = snapshot.SalaryUndisclosed
+	jobData.SkillRequirements = snapshot.SkillRequirements
+	jobData.ScoringPolicy = snapshot.ScoringPolicy
+
+	//reference data may have been retired since, and a live job cannot go back to a past expiry date
+	live := jobData.Status == model.JobStatusPublished || jobData.Status == model.JobStatusPaused
+	err = s.validateJob(jobData, live)
+	if err != nil {
+		return model.Response{}, err
+	}
+	s.normaliseSalary(&jobData)
+
+	jobData, err = s.jobRepo.UpdateJob(jobData)
+	if err != nil {
+		return model.Response{}, referenceError(err)
+	}
+
+	s.invalidateJobCache(jID)
+
+	return model.Response{
+		Id: jobData.ID,
+	}, nil
+}
@@ -0,0 +1,234 @@
+package service
+
+import (
+	"errors"
+	"job-portal-api/internal/cache"
+	"job-portal-api/internal/model"
+	"job-portal-api/internal/repository"
+	"reflect"
+	"testing"
+	"time"
+
+	gomock "go.uber.org/mock/gomock"
+	"gorm.io/gorm"
+)
+
+func TestService_ViewJobRevisions(t *testing.T) {
+	tests := []struct {
+		name         string
+		jID          uint
+		want         []model.JobRevision
+		wantErr      bool
+		mockResponse func(mj *repository.MockJobRepository)
+	}{
+		{
+			name:    "failure",
+			jID:     1,
+			want:    nil,
+			wantErr: true,
+			mockResponse: func(mj *repository.MockJobRepository) {
+				mj.EXPECT().GetJobRevisions(uint(1)).Return(nil, errors.New("error"))
+			},
+		},
+		{
+			name:    "success",
+			jID:     1,
+			want:    []model.JobRevision{{JobID: 1, Revision: 1}, {JobID: 1, Revision: 2}},
+			wantErr: false,
+			mockResponse: func(mj *repository.MockJobRepository) {
+				mj.EXPECT().GetJobRevisions(uint(1)).Return([]model.JobRevision{{JobID: 1, Revision: 1}, {JobID: 1, Revision: 2}}, nil)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			mj := repository.NewMockJobRepository(mc)
+			mcr := repository.NewMockComapnyRepo(mc)
+			mt := repository.NewMockTaxonomyRepository(mc)
+			mca := cache.NewMockCaching(mc)
+			s, _ := NewJobService(mj, mcr, mt, mca, testRates)
+			tt.mockResponse(mj)
+			got, err := s.ViewJobRevisions(tt.jID)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Service.ViewJobRevisions() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Service.ViewJobRevisions() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestService_DiffJobRevisions(t *testing.T) {
+	type args struct {
+		jID  uint
+		from int
+		to   int
+	}
+	tests := []struct {
+		name         string
+		args         args
+		want         model.JobRevisionDiff
+		wantErr      bool
+		mockResponse func(mj *repository.MockJobRepository)
+	}{
+		{
+			name:    "failure - unknown revision",
+			args:    args{jID: 1, from: 1, to: 9},
+			want:    model.JobRevisionDiff{},
+			wantErr: true,
+			mockResponse: func(mj *repository.MockJobRepository) {
+				mj.EXPECT().GetJobRevision(uint(1), 1).Return(model.JobRevision{}, nil)
+				mj.EXPECT().GetJobRevision(uint(1), 9).Return(model.JobRevision{}, errors.New("error"))
+			},
+		},
+		{
+			name:    "success - no changes",
+			args:    args{jID: 1, from: 2, to: 2},
+			want:    model.JobRevisionDiff{JobID: 1, From: 2, To: 2, Changes: []model.JobRevisionChange{}},
+			wantErr: false,
+			mockResponse: func(mj *repository.MockJobRepository) {
+				mj.EXPECT().GetJobRevision(uint(1), 2).Return(model.JobRevision{JobID: 1, Revision: 2, Snapshot: model.JobSnapshot{Jobname: "golang developer"}}, nil).Times(2)
+			},
+		},
+		{
+			name: "success",
+			args: args{jID: 1, from: 1, to: 2},
+			want: model.JobRevisionDiff{
+				JobID: 1,
+				From:  1,
+				To:    2,
+				Changes: []model.JobRevisionChange{
+					{Field: "jobName", From: "go developer", To: "golang developer"},
+					{Field: "location", From: []interface{}{float64(1)}, To: []interface{}{float64(1), float64(2)}},
+					{Field: "maxExperience", From: float64(3), To: float64(5)},
+				},
+			},
+			wantErr: false,
+			mockResponse: func(mj *repository.MockJobRepository) {
+				mj.EXPECT().GetJobRevision(uint(1), 1).Return(model.JobRevision{JobID: 1, Revision: 1, Snapshot: model.JobSnapshot{
+					Jobname:       "go developer",
+					Location:      []uint{1},
+					MaxExperience: 3,
+				}}, nil)
+				mj.EXPECT().GetJobRevision(uint(1), 2).Return(model.JobRevision{JobID: 1, Revision: 2, Snapshot: model.JobSnapshot{
+					Jobname:       "golang developer",
+					Location:      []uint{1, 2},
+					MaxExperience: 5,
+				}}, nil)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			mj := repository.NewMockJobRepository(mc)
+			mcr := repository.NewMockComapnyRepo(mc)
+			mt := repository.NewMockTaxonomyRepository(mc)
+			mca := cache.NewMockCaching(mc)
+			s, _ := NewJobService(mj, mcr, mt, mca, testRates)
+			tt.mockResponse(mj)
+			got, err := s.DiffJobRevisions(tt.args.jID, tt.args.from, tt.args.to)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Service.DiffJobRevisions() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Service.DiffJobRevisions() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestService_RestoreJobRevision(t *testing.T) {
+	past := time.Now().Add(-time.Hour)
+	type args struct {
+		userID   uint
+		jID      uint
+		revision int
+	}
+	tests := []struct {
+		name         string
+		args         args
+		want         model.Response
+		wantErr      bool
+		mockResponse func(mj *repository.MockJobRepository, mca *cache.MockCaching)
+	}{
+		{
+			name:    "failure - not the company owner",
+			args:    args{userID: 3, jID: 1, revision: 1},
+			want:    model.Response{},
+			wantErr: true,
+			mockResponse: func(mj *repository.MockJobRepository, mca *cache.MockCaching) {
+				mj.EXPECT().GetJobByJobID(uint(1)).Return(model.Job{Model: gorm.Model{ID: 1}}, nil)
+			},
+		},
+		{
+			name:    "failure - unknown revision",
+			args:    args{userID: 8, jID: 1, revision: 9},
+			want:    model.Response{},
+			wantErr: true,
+			mockResponse: func(mj *repository.MockJobRepository, mca *cache.MockCaching) {
+				mj.EXPECT().GetJobByJobID(uint(1)).Return(model.Job{Model: gorm.Model{ID: 1}}, nil)
+				mj.EXPECT().GetJobRevision(uint(1), 9).Return(model.JobRevision{}, errors.New("error"))
+			},
+		},
+		{
+			name:    "failure - live job cannot go back to a past expiry date",
+			args:    args{userID: 8, jID: 1, revision: 1},
+			want:    model.Response{},
+			wantErr: true,
+			mockResponse: func(mj *repository.MockJobRepository, mca *cache.MockCaching) {
+				mj.EXPECT().GetJobByJobID(uint(1)).Return(model.Job{Model: gorm.Model{ID: 1}, Status: model.JobStatusPublished}, nil)
+				mj.EXPECT().GetJobRevision(uint(1), 1).Return(model.JobRevision{JobID: 1, Revision: 1, Snapshot: model.JobSnapshot{ExpiresAt: &past}}, nil)
+			},
+		},
+		{
+			name:    "success - status is kept",
+			args:    args{userID: 8, jID: 1, revision: 1},
+			want:    model.Response{Id: 1},
+			wantErr: false,
+			mockResponse: func(mj *repository.MockJobRepository, mca *cache.MockCaching) {
+				mj.EXPECT().GetJobByJobID(uint(1)).Return(model.Job{Model: gorm.Model{ID: 1}, Jobname: "golang developer", Status: model.JobStatusClosed}, nil)
+				mj.EXPECT().GetJobRevision(uint(1), 1).Return(model.JobRevision{JobID: 1, Revision: 1, Snapshot: model.JobSnapshot{
+					Jobname:  "go developer",
+					Location: []uint{2},
+					Status:   model.JobStatusDraft,
+					WorkMode: model.WorkModeRemote,
+				}}, nil)
+				mj.EXPECT().UpdateJob(model.Job{
+					Model:    gorm.Model{ID: 1},
+					Jobname:  "go developer",
+					Location: []model.Location{{Model: gorm.Model{ID: 2}}},
+					Status:   model.JobStatusClosed,
+					WorkMode: model.WorkModeRemote,
+				}).Return(model.Job{Model: gorm.Model{ID: 1}}, nil)
+				mca.EXPECT().DeleteTheCacheData(gomock.Any(), uint(1)).Return(nil)
+				mca.EXPECT().DeleteShortlist(gomock.Any(), uint(1)).Return(nil)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			mj := repository.NewMockJobRepository(mc)
+			mcr := repository.NewMockComapnyRepo(mc)
+			mt := repository.NewMockTaxonomyRepository(mc)
+			mca := cache.NewMockCaching(mc)
+			s, _ := NewJobService(mj, mcr, mt, mca, testRates)
+			mcr.EXPECT().GetCompanyByID(gomock.Any()).Return(model.Company{OwnerID: 8}, nil).AnyTimes()
+			mt.EXPECT().FindMissingTaxonomyIDs(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+			tt.mockResponse(mj, mca)
+			got, err := s.RestoreJobRevision(tt.args.userID, tt.args.jID, tt.args.revision)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Service.RestoreJobRevision() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Service.RestoreJobRevision() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
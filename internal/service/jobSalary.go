@@ -0,0 +1,128 @@
+package service
+
+import (
+	"errors"
+	"job-portal-api/internal/model"
+	"strconv"
+	"strings"
+
+	"github.com/rs/zerolog/log"
+)
+
+// working hours and months used to turn a posted salary into a yearly one
+const (
+	hoursPerYear  = 2080
+	monthsPerYear = 12
+)
+
+// ExchangeRates converts salaries into the base currency. Each rate is the
+// value of one unit of the currency expressed in the base currency.
+type ExchangeRates struct {
+	Base  string
+	Rates map[string]float64
+}
+
+// NewExchangeRates builds the rate table from the configured base currency and
+// a list like "USD:83.0;EUR:90.0".
+func NewExchangeRates(base string, table string) (ExchangeRates, error) {
+	base = strings.ToUpper(strings.TrimSpace(base))
+	if base == "" {
+		log.Info().Msg("base currency cannot be empty")
+		return ExchangeRates{}, errors.New("base currency cannot be empty")
+	}
+
+	rates := ExchangeRates{
+		Base:  base,
+		Rates: map[string]float64{base: 1},
+	}
+
+	for _, v := range strings.Split(table, ";") {
+		v = strings.TrimSpace(v)
+		if v == "" {
+			continue
+		}
+		code, value, ok := strings.Cut(v, ":")
+		if !ok {
+			log.Info().Str("rate", v).Msg("invalid exchange rate entry")
+			return ExchangeRates{}, errors.New("invalid exchange rate entry")
+		}
+		rate, err := strconv.ParseFloat(strings.TrimSpace(value), 64)
+		if err != nil || rate <= 0 {
+			log.Info().Str("rate", v).Msg("invalid exchange rate value")
+			return ExchangeRates{}, errors.New("invalid exchange rate value")
+		}
+		rates.Rates[strings.ToUpper(strings.TrimSpace(code))] = rate
+	}
+
+	return rates, nil
+}
+
+func (e ExchangeRates) supports(currency string) bool {
+	_, ok := e.Rates[currency]
+	return ok
+}
+
+// yearly converts an amount paid per period in the given currency into a
+// yearly amount in the base currency.
+func (e ExchangeRates) yearly(amount float64, currency string, payPeriod string) float64 {
+	switch payPeriod {
+	case model.PayPeriodHourly:
+		amount *= hoursPerYear
+	case model.PayPeriodMonthly:
+		amount *= monthsPerYear
+	}
+	return amount * e.Rates[currency]
+}
+
+func hasSalary(jobData model.Job) bool {
+	return jobData.MinSalary != 0 || jobData.MaxSalary != 0
+}
+
+// validateSalary records the problems with the pay details of a job.
+func (s *Service) validateSalary(jobData model.Job, validationErr *ValidationError) {
+	if !hasSalary(jobData) {
+		return
+	}
+	if jobData.SalaryUndisclosed {
+		validationErr.add("salaryUndisclosed", "cannot be set together with a salary")
+		return
+	}
+	if jobData.MinSalary < 0 {
+		validationErr.add("minSalary", "cannot be negative")
+	}
+	if jobData.MaxSalary != 0 && jobData.MinSalary > jobData.MaxSalary {
+		validationErr.add("minSalary", "cannot be greater than maxSalary")
+	}
+	if jobData.Currency == "" {
+		validationErr.add("currency", "is required when a salary is given")
+	} else if !s.rates.supports(jobData.Currency) {
+		validationErr.add("currency", "no exchange rate for "+jobData.Currency)
+	}
+	switch jobData.PayPeriod {
+	case model.PayPeriodHourly, model.PayPeriodMonthly, model.PayPeriodYearly:
+	case "":
+		validationErr.add("payPeriod", "is required when a salary is given")
+	default:
+		validationErr.add("payPeriod", "must be one of hourly monthly yearly")
+	}
+}
+
+// normaliseSalary fills in the yearly base currency salary used for the
+// listing filters and sort. A missing maximum means a fixed salary.
+func (s *Service) normaliseSalary(jobData *model.Job) {
+	jobData.NormalisedMinSalary = nil
+	jobData.NormalisedMaxSalary = nil
+	if jobData.SalaryUndisclosed || !hasSalary(*jobData) {
+		return
+	}
+
+	maxSalary := jobData.MaxSalary
+	if maxSalary == 0 {
+		maxSalary = jobData.MinSalary
+	}
+
+	minNormalised := s.rates.yearly(jobData.MinSalary, jobData.Currency, jobData.PayPeriod)
+	maxNormalised := s.rates.yearly(maxSalary, jobData.Currency, jobData.PayPeriod)
+	jobData.NormalisedMinSalary = &minNormalised
+	jobData.NormalisedMaxSalary = &maxNormalised
+}
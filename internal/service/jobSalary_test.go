@@ -0,0 +1,155 @@
+package service
+
+import (
+	"errors"
+	"job-portal-api/internal/cache"
+	"job-portal-api/internal/model"
+	"job-portal-api/internal/repository"
+	"reflect"
+	"testing"
+
+	"go.uber.org/mock/gomock"
+)
+
+var testRates = ExchangeRates{
+	Base:  "INR",
+	Rates: map[string]float64{"INR": 1, "USD": 80},
+}
+
+func TestNewExchangeRates(t *testing.T) {
+	tests := []struct {
+		name    string
+		base    string
+		table   string
+		want    ExchangeRates
+		wantErr bool
+	}{
+		{
+			name:    "failure - empty base currency",
+			base:    " ",
+			table:   "USD:80",
+			want:    ExchangeRates{},
+			wantErr: true,
+		},
+		{
+			name:    "failure - missing rate",
+			base:    "INR",
+			table:   "USD",
+			want:    ExchangeRates{},
+			wantErr: true,
+		},
+		{
+			name:    "failure - invalid rate",
+			base:    "INR",
+			table:   "USD:-80",
+			want:    ExchangeRates{},
+			wantErr: true,
+		},
+		{
+			name:    "success",
+			base:    "inr",
+			table:   "usd:80; EUR:90.5;",
+			want:    ExchangeRates{Base: "INR", Rates: map[string]float64{"INR": 1, "USD": 80, "EUR": 90.5}},
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewExchangeRates(tt.base, tt.table)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("NewExchangeRates() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("NewExchangeRates() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestService_CreateJobByCompanyId_salary(t *testing.T) {
+	tests := []struct {
+		name          string
+		jobDetails    model.NewJobs
+		wantMin       *float64
+		wantMax       *float64
+		wantFieldErrs []model.FieldError
+	}{
+		{
+			name:       "no salary",
+			jobDetails: model.NewJobs{Jobname: "golang developer"},
+		},
+		{
+			name:       "undisclosed salary",
+			jobDetails: model.NewJobs{Jobname: "golang developer", SalaryUndisclosed: true},
+		},
+		{
+			name:       "hourly salary in another currency",
+			jobDetails: model.NewJobs{Jobname: "golang developer", MinSalary: 10, MaxSalary: 20, Currency: "usd", PayPeriod: model.PayPeriodHourly},
+			wantMin:    floatPointer(10 * 2080 * 80),
+			wantMax:    floatPointer(20 * 2080 * 80),
+		},
+		{
+			name:       "fixed monthly salary",
+			jobDetails: model.NewJobs{Jobname: "golang developer", MinSalary: 50000, Currency: "INR", PayPeriod: model.PayPeriodMonthly},
+			wantMin:    floatPointer(600000),
+			wantMax:    floatPointer(600000),
+		},
+		{
+			name:       "invalid salary",
+			jobDetails: model.NewJobs{Jobname: "golang developer", MinSalary: 30, MaxSalary: 20, Currency: "JPY"},
+			wantFieldErrs: []model.FieldError{
+				{Field: "minSalary", Message: "cannot be greater than maxSalary"},
+				{Field: "currency", Message: "no exchange rate for JPY"},
+				{Field: "payPeriod", Message: "is required when a salary is given"},
+			},
+		},
+		{
+			name:       "salary on an undisclosed job",
+			jobDetails: model.NewJobs{Jobname: "golang developer", MinSalary: 30, Currency: "INR", PayPeriod: model.PayPeriodYearly, SalaryUndisclosed: true},
+			wantFieldErrs: []model.FieldError{
+				{Field: "salaryUndisclosed", Message: "cannot be set together with a salary"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			mj := repository.NewMockJobRepository(mc)
+			mcr := repository.NewMockComapnyRepo(mc)
+			mt := repository.NewMockTaxonomyRepository(mc)
+			mca := cache.NewMockCaching(mc)
+			s, _ := NewJobService(mj, mcr, mt, mca, testRates)
+			mcr.EXPECT().GetCompanyByID(gomock.Any()).Return(model.Company{OwnerID: 8}, nil).AnyTimes()
+			mt.EXPECT().FindMissingTaxonomyIDs(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+
+			var saved model.Job
+			mj.EXPECT().CreateJob(gomock.Any()).DoAndReturn(func(jobData model.Job) (model.Response, error) {
+				saved = jobData
+				return model.Response{Id: 1}, nil
+			}).AnyTimes()
+
+			_, err := s.CreateJobByCompanyId(8, tt.jobDetails, 1)
+			if tt.wantFieldErrs != nil {
+				var validationErr *ValidationError
+				if !errors.As(err, &validationErr) {
+					t.Fatalf("Service.CreateJobByCompanyId() error = %v, want a validation error", err)
+				}
+				if !reflect.DeepEqual(validationErr.Errors, tt.wantFieldErrs) {
+					t.Errorf("Service.CreateJobByCompanyId() field errors = %v, want %v", validationErr.Errors, tt.wantFieldErrs)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Service.CreateJobByCompanyId() error = %v", err)
+			}
+			if !reflect.DeepEqual(saved.NormalisedMinSalary, tt.wantMin) || !reflect.DeepEqual(saved.NormalisedMaxSalary, tt.wantMax) {
+				t.Errorf("Service.CreateJobByCompanyId() normalised salary = %v - %v, want %v - %v", saved.NormalisedMinSalary, saved.NormalisedMaxSalary, tt.wantMin, tt.wantMax)
+			}
+		})
+	}
+}
+
+func floatPointer(v float64) *float64 {
+	return &v
+}
@@ -2,91 +2,69 @@ package service
 
 import (
 	"context"
+	"encoding/base64"
 	"encoding/json"
+	"errors"
+	"io"
 	"job-portal-api/internal/cache"
 	"job-portal-api/internal/model"
 	"job-portal-api/internal/repository"
+	"strconv"
+	"strings"
 	"sync"
+	"time"
 
 	"github.com/rs/zerolog/log"
+	"golang.org/x/sync/singleflight"
 	"gorm.io/gorm"
 )
 
 //go:generate mockgen -source=jobService.go -destination=jobService_mock.go -package=service
 type JobService interface {
-	CreateJobByCompanyId(jobdata model.NewJobs, cID uint) (model.Response, error)
+	CreateJobByCompanyId(userID uint, jobdata model.NewJobs, cID uint) (model.Response, error)
 	ViewJobByCompanyID(cID uint) ([]model.Job, error)
 	ViewJobByJobID(jID uint) (model.Job, error)
-	ViewAllJobs() ([]model.Job, error)
-	ProcessApplication(applications []model.NewUserApplication) []model.NewUserApplication
+	ViewAllJobs(filter model.JobFilter) (model.JobPage, error)
+	SearchJobs(query string, filter model.JobFilter, offset int) (model.JobSearchPage, error)
+	UpdateJobByJobID(userID uint, jID uint, jobDetails model.UpdateJob) (model.Response, error)
+	DeleteJobByJobID(userID uint, jID uint) error
+	ChangeJobStatus(userID uint, jID uint, status string) (model.Response, error)
+	ExpireJobs() (int, error)
+	ViewJobRevisions(jID uint) ([]model.JobRevision, error)
+	DiffJobRevisions(jID uint, from int, to int) (model.JobRevisionDiff, error)
+	RestoreJobRevision(userID uint, jID uint, revision int) (model.Response, error)
+	ImportJobs(userID uint, cID uint, format string, data io.Reader, dryRun bool) (model.ImportResult, error)
+	ProcessApplication(ctx context.Context, applications []model.NewUserApplication) []model.ScreenedApplication
+	StreamApplications(ctx context.Context, applications <-chan model.StreamedApplication, emit func(model.ScreeningStreamResult))
 }
 
-func NewJobService(jobService repository.JobRepository, rdb cache.Caching) (JobService, error) {
+func NewJobService(jobService repository.JobRepository, companyRepo repository.ComapnyRepo, taxonomyRepo repository.TaxonomyRepository, rdb cache.Caching, rates ExchangeRates) (JobService, error) {
 	if jobService == nil {
 		log.Info().Msg("jobservice cannot be nil")
 	}
 	return &Service{
-		jobRepo: jobService,
-		rdb:     rdb,
+		jobRepo:      jobService,
+		comapnayRepo: companyRepo,
+		taxonomyRepo: taxonomyRepo,
+		rdb:          rdb,
+		rates:        rates,
 	}, nil
 }
 
-func (s *Service) CreateJobByCompanyId(jobDetails model.NewJobs, cID uint) (model.Response, error) {
+func (s *Service) CreateJobByCompanyId(userID uint, jobDetails model.NewJobs, cID uint) (model.Response, error) {
 
-	jobData := model.Job{
-		Cid:             cID,
-		Jobname:         jobDetails.Jobname,
-		MinNoticePeriod: jobDetails.MinNoticePeriod,
-		MaxNoticePeriod: jobDetails.MaxNoticePeriod,
-		Description:     jobDetails.Description,
-		MinExperience:   jobDetails.MinExperience,
-		MaxExperience:   jobDetails.MaxExperience,
-	}
-
-	for _, v := range jobDetails.Jobtype {
-		jobtype := model.JobType{
-			Model: gorm.Model{
-				ID: v,
-			},
-		}
-		jobData.Jobtype = append(jobData.Jobtype, jobtype)
-	}
+	jobData := newJob(jobDetails, cID)
 
-	for _, v := range jobDetails.Location {
-		jobLocation := model.Location{
-			Model: gorm.Model{
-				ID: v,
-			},
-		}
-		jobData.Location = append(jobData.Location, jobLocation)
-	}
-
-	for _, v := range jobDetails.TechnologyStack {
-		jobSkill := model.TechnologyStack{
-			Model: gorm.Model{
-				ID: v,
-			},
-		}
-		jobData.TechnologyStack = append(jobData.TechnologyStack, jobSkill)
-	}
-
-	for _, v := range jobDetails.Qualifications {
-		jobQualification := model.Qualification{
-			Model: gorm.Model{
-				ID: v,
-			},
-		}
-		jobData.Qualifications = append(jobData.Qualifications, jobQualification)
+	err := s.validateJob(jobData, true)
+	if err != nil {
+		return model.Response{}, err
 	}
 
-	for _, v := range jobDetails.Shift {
-		jobShift := model.Shift{
-			Model: gorm.Model{
-				ID: v,
-			},
-		}
-		jobData.Shift = append(jobData.Shift, jobShift)
+	err = s.authorizeCompany(userID, cID)
+	if err != nil {
+		return model.Response{}, err
 	}
+	s.normaliseSalary(&jobData)
 
 	responseData, err := s.jobRepo.CreateJob(jobData)
 	if err != nil {
@@ -97,6 +75,49 @@ func (s *Service) CreateJobByCompanyId(jobDetails model.NewJobs, cID uint) (mode
 
 }
 
+// newJob builds the job for a company from the posted details, filling in
+// the defaults for status and work mode.
+func newJob(jobDetails model.NewJobs, cID uint) model.Job {
+	jobData := model.Job{
+		Cid:               cID,
+		Jobname:           jobDetails.Jobname,
+		MinNoticePeriod:   jobDetails.MinNoticePeriod,
+		MaxNoticePeriod:   jobDetails.MaxNoticePeriod,
+		Description:       jobDetails.Description,
+		MinExperience:     jobDetails.MinExperience,
+		MaxExperience:     jobDetails.MaxExperience,
+		Status:            jobDetails.Status,
+		ExpiresAt:         jobDetails.ExpiresAt,
+		WorkMode:          jobDetails.WorkMode,
+		MinSalary:         jobDetails.MinSalary,
+		MaxSalary:         jobDetails.MaxSalary,
+		Currency:          strings.ToUpper(jobDetails.Currency),
+		PayPeriod:         jobDetails.PayPeriod,
+		SalaryUndisclosed: jobDetails.SalaryUndisclosed,
+		SkillRequirements: jobDetails.SkillRequirements,
+		ScoringPolicy:     jobDetails.ScoringPolicy,
+	}
+
+	if jobData.Status == "" {
+		jobData.Status = model.JobStatusPublished
+	}
+	if jobData.WorkMode == "" {
+		jobData.WorkMode = model.WorkModeOnsite
+	}
+	if jobData.Status == model.JobStatusPublished {
+		now := time.Now()
+		jobData.PublishedAt = &now
+	}
+
+	jobData.Jobtype = toJobTypes(jobDetails.Jobtype)
+	jobData.Location = toLocations(jobDetails.Location)
+	jobData.TechnologyStack = toTechnologyStacks(jobDetails.TechnologyStack)
+	jobData.Qualifications = toQualifications(jobDetails.Qualifications)
+	jobData.Shift = toShifts(jobDetails.Shift)
+
+	return jobData
+}
+
 func (s *Service) ViewJobByCompanyID(cID uint) ([]model.Job, error) {
 
 	jobData, err := s.jobRepo.GetJobByCompanyID(cID)
@@ -117,152 +138,527 @@ func (s *Service) ViewJobByJobID(jID uint) (model.Job, error) {
 	return jobData, nil
 }
 
-func (s *Service) ViewAllJobs() ([]model.Job, error) {
-	jobData, err := s.jobRepo.GetAllJobs()
+const (
+	defaultJobPageSize = 20
+	maxJobPageSize     = 100
+)
+
+func (s *Service) ViewAllJobs(filter model.JobFilter) (model.JobPage, error) {
+
+	switch filter.Sort {
+	case "":
+		filter.Sort = model.JobSortNewest
+	case model.JobSortNewest, model.JobSortExperience, model.JobSortSalary:
+	default:
+		log.Error().Str("sort", filter.Sort).Msg("invalid sort option")
+		return model.JobPage{}, errors.New("invalid sort option")
+	}
+
+	err := validateJobFilter(filter)
 	if err != nil {
-		return nil, err
+		return model.JobPage{}, err
 	}
 
-	return jobData, nil
+	limit := jobPageSize(filter.Limit)
+
+	var after *model.JobCursor
+	if filter.Cursor != "" {
+		cursor, err := decodeJobCursor(filter.Cursor)
+		if err != nil {
+			return model.JobPage{}, err
+		}
+		if cursor.Sort != filter.Sort {
+			log.Error().Msg("cursor does not belong to the requested sort order")
+			return model.JobPage{}, errors.New("invalid cursor")
+		}
+		after = &cursor
+	}
+
+	filter.Limit = limit + 1
+	jobData, err := s.jobRepo.FilterJobs(filter, after)
+	if err != nil {
+		return model.JobPage{}, err
+	}
+
+	jobData, next := pageOf(jobData, 0, limit)
+	page := model.JobPage{
+		Jobs: jobData,
+	}
+	if next != nil {
+		last := page.Jobs[limit-1]
+		cursor := model.JobCursor{
+			Sort:          filter.Sort,
+			ID:            last.ID,
+			CreatedAt:     last.CreatedAt,
+			MinExperience: last.MinExperience,
+		}
+		if filter.Sort == model.JobSortSalary {
+			cursor.Salary = salarySortKey(last)
+		}
+		page.NextCursor, err = encodeJobCursor(cursor)
+		if err != nil {
+			return model.JobPage{}, err
+		}
+	}
+
+	return page, nil
 }
 
-func (s *Service) ProcessApplication(applications []model.NewUserApplication) []model.NewUserApplication {
-	ctx := context.Background()
-	wg := new(sync.WaitGroup)
-	ch := make(chan model.NewUserApplication)
-	var finalData []model.NewUserApplication
+func (s *Service) SearchJobs(query string, filter model.JobFilter, offset int) (model.JobSearchPage, error) {
 
-	for _, v := range applications {
-		wg.Add(1)
-		go func(application model.NewUserApplication) {
-			defer wg.Done()
+	query = strings.TrimSpace(query)
+	if query == "" {
+		log.Error().Msg("empty search query")
+		return model.JobSearchPage{}, errors.New("search query cannot be empty")
+	}
+	if offset < 0 {
+		log.Error().Int("offset", offset).Msg("invalid search offset")
+		return model.JobSearchPage{}, errors.New("invalid offset")
+	}
+	err := validateJobFilter(filter)
+	if err != nil {
+		return model.JobSearchPage{}, err
+	}
 
-			var jobData model.Job
+	limit := jobPageSize(filter.Limit)
 
-			val, err := s.rdb.GetTheCacheData(ctx, application.Jid)
+	filter.Limit = limit + 1
+	results, err := s.jobRepo.SearchJobs(query, filter, offset)
+	if err != nil {
+		return model.JobSearchPage{}, err
+	}
 
-			if err != nil {
-				jobDataFromDB, err := s.jobRepo.GetJobByJobID(application.Jid)
-				if err != nil {
-					log.Error().Err(err).Msg("invalid application job id does not exists")
-					return
-				}
-				err = s.rdb.AddToTheCache(ctx, application.Jid, jobDataFromDB)
-				if err != nil {
-					return
-				}
-				jobData = jobDataFromDB
+	var page model.JobSearchPage
+	page.Results, page.NextOffset = pageOf(results, offset, limit)
 
-			} else {
-				err = json.Unmarshal([]byte(val), &jobData)
-				if err != nil {
-					log.Error().Err(err).Msg("error in un marshaling")
-					return
-				}
-			}
-			check := CompareData(application, jobData)
+	return page, nil
+}
 
-			if check {
-				ch <- application
-			}
+// validateJobFilter checks the parts of the listing filter that the query
+// cannot make sense of on its own.
+func validateJobFilter(filter model.JobFilter) error {
+	for _, v := range filter.WorkMode {
+		switch v {
+		case model.WorkModeOnsite, model.WorkModeRemote, model.WorkModeHybrid:
+		default:
+			log.Error().Str("work mode", v).Msg("invalid work mode")
+			return errors.New("invalid work mode")
+		}
+	}
 
-		}(v)
+	if filter.Latitude == nil && filter.Longitude == nil && filter.RadiusKm == nil {
+		return nil
 	}
+	if filter.Latitude == nil || filter.Longitude == nil || filter.RadiusKm == nil {
+		log.Error().Msg("radius search needs a latitude, a longitude and a radius")
+		return errors.New("radius search needs a latitude, a longitude and a radius")
+	}
+	if *filter.Latitude < -90 || *filter.Latitude > 90 || *filter.Longitude < -180 || *filter.Longitude > 180 {
+		log.Error().Msg("invalid coordinates")
+		return errors.New("invalid coordinates")
+	}
+	if *filter.RadiusKm <= 0 {
+		log.Error().Msg("invalid radius")
+		return errors.New("radius must be greater than zero")
+	}
+	return nil
+}
 
-	go func() {
-		wg.Wait()
-		close(ch)
-	}()
+// salarySortKey is the value the salary sort orders by, jobs without a
+// disclosed salary go last.
+func salarySortKey(jobData model.Job) float64 {
+	if jobData.NormalisedMaxSalary == nil {
+		return -1
+	}
+	return *jobData.NormalisedMaxSalary
+}
 
-	for v := range ch {
-		finalData = append(finalData, v)
+func jobPageSize(limit int) int {
+	if limit <= 0 {
+		return defaultJobPageSize
+	}
+	if limit > maxJobPageSize {
+		return maxJobPageSize
 	}
+	return limit
+}
 
-	return finalData
+// pageOf cuts rows fetched with limit+1 down to one page. The extra row
+// only tells whether there is a next page, when there is its offset is
+// returned as well.
+func pageOf[T any](rows []T, offset int, limit int) ([]T, *int) {
+	if rows == nil {
+		return []T{}, nil
+	}
+	if len(rows) <= limit {
+		return rows, nil
+	}
+	nextOffset := offset + limit
+	return rows[:limit], &nextOffset
 }
 
-func CompareData(application model.NewUserApplication, jobData model.Job) bool {
-	totalFields := 0
-	matchedFields := 0
+func encodeJobCursor(cursor model.JobCursor) (string, error) {
+	val, err := json.Marshal(cursor)
+	if err != nil {
+		log.Error().Err(err).Msg("error in marshaling cursor")
+		return "", errors.New("could not encode cursor")
+	}
+	return base64.RawURLEncoding.EncodeToString(val), nil
+}
+
+func decodeJobCursor(cursor string) (model.JobCursor, error) {
+	var jobCursor model.JobCursor
 
-	totalFields++
-	if application.Jobs.NoticePeriod >= jobData.MinNoticePeriod && application.Jobs.NoticePeriod <= int(jobData.MaxNoticePeriod) {
-		matchedFields++
+	val, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		log.Error().Err(err).Msg("error in decoding cursor")
+		return model.JobCursor{}, errors.New("invalid cursor")
 	}
 
-	totalFields++
-	if application.Jobs.Experience >= jobData.MinExperience && application.Jobs.Experience <= int(jobData.MaxExperience) {
-		matchedFields++
+	err = json.Unmarshal(val, &jobCursor)
+	if err != nil {
+		log.Error().Err(err).Msg("error in un marshaling cursor")
+		return model.JobCursor{}, errors.New("invalid cursor")
 	}
 
-	count := 0
-	totalFields++
-	for _, v := range application.Jobs.Location {
-		for _, v1 := range jobData.Location {
-			if v == v1.ID {
-				count++
-			}
-		}
+	return jobCursor, nil
+}
+
+func (s *Service) UpdateJobByJobID(userID uint, jID uint, jobDetails model.UpdateJob) (model.Response, error) {
+
+	jobData, err := s.jobRepo.GetJobByJobID(jID)
+	if err != nil {
+		return model.Response{}, err
 	}
-	if count != 0 {
-		matchedFields++
+
+	err = s.authorizeCompany(userID, jobData.Cid)
+	if err != nil {
+		return model.Response{}, err
 	}
 
-	count = 0
-	totalFields++
-	for _, v := range application.Jobs.TechnologyStack {
-		for _, v1 := range jobData.TechnologyStack {
-			if v == v1.ID {
-				count++
-			}
+	if jobDetails.Jobname != nil {
+		jobData.Jobname = *jobDetails.Jobname
+	}
+	if jobDetails.MinNoticePeriod != nil {
+		jobData.MinNoticePeriod = *jobDetails.MinNoticePeriod
+	}
+	if jobDetails.MaxNoticePeriod != nil {
+		jobData.MaxNoticePeriod = *jobDetails.MaxNoticePeriod
+	}
+	if jobDetails.Description != nil {
+		jobData.Description = *jobDetails.Description
+	}
+	if jobDetails.MinExperience != nil {
+		jobData.MinExperience = *jobDetails.MinExperience
+	}
+	if jobDetails.MaxExperience != nil {
+		jobData.MaxExperience = *jobDetails.MaxExperience
+	}
+	if jobDetails.Location != nil {
+		jobData.Location = toLocations(*jobDetails.Location)
+	}
+	if jobDetails.TechnologyStack != nil {
+		jobData.TechnologyStack = toTechnologyStacks(*jobDetails.TechnologyStack)
+	}
+	if jobDetails.Qualifications != nil {
+		jobData.Qualifications = toQualifications(*jobDetails.Qualifications)
+	}
+	if jobDetails.Shift != nil {
+		jobData.Shift = toShifts(*jobDetails.Shift)
+	}
+	if jobDetails.Jobtype != nil {
+		jobData.Jobtype = toJobTypes(*jobDetails.Jobtype)
+	}
+	if jobDetails.ExpiresAt != nil || jobDetails.ClearExpiresAt {
+		jobData.ExpiresAt = jobDetails.ExpiresAt
+	}
+	if jobDetails.WorkMode != nil {
+		jobData.WorkMode = *jobDetails.WorkMode
+		if jobData.WorkMode == "" {
+			jobData.WorkMode = model.WorkModeOnsite
 		}
 	}
-	if count != 0 {
-		matchedFields++
+	if jobDetails.MinSalary != nil {
+		jobData.MinSalary = *jobDetails.MinSalary
+	}
+	if jobDetails.MaxSalary != nil {
+		jobData.MaxSalary = *jobDetails.MaxSalary
+	}
+	if jobDetails.Currency != nil {
+		jobData.Currency = strings.ToUpper(*jobDetails.Currency)
+	}
+	if jobDetails.PayPeriod != nil {
+		jobData.PayPeriod = *jobDetails.PayPeriod
+	}
+	if jobDetails.SalaryUndisclosed != nil {
+		jobData.SalaryUndisclosed = *jobDetails.SalaryUndisclosed
+	}
+	if jobDetails.SkillRequirements != nil {
+		jobData.SkillRequirements = *jobDetails.SkillRequirements
+	}
+	if jobDetails.ScoringPolicy != nil {
+		jobData.ScoringPolicy = *jobDetails.ScoringPolicy
 	}
 
-	count = 0
-	totalFields++
-	for _, v := range application.Jobs.Qualifications {
-		for _, v1 := range jobData.Qualifications {
-			if v == v1.ID {
-				count++
-			}
-		}
+	err = s.validateJob(jobData, jobDetails.ExpiresAt != nil)
+	if err != nil {
+		return model.Response{}, err
 	}
-	if count != 0 {
-		matchedFields++
+	s.normaliseSalary(&jobData)
+
+	jobData, err = s.jobRepo.UpdateJob(jobData)
+	if err != nil {
+		return model.Response{}, referenceError(err)
 	}
 
-	count = 0
-	totalFields++
-	for _, v := range application.Jobs.Shift {
-		for _, v1 := range jobData.Shift {
-			if v == v1.ID {
-				count++
+	s.invalidateJobCache(jID)
+
+	return model.Response{
+		Id: jobData.ID,
+	}, nil
+}
+
+func (s *Service) DeleteJobByJobID(userID uint, jID uint) error {
+
+	err := s.authorizeRecruiter(userID, jID)
+	if err != nil {
+		return err
+	}
+
+	err = s.jobRepo.DeleteJob(jID)
+	if err != nil {
+		return err
+	}
+
+	s.invalidateJobCache(jID)
+
+	return nil
+}
+
+// invalidateJobCache drops the cached copy of a job so that screening does
+// not keep matching applications against the old posting. The database is
+// already updated at this point, so a redis failure is only logged.
+func (s *Service) invalidateJobCache(jID uint) {
+	ctx := context.Background()
+	err := s.rdb.DeleteTheCacheData(ctx, jID)
+	if err != nil {
+		log.Error().Err(err).Uint("job id", jID).Msg("error in invalidating cached job")
+	}
+	s.invalidateShortlist(jID)
+}
+
+// screeningWorkers bounds how many applications of one request are screened
+// at the same time.
+const screeningWorkers = 8
+
+// ProcessApplication screens every application against its job. Accepted and
+// rejected applications are both returned with their results, in the order
+// they were given. Applications for unknown or closed jobs are left out, and
+// the work stops early when ctx is cancelled.
+func (s *Service) ProcessApplication(ctx context.Context, applications []model.NewUserApplication) []model.ScreenedApplication {
+	results := make([]*model.ScreenedApplication, len(applications))
+	s.screenApplications(ctx, applications, func(i int, screened model.ScreenedApplication, err error) {
+		if err == nil {
+			results[i] = &screened
+		}
+	})
+
+	var finalData []model.ScreenedApplication
+	for _, v := range results {
+		if v != nil {
+			finalData = append(finalData, *v)
+		}
+	}
+
+	return finalData
+}
+
+// screenApplications screens the applications with a bounded number of
+// workers and calls done from the workers as each one finishes. Nothing new
+// is started once ctx is cancelled.
+func (s *Service) screenApplications(ctx context.Context, applications []model.NewUserApplication, done func(i int, screened model.ScreenedApplication, err error)) {
+	queue := make(chan queuedApplication)
+	go func() {
+		defer close(queue)
+		for i, v := range applications {
+			select {
+			case <-ctx.Done():
+				log.Info().Err(ctx.Err()).Msg("application screening cancelled")
+				return
+			case queue <- queuedApplication{index: i, application: v}:
 			}
 		}
+	}()
+
+	s.screenQueue(ctx, queue, done)
+}
+
+type queuedApplication struct {
+	index       int
+	application model.NewUserApplication
+}
+
+// screenQueue screens applications from queue until it is closed. The jobs
+// are loaded once for the whole queue.
+func (s *Service) screenQueue(ctx context.Context, queue <-chan queuedApplication, done func(i int, screened model.ScreenedApplication, err error)) {
+	jobs := newBatchJobs(s)
+
+	wg := new(sync.WaitGroup)
+	for i := 0; i < screeningWorkers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for v := range queue {
+				if ctx.Err() != nil {
+					continue
+				}
+				screened, err := s.screenApplication(ctx, jobs, v.application)
+				done(v.index, screened, err)
+			}
+		}()
 	}
-	if count != 0 {
-		matchedFields++
+	wg.Wait()
+}
+
+func (s *Service) screenApplication(ctx context.Context, jobs *batchJobs, application model.NewUserApplication) (model.ScreenedApplication, error) {
+	jobData, err := jobs.get(ctx, application.Jid)
+	if err != nil {
+		return model.ScreenedApplication{}, errors.New("could not find the job")
+	}
+
+	if !acceptingApplications(jobData) {
+		log.Info().Uint("job id", application.Jid).Msg("job is not accepting applications")
+		return model.ScreenedApplication{}, errors.New("job is not accepting applications")
 	}
 
-	count = 0
-	totalFields++
-	for _, v := range application.Jobs.Jobtype {
-		for _, v1 := range jobData.Jobtype {
-			if v == v1.ID {
-				count++
+	return model.ScreenedApplication{
+		NewUserApplication: application,
+		Screening:          CompareData(application, jobData),
+	}, nil
+}
+
+// batchJobs loads every job of a batch once, however many applications
+// share it. Concurrent loads of the same job wait for the first one.
+type batchJobs struct {
+	s     *Service
+	group singleflight.Group
+	mu    sync.Mutex
+	jobs  map[uint]batchJob
+}
+
+type batchJob struct {
+	job model.Job
+	err error
+}
+
+func newBatchJobs(s *Service) *batchJobs {
+	return &batchJobs{
+		s:    s,
+		jobs: make(map[uint]batchJob),
+	}
+}
+
+func (b *batchJobs) get(ctx context.Context, jID uint) (model.Job, error) {
+	for {
+		b.mu.Lock()
+		loaded, ok := b.jobs[jID]
+		b.mu.Unlock()
+		if ok {
+			return loaded.job, loaded.err
+		}
+
+		loadedHere := false
+		val, err, _ := b.group.Do(strconv.FormatUint(uint64(jID), 10), func() (interface{}, error) {
+			loadedHere = true
+			jobData, err := b.s.cachedJob(ctx, jID)
+			//a cancelled request says nothing about the job, it is loaded again
+			//by the next caller instead of failing the rest of the batch
+			if !isContextError(err) {
+				b.mu.Lock()
+				b.jobs[jID] = batchJob{job: jobData, err: err}
+				b.mu.Unlock()
 			}
+			return jobData, err
+		})
+		if isContextError(err) && !loadedHere && ctx.Err() == nil {
+			continue
+		}
+		if err != nil {
+			return model.Job{}, err
+		}
+		return val.(model.Job), nil
+	}
+}
+
+func isContextError(err error) bool {
+	return errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded)
+}
+
+// cachedJob returns the job from redis, loading it from the database on a
+// miss. The cache only saves work, when redis fails the job is still
+// returned from the database.
+func (s *Service) cachedJob(ctx context.Context, jID uint) (model.Job, error) {
+	var jobData model.Job
+
+	val, err := s.rdb.GetTheCacheData(ctx, jID)
+	if err == nil {
+		err = json.Unmarshal([]byte(val), &jobData)
+		if err == nil {
+			return jobData, nil
 		}
+		log.Error().Err(err).Uint("job id", jID).Msg("error in un marshaling cached job")
 	}
-	if count != 0 {
-		matchedFields++
+
+	jobData, err = s.jobRepo.GetJobByJobID(jID)
+	if err != nil {
+		log.Error().Err(err).Msg("invalid application job id does not exists")
+		return model.Job{}, err
 	}
 
-	if matchedFields*2 >= totalFields {
-		return true
+	err = s.rdb.AddToTheCache(ctx, jID, jobData)
+	if err != nil {
+		log.Error().Err(err).Uint("job id", jID).Msg("error in caching job")
 	}
 
-	return false
+	return jobData, nil
+}
+
+func toLocations(ids []uint) []model.Location {
+	var locations []model.Location
+	for _, v := range ids {
+		locations = append(locations, model.Location{Model: gorm.Model{ID: v}})
+	}
+	return locations
+}
+
+func toTechnologyStacks(ids []uint) []model.TechnologyStack {
+	var stacks []model.TechnologyStack
+	for _, v := range ids {
+		stacks = append(stacks, model.TechnologyStack{Model: gorm.Model{ID: v}})
+	}
+	return stacks
+}
+
+func toQualifications(ids []uint) []model.Qualification {
+	var qualifications []model.Qualification
+	for _, v := range ids {
+		qualifications = append(qualifications, model.Qualification{Model: gorm.Model{ID: v}})
+	}
+	return qualifications
+}
+
+func toShifts(ids []uint) []model.Shift {
+	var shifts []model.Shift
+	for _, v := range ids {
+		shifts = append(shifts, model.Shift{Model: gorm.Model{ID: v}})
+	}
+	return shifts
+}
+
+func toJobTypes(ids []uint) []model.JobType {
+	var jobTypes []model.JobType
+	for _, v := range ids {
+		jobTypes = append(jobTypes, model.JobType{Model: gorm.Model{ID: v}})
+	}
+	return jobTypes
 }
@@ -9,6 +9,8 @@
 package service
 
 import (
+	context "context"
+	io "io"
 	model "job-portal-api/internal/model"
 	reflect "reflect"
 
@@ -38,48 +40,179 @@ func (m *MockJobService) EXPECT() *MockJobServiceMockRecorder {
 	return m.recorder
 }
 
+// ChangeJobStatus mocks base method.
+func (m *MockJobService) ChangeJobStatus(userID, jID uint, status string) (model.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ChangeJobStatus", userID, jID, status)
+	ret0, _ := ret[0].(model.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ChangeJobStatus indicates an expected call of ChangeJobStatus.
+func (mr *MockJobServiceMockRecorder) ChangeJobStatus(userID, jID, status any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangeJobStatus", reflect.TypeOf((*MockJobService)(nil).ChangeJobStatus), userID, jID, status)
+}
+
 // CreateJobByCompanyId mocks base method.
-func (m *MockJobService) CreateJobByCompanyId(jobdata model.NewJobs, cID uint) (model.Response, error) {
+func (m *MockJobService) CreateJobByCompanyId(userID uint, jobdata model.NewJobs, cID uint) (model.Response, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateJobByCompanyId", jobdata, cID)
+	ret := m.ctrl.Call(m, "CreateJobByCompanyId", userID, jobdata, cID)
 	ret0, _ := ret[0].(model.Response)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // CreateJobByCompanyId indicates an expected call of CreateJobByCompanyId.
-func (mr *MockJobServiceMockRecorder) CreateJobByCompanyId(jobdata, cID any) *gomock.Call {
+func (mr *MockJobServiceMockRecorder) CreateJobByCompanyId(userID, jobdata, cID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateJobByCompanyId", reflect.TypeOf((*MockJobService)(nil).CreateJobByCompanyId), jobdata, cID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateJobByCompanyId", reflect.TypeOf((*MockJobService)(nil).CreateJobByCompanyId), userID, jobdata, cID)
+}
+
+// DeleteJobByJobID mocks base method.
+func (m *MockJobService) DeleteJobByJobID(userID, jID uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteJobByJobID", userID, jID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteJobByJobID indicates an expected call of DeleteJobByJobID.
+func (mr *MockJobServiceMockRecorder) DeleteJobByJobID(userID, jID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteJobByJobID", reflect.TypeOf((*MockJobService)(nil).DeleteJobByJobID), userID, jID)
+}
+
+// DiffJobRevisions mocks base method.
+func (m *MockJobService) DiffJobRevisions(jID uint, from, to int) (model.JobRevisionDiff, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DiffJobRevisions", jID, from, to)
+	ret0, _ := ret[0].(model.JobRevisionDiff)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DiffJobRevisions indicates an expected call of DiffJobRevisions.
+func (mr *MockJobServiceMockRecorder) DiffJobRevisions(jID, from, to any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DiffJobRevisions", reflect.TypeOf((*MockJobService)(nil).DiffJobRevisions), jID, from, to)
+}
+
+// ExpireJobs mocks base method.
+func (m *MockJobService) ExpireJobs() (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExpireJobs")
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExpireJobs indicates an expected call of ExpireJobs.
+func (mr *MockJobServiceMockRecorder) ExpireJobs() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExpireJobs", reflect.TypeOf((*MockJobService)(nil).ExpireJobs))
+}
+
+// ImportJobs mocks base method.
+func (m *MockJobService) ImportJobs(userID, cID uint, format string, data io.Reader, dryRun bool) (model.ImportResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImportJobs", userID, cID, format, data, dryRun)
+	ret0, _ := ret[0].(model.ImportResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ImportJobs indicates an expected call of ImportJobs.
+func (mr *MockJobServiceMockRecorder) ImportJobs(userID, cID, format, data, dryRun any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportJobs", reflect.TypeOf((*MockJobService)(nil).ImportJobs), userID, cID, format, data, dryRun)
 }
 
 // ProcessApplication mocks base method.
-func (m *MockJobService) ProcessApplication(applications []model.NewUserApplication) []model.NewUserApplication {
+func (m *MockJobService) ProcessApplication(ctx context.Context, applications []model.NewUserApplication) []model.ScreenedApplication {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ProcessApplication", applications)
-	ret0, _ := ret[0].([]model.NewUserApplication)
+	ret := m.ctrl.Call(m, "ProcessApplication", ctx, applications)
+	ret0, _ := ret[0].([]model.ScreenedApplication)
 	return ret0
 }
 
 // ProcessApplication indicates an expected call of ProcessApplication.
-func (mr *MockJobServiceMockRecorder) ProcessApplication(applications any) *gomock.Call {
+func (mr *MockJobServiceMockRecorder) ProcessApplication(ctx, applications any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProcessApplication", reflect.TypeOf((*MockJobService)(nil).ProcessApplication), applications)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProcessApplication", reflect.TypeOf((*MockJobService)(nil).ProcessApplication), ctx, applications)
+}
+
+// RestoreJobRevision mocks base method.
+func (m *MockJobService) RestoreJobRevision(userID, jID uint, revision int) (model.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreJobRevision", userID, jID, revision)
+	ret0, _ := ret[0].(model.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RestoreJobRevision indicates an expected call of RestoreJobRevision.
+func (mr *MockJobServiceMockRecorder) RestoreJobRevision(userID, jID, revision any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreJobRevision", reflect.TypeOf((*MockJobService)(nil).RestoreJobRevision), userID, jID, revision)
+}
+
+// SearchJobs mocks base method.
+func (m *MockJobService) SearchJobs(query string, filter model.JobFilter, offset int) (model.JobSearchPage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchJobs", query, filter, offset)
+	ret0, _ := ret[0].(model.JobSearchPage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchJobs indicates an expected call of SearchJobs.
+func (mr *MockJobServiceMockRecorder) SearchJobs(query, filter, offset any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchJobs", reflect.TypeOf((*MockJobService)(nil).SearchJobs), query, filter, offset)
+}
+
+// StreamApplications mocks base method.
+func (m *MockJobService) StreamApplications(ctx context.Context, applications <-chan model.StreamedApplication, emit func(model.ScreeningStreamResult)) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "StreamApplications", ctx, applications, emit)
+}
+
+// StreamApplications indicates an expected call of StreamApplications.
+func (mr *MockJobServiceMockRecorder) StreamApplications(ctx, applications, emit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StreamApplications", reflect.TypeOf((*MockJobService)(nil).StreamApplications), ctx, applications, emit)
+}
+
+// UpdateJobByJobID mocks base method.
+func (m *MockJobService) UpdateJobByJobID(userID, jID uint, jobDetails model.UpdateJob) (model.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateJobByJobID", userID, jID, jobDetails)
+	ret0, _ := ret[0].(model.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateJobByJobID indicates an expected call of UpdateJobByJobID.
+func (mr *MockJobServiceMockRecorder) UpdateJobByJobID(userID, jID, jobDetails any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateJobByJobID", reflect.TypeOf((*MockJobService)(nil).UpdateJobByJobID), userID, jID, jobDetails)
 }
 
 // ViewAllJobs mocks base method.
-func (m *MockJobService) ViewAllJobs() ([]model.Job, error) {
+func (m *MockJobService) ViewAllJobs(filter model.JobFilter) (model.JobPage, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ViewAllJobs")
-	ret0, _ := ret[0].([]model.Job)
+	ret := m.ctrl.Call(m, "ViewAllJobs", filter)
+	ret0, _ := ret[0].(model.JobPage)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ViewAllJobs indicates an expected call of ViewAllJobs.
-func (mr *MockJobServiceMockRecorder) ViewAllJobs() *gomock.Call {
+func (mr *MockJobServiceMockRecorder) ViewAllJobs(filter any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ViewAllJobs", reflect.TypeOf((*MockJobService)(nil).ViewAllJobs))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ViewAllJobs", reflect.TypeOf((*MockJobService)(nil).ViewAllJobs), filter)
 }
 
 // ViewJobByCompanyID mocks base method.
@@ -111,3 +244,18 @@ func (mr *MockJobServiceMockRecorder) ViewJobByJobID(jID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ViewJobByJobID", reflect.TypeOf((*MockJobService)(nil).ViewJobByJobID), jID)
 }
+
+// ViewJobRevisions mocks base method.
+func (m *MockJobService) ViewJobRevisions(jID uint) ([]model.JobRevision, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ViewJobRevisions", jID)
+	ret0, _ := ret[0].([]model.JobRevision)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ViewJobRevisions indicates an expected call of ViewJobRevisions.
+func (mr *MockJobServiceMockRecorder) ViewJobRevisions(jID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ViewJobRevisions", reflect.TypeOf((*MockJobService)(nil).ViewJobRevisions), jID)
+}
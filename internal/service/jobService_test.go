@@ -1,27 +1,33 @@
 package service
 
 import (
+	"context"
 	"errors"
 	"job-portal-api/internal/cache"
 	"job-portal-api/internal/model"
 	"job-portal-api/internal/repository"
 	"reflect"
 	"testing"
+	"time"
 
 	gomock "go.uber.org/mock/gomock"
+	"gorm.io/gorm"
 )
 
 func TestService_CreateJobByCompanyId(t *testing.T) {
+	past := time.Now().Add(-time.Hour)
 	type args struct {
 		jobDetails model.NewJobs
 		cID        uint
 	}
 	tests := []struct {
-		name         string
-		args         args
-		want         model.Response
-		wantErr      bool
-		mockResponse func() (model.Response, error)
+		name           string
+		args           args
+		want           model.Response
+		wantErr        bool
+		wantFieldErrs  []model.FieldError
+		mockValidation func(mcr *repository.MockComapnyRepo, mt *repository.MockTaxonomyRepository)
+		mockResponse   func() (model.Response, error)
 	}{
 		{
 			name:    "failure",
@@ -32,6 +38,66 @@ func TestService_CreateJobByCompanyId(t *testing.T) {
 				return model.Response{}, errors.New("error")
 			},
 		},
+		{
+			name: "failure - expiry date in the past",
+			args: args{jobDetails: model.NewJobs{
+				Jobname:   "asdfghj",
+				ExpiresAt: &past,
+			}, cID: 1},
+			want:          model.Response{},
+			wantErr:       true,
+			wantFieldErrs: []model.FieldError{{Field: "expiresAt", Message: "must be in the future"}},
+		},
+		{
+			name: "failure - unknown company and reference data",
+			args: args{jobDetails: model.NewJobs{
+				Jobname:         "asdfghj",
+				MinNoticePeriod: 90,
+				MaxNoticePeriod: 30,
+				Location:        []uint{1, 7},
+				TechnologyStack: []uint{1},
+				MinExperience:   5,
+				MaxExperience:   2,
+			}, cID: 9},
+			want:    model.Response{},
+			wantErr: true,
+			wantFieldErrs: []model.FieldError{
+				{Field: "companyID", Message: "company does not exist"},
+				{Field: "minNoticePeriod", Message: "cannot be greater than maxNoticePeriod"},
+				{Field: "minExperience", Message: "cannot be greater than maxExperience"},
+				{Field: "location", Message: "unknown ids [7]"},
+			},
+			mockValidation: func(mcr *repository.MockComapnyRepo, mt *repository.MockTaxonomyRepository) {
+				mcr.EXPECT().GetCompanyByID(uint64(9)).Return(model.Company{}, errors.New("error"))
+				mt.EXPECT().FindMissingTaxonomyIDs(model.TaxonomyLocation, []uint{1, 7}).Return([]uint{7}, nil)
+				mt.EXPECT().FindMissingTaxonomyIDs(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+			},
+		},
+		{
+			name: "failure - reference data lookup",
+			args: args{jobDetails: model.NewJobs{
+				Jobname:  "asdfghj",
+				Location: []uint{1},
+			}, cID: 1},
+			want:    model.Response{},
+			wantErr: true,
+			mockValidation: func(mcr *repository.MockComapnyRepo, mt *repository.MockTaxonomyRepository) {
+				mcr.EXPECT().GetCompanyByID(uint64(1)).Return(model.Company{}, nil)
+				mt.EXPECT().FindMissingTaxonomyIDs(model.TaxonomyLocation, []uint{1}).Return(nil, errors.New("error"))
+			},
+		},
+		{
+			name: "failure - not the company owner",
+			args: args{jobDetails: model.NewJobs{
+				Jobname: "asdfghj",
+			}, cID: 1},
+			want:    model.Response{},
+			wantErr: true,
+			mockValidation: func(mcr *repository.MockComapnyRepo, mt *repository.MockTaxonomyRepository) {
+				mcr.EXPECT().GetCompanyByID(uint64(1)).Return(model.Company{OwnerID: 3}, nil).Times(2)
+				mt.EXPECT().FindMissingTaxonomyIDs(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+			},
+		},
 		{
 			name: "success",
 			args: args{jobDetails: model.NewJobs{
@@ -58,12 +124,20 @@ func TestService_CreateJobByCompanyId(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			mc := gomock.NewController(t)
 			mj := repository.NewMockJobRepository(mc)
+			mcr := repository.NewMockComapnyRepo(mc)
+			mt := repository.NewMockTaxonomyRepository(mc)
 			mca := cache.NewMockCaching(mc)
-			s, _ := NewJobService(mj, mca)
+			s, _ := NewJobService(mj, mcr, mt, mca, testRates)
+			if tt.mockValidation != nil {
+				tt.mockValidation(mcr, mt)
+			} else {
+				mcr.EXPECT().GetCompanyByID(gomock.Any()).Return(model.Company{OwnerID: 8}, nil).AnyTimes()
+				mt.EXPECT().FindMissingTaxonomyIDs(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+			}
 			if tt.mockResponse != nil {
 				mj.EXPECT().CreateJob(gomock.Any()).Return(tt.mockResponse()).AnyTimes()
 			}
-			got, err := s.CreateJobByCompanyId(tt.args.jobDetails, tt.args.cID)
+			got, err := s.CreateJobByCompanyId(8, tt.args.jobDetails, tt.args.cID)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("Service.UserSignup() error = %v, wantErr %v", err, tt.wantErr)
 				return
@@ -71,10 +145,51 @@ func TestService_CreateJobByCompanyId(t *testing.T) {
 			if !reflect.DeepEqual(got, tt.want) {
 				t.Errorf("Service.UserSignup() = %v, want %v", got, tt.want)
 			}
+			if tt.wantFieldErrs != nil {
+				var validationErr *ValidationError
+				if !errors.As(err, &validationErr) {
+					t.Fatalf("Service.CreateJobByCompanyId() error = %v, want a validation error", err)
+				}
+				if !reflect.DeepEqual(validationErr.Errors, tt.wantFieldErrs) {
+					t.Errorf("Service.CreateJobByCompanyId() field errors = %v, want %v", validationErr.Errors, tt.wantFieldErrs)
+				}
+			}
 		})
 	}
 }
 
+func TestService_CreateJobByCompanyId_WorkMode(t *testing.T) {
+	mc := gomock.NewController(t)
+	mj := repository.NewMockJobRepository(mc)
+	mcr := repository.NewMockComapnyRepo(mc)
+	mt := repository.NewMockTaxonomyRepository(mc)
+	s, _ := NewJobService(mj, mcr, mt, cache.NewMockCaching(mc), testRates)
+
+	var stored model.Job
+	mcr.EXPECT().GetCompanyByID(uint64(1)).Return(model.Company{OwnerID: 8}, nil).AnyTimes()
+	mt.EXPECT().FindMissingTaxonomyIDs(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+	mj.EXPECT().CreateJob(gomock.Any()).DoAndReturn(func(jobData model.Job) (model.Response, error) {
+		jobData.ID = 1
+		stored = jobData
+		return model.Response{Id: 1}, nil
+	})
+	mj.EXPECT().GetJobByJobID(uint(1)).DoAndReturn(func(jID uint) (model.Job, error) {
+		return stored, nil
+	})
+
+	_, err := s.CreateJobByCompanyId(8, model.NewJobs{Jobname: "asdfghj", WorkMode: model.WorkModeRemote}, 1)
+	if err != nil {
+		t.Fatalf("Service.CreateJobByCompanyId() error = %v", err)
+	}
+	got, err := s.ViewJobByJobID(1)
+	if err != nil {
+		t.Fatalf("Service.ViewJobByJobID() error = %v", err)
+	}
+	if got.WorkMode != model.WorkModeRemote {
+		t.Errorf("Service.ViewJobByJobID() work mode = %q, want %q", got.WorkMode, model.WorkModeRemote)
+	}
+}
+
 func TestService_ViewJobByCompanyID(t *testing.T) {
 	type args struct {
 		cID uint
@@ -109,8 +224,10 @@ func TestService_ViewJobByCompanyID(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			mc := gomock.NewController(t)
 			mj := repository.NewMockJobRepository(mc)
+			mcr := repository.NewMockComapnyRepo(mc)
+			mt := repository.NewMockTaxonomyRepository(mc)
 			mca := cache.NewMockCaching(mc)
-			s, _ := NewJobService(mj, mca)
+			s, _ := NewJobService(mj, mcr, mt, mca, testRates)
 			if tt.mockResponse != nil {
 				mj.EXPECT().GetJobByCompanyID(gomock.Any()).Return(tt.mockResponse()).AnyTimes()
 			}
@@ -160,8 +277,10 @@ func TestService_ViewJobByJobID(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			mc := gomock.NewController(t)
 			mj := repository.NewMockJobRepository(mc)
+			mcr := repository.NewMockComapnyRepo(mc)
+			mt := repository.NewMockTaxonomyRepository(mc)
 			mca := cache.NewMockCaching(mc)
-			s, _ := NewJobService(mj, mca)
+			s, _ := NewJobService(mj, mcr, mt, mca, testRates)
 			if tt.mockResponse != nil {
 				mj.EXPECT().GetJobByJobID(gomock.Any()).Return(tt.mockResponse()).AnyTimes()
 			}
@@ -178,26 +297,362 @@ func TestService_ViewJobByJobID(t *testing.T) {
 }
 
 func TestService_ViewAllJobs(t *testing.T) {
+	created := time.Date(2023, 11, 20, 10, 0, 0, 0, time.UTC)
+	nextCursor, _ := encodeJobCursor(model.JobCursor{Sort: model.JobSortNewest, ID: 2, CreatedAt: created})
+	experienceCursor, _ := encodeJobCursor(model.JobCursor{Sort: model.JobSortExperience, ID: 2})
+	salary := 1200000.0
+	salaryCursor, _ := encodeJobCursor(model.JobCursor{Sort: model.JobSortSalary, ID: 4, Salary: -1})
+	latitude := 12.97
+	longitude := 77.59
+	radius := 25.0
+	noRadius := 0.0
+	offTheMap := 95.0
 	tests := []struct {
 		name         string
-		want         []model.Job
+		filter       model.JobFilter
+		want         model.JobPage
 		wantErr      bool
-		mockResponse func() ([]model.Job, error)
+		mockResponse func(mj *repository.MockJobRepository)
 	}{
 		{
 			name:    "failure",
-			want:    nil,
+			filter:  model.JobFilter{},
+			want:    model.JobPage{},
 			wantErr: true,
-			mockResponse: func() ([]model.Job, error) {
-				return nil, errors.New("error")
+			mockResponse: func(mj *repository.MockJobRepository) {
+				mj.EXPECT().FilterJobs(gomock.Any(), gomock.Any()).Return(nil, errors.New("error"))
+			},
+		},
+		{
+			name:    "failure - invalid sort",
+			filter:  model.JobFilter{Sort: "relevance"},
+			want:    model.JobPage{},
+			wantErr: true,
+		},
+		{
+			name:    "failure - invalid work mode",
+			filter:  model.JobFilter{WorkMode: []string{"office"}},
+			want:    model.JobPage{},
+			wantErr: true,
+		},
+		{
+			name:    "failure - radius without a point",
+			filter:  model.JobFilter{Latitude: &latitude, RadiusKm: &radius},
+			want:    model.JobPage{},
+			wantErr: true,
+		},
+		{
+			name:    "failure - invalid coordinates",
+			filter:  model.JobFilter{Latitude: &offTheMap, Longitude: &longitude, RadiusKm: &radius},
+			want:    model.JobPage{},
+			wantErr: true,
+		},
+		{
+			name:    "failure - zero radius",
+			filter:  model.JobFilter{Latitude: &latitude, Longitude: &longitude, RadiusKm: &noRadius},
+			want:    model.JobPage{},
+			wantErr: true,
+		},
+		{
+			name:    "success - radius search including remote jobs",
+			filter:  model.JobFilter{Latitude: &latitude, Longitude: &longitude, RadiusKm: &radius, IncludeRemote: true},
+			want:    model.JobPage{Jobs: []model.Job{{Model: gorm.Model{ID: 1}, WorkMode: model.WorkModeRemote}}},
+			wantErr: false,
+			mockResponse: func(mj *repository.MockJobRepository) {
+				mj.EXPECT().FilterJobs(model.JobFilter{Latitude: &latitude, Longitude: &longitude, RadiusKm: &radius, IncludeRemote: true, Sort: model.JobSortNewest, Limit: 21}, nil).Return([]model.Job{{Model: gorm.Model{ID: 1}, WorkMode: model.WorkModeRemote}}, nil)
+			},
+		},
+		{
+			name:    "failure - invalid cursor",
+			filter:  model.JobFilter{Cursor: "not a cursor"},
+			want:    model.JobPage{},
+			wantErr: true,
+		},
+		{
+			name:    "failure - cursor from another sort order",
+			filter:  model.JobFilter{Cursor: experienceCursor},
+			want:    model.JobPage{},
+			wantErr: true,
+		},
+		{
+			name:    "success - empty table",
+			filter:  model.JobFilter{},
+			want:    model.JobPage{Jobs: []model.Job{}},
+			wantErr: false,
+			mockResponse: func(mj *repository.MockJobRepository) {
+				mj.EXPECT().FilterJobs(model.JobFilter{Sort: model.JobSortNewest, Limit: 21}, nil).Return(nil, nil)
+			},
+		},
+		{
+			name:   "success - next page",
+			filter: model.JobFilter{Location: []uint{1}, Limit: 2},
+			want: model.JobPage{
+				Jobs: []model.Job{
+					{Model: gorm.Model{ID: 3, CreatedAt: created.Add(time.Hour)}},
+					{Model: gorm.Model{ID: 2, CreatedAt: created}},
+				},
+				NextCursor: nextCursor,
+			},
+			wantErr: false,
+			mockResponse: func(mj *repository.MockJobRepository) {
+				mj.EXPECT().FilterJobs(model.JobFilter{Location: []uint{1}, Sort: model.JobSortNewest, Limit: 3}, nil).Return([]model.Job{
+					{Model: gorm.Model{ID: 3, CreatedAt: created.Add(time.Hour)}},
+					{Model: gorm.Model{ID: 2, CreatedAt: created}},
+					{Model: gorm.Model{ID: 1, CreatedAt: created.Add(-time.Hour)}},
+				}, nil)
+			},
+		},
+		{
+			name:   "success - salary sort puts undisclosed salaries last",
+			filter: model.JobFilter{MinSalary: &salary, Sort: model.JobSortSalary, Limit: 2},
+			want: model.JobPage{
+				Jobs: []model.Job{
+					{Model: gorm.Model{ID: 5}, NormalisedMaxSalary: &salary},
+					{Model: gorm.Model{ID: 4}, SalaryUndisclosed: true},
+				},
+				NextCursor: salaryCursor,
+			},
+			wantErr: false,
+			mockResponse: func(mj *repository.MockJobRepository) {
+				mj.EXPECT().FilterJobs(model.JobFilter{MinSalary: &salary, Sort: model.JobSortSalary, Limit: 3}, nil).Return([]model.Job{
+					{Model: gorm.Model{ID: 5}, NormalisedMaxSalary: &salary},
+					{Model: gorm.Model{ID: 4}, SalaryUndisclosed: true},
+					{Model: gorm.Model{ID: 3}, SalaryUndisclosed: true},
+				}, nil)
+			},
+		},
+		{
+			name:   "success - last page",
+			filter: model.JobFilter{Cursor: nextCursor, Limit: 2},
+			want: model.JobPage{
+				Jobs: []model.Job{{Model: gorm.Model{ID: 1}}},
+			},
+			wantErr: false,
+			mockResponse: func(mj *repository.MockJobRepository) {
+				mj.EXPECT().FilterJobs(gomock.Any(), &model.JobCursor{Sort: model.JobSortNewest, ID: 2, CreatedAt: created}).Return([]model.Job{{Model: gorm.Model{ID: 1}}}, nil)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			mj := repository.NewMockJobRepository(mc)
+			mcr := repository.NewMockComapnyRepo(mc)
+			mt := repository.NewMockTaxonomyRepository(mc)
+			mca := cache.NewMockCaching(mc)
+			s, _ := NewJobService(mj, mcr, mt, mca, testRates)
+			if tt.mockResponse != nil {
+				tt.mockResponse(mj)
+			}
+			got, err := s.ViewAllJobs(tt.filter)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Service.ViewAllJobs() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Service.ViewAllJobs() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestService_SearchJobs(t *testing.T) {
+	nextOffset := 2
+	type args struct {
+		query  string
+		filter model.JobFilter
+		offset int
+	}
+	tests := []struct {
+		name         string
+		args         args
+		want         model.JobSearchPage
+		wantErr      bool
+		mockResponse func(mj *repository.MockJobRepository)
+	}{
+		{
+			name:    "failure - empty query",
+			args:    args{query: "  "},
+			want:    model.JobSearchPage{},
+			wantErr: true,
+		},
+		{
+			name:    "failure - negative offset",
+			args:    args{query: "golang", offset: -1},
+			want:    model.JobSearchPage{},
+			wantErr: true,
+		},
+		{
+			name:    "failure",
+			args:    args{query: "golang"},
+			want:    model.JobSearchPage{},
+			wantErr: true,
+			mockResponse: func(mj *repository.MockJobRepository) {
+				mj.EXPECT().SearchJobs("golang", gomock.Any(), 0).Return(nil, errors.New("error"))
+			},
+		},
+		{
+			name: "success - next page",
+			args: args{query: " golang ", filter: model.JobFilter{CompanyID: 1, Limit: 2}},
+			want: model.JobSearchPage{
+				Results: []model.JobSearchResult{
+					{Job: model.Job{Model: gorm.Model{ID: 4}}, Rank: 0.9},
+					{Job: model.Job{Model: gorm.Model{ID: 2}}, Rank: 0.5},
+				},
+				NextOffset: &nextOffset,
+			},
+			wantErr: false,
+			mockResponse: func(mj *repository.MockJobRepository) {
+				mj.EXPECT().SearchJobs("golang", model.JobFilter{CompanyID: 1, Limit: 3}, 0).Return([]model.JobSearchResult{
+					{Job: model.Job{Model: gorm.Model{ID: 4}}, Rank: 0.9},
+					{Job: model.Job{Model: gorm.Model{ID: 2}}, Rank: 0.5},
+					{Job: model.Job{Model: gorm.Model{ID: 1}}, Rank: 0.1},
+				}, nil)
+			},
+		},
+		{
+			name:    "success - no results",
+			args:    args{query: "cobol", offset: 20},
+			want:    model.JobSearchPage{Results: []model.JobSearchResult{}},
+			wantErr: false,
+			mockResponse: func(mj *repository.MockJobRepository) {
+				mj.EXPECT().SearchJobs("cobol", model.JobFilter{Limit: 21}, 20).Return([]model.JobSearchResult{}, nil)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			mj := repository.NewMockJobRepository(mc)
+			mcr := repository.NewMockComapnyRepo(mc)
+			mt := repository.NewMockTaxonomyRepository(mc)
+			mca := cache.NewMockCaching(mc)
+			s, _ := NewJobService(mj, mcr, mt, mca, testRates)
+			if tt.mockResponse != nil {
+				tt.mockResponse(mj)
+			}
+			got, err := s.SearchJobs(tt.args.query, tt.args.filter, tt.args.offset)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Service.SearchJobs() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Service.SearchJobs() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestService_UpdateJobByJobID(t *testing.T) {
+	jobname := "golang developer"
+	locations := []uint{3}
+	minExperience := 5
+	type args struct {
+		userID     uint
+		jID        uint
+		jobDetails model.UpdateJob
+	}
+	tests := []struct {
+		name          string
+		args          args
+		want          model.Response
+		wantErr       bool
+		wantFieldErrs []model.FieldError
+		mockResponse  func(mj *repository.MockJobRepository, mca *cache.MockCaching)
+	}{
+		{
+			name:    "failure - job not found",
+			args:    args{userID: 8, jID: 1, jobDetails: model.UpdateJob{Jobname: &jobname}},
+			want:    model.Response{},
+			wantErr: true,
+			mockResponse: func(mj *repository.MockJobRepository, mca *cache.MockCaching) {
+				mj.EXPECT().GetJobByJobID(uint(1)).Return(model.Job{}, errors.New("error"))
+			},
+		},
+		{
+			name:    "failure - not the company owner",
+			args:    args{userID: 3, jID: 1, jobDetails: model.UpdateJob{Jobname: &jobname}},
+			want:    model.Response{},
+			wantErr: true,
+			mockResponse: func(mj *repository.MockJobRepository, mca *cache.MockCaching) {
+				mj.EXPECT().GetJobByJobID(uint(1)).Return(model.Job{Model: gorm.Model{ID: 1}}, nil)
+			},
+		},
+		{
+			name:    "failure - update",
+			args:    args{userID: 8, jID: 1, jobDetails: model.UpdateJob{Jobname: &jobname}},
+			want:    model.Response{},
+			wantErr: true,
+			mockResponse: func(mj *repository.MockJobRepository, mca *cache.MockCaching) {
+				mj.EXPECT().GetJobByJobID(uint(1)).Return(model.Job{Model: gorm.Model{ID: 1}}, nil)
+				mj.EXPECT().UpdateJob(gomock.Any()).Return(model.Job{}, errors.New("error"))
 			},
 		},
 		{
 			name:    "success",
-			want:    []model.Job{},
+			args:    args{userID: 8, jID: 1, jobDetails: model.UpdateJob{Jobname: &jobname, Location: &locations}},
+			want:    model.Response{Id: 1},
 			wantErr: false,
-			mockResponse: func() ([]model.Job, error) {
-				return []model.Job{}, nil
+			mockResponse: func(mj *repository.MockJobRepository, mca *cache.MockCaching) {
+				mj.EXPECT().GetJobByJobID(uint(1)).Return(model.Job{
+					Model:       gorm.Model{ID: 1},
+					Jobname:     "java developer",
+					Description: "backend",
+					Location:    []model.Location{{Model: gorm.Model{ID: 1}}},
+				}, nil)
+				mj.EXPECT().UpdateJob(model.Job{
+					Model:       gorm.Model{ID: 1},
+					Jobname:     "golang developer",
+					Description: "backend",
+					Location:    []model.Location{{Model: gorm.Model{ID: 3}}},
+				}).Return(model.Job{Model: gorm.Model{ID: 1}}, nil)
+				mca.EXPECT().DeleteTheCacheData(gomock.Any(), uint(1)).Return(nil)
+				mca.EXPECT().DeleteShortlist(gomock.Any(), uint(1)).Return(nil)
+			},
+		},
+		{
+			name:          "failure - location retired before the update",
+			args:          args{userID: 8, jID: 1, jobDetails: model.UpdateJob{Location: &locations}},
+			want:          model.Response{},
+			wantErr:       true,
+			wantFieldErrs: []model.FieldError{{Field: "location", Message: "unknown ids [3]"}},
+			mockResponse: func(mj *repository.MockJobRepository, mca *cache.MockCaching) {
+				mj.EXPECT().GetJobByJobID(uint(1)).Return(model.Job{Model: gorm.Model{ID: 1}}, nil)
+				mj.EXPECT().UpdateJob(gomock.Any()).Return(model.Job{}, &repository.MissingReferenceError{Kind: model.TaxonomyLocation, IDs: []uint{3}})
+			},
+		},
+		{
+			name:    "failure - invalid experience range",
+			args:    args{userID: 8, jID: 1, jobDetails: model.UpdateJob{MinExperience: &minExperience}},
+			want:    model.Response{},
+			wantErr: true,
+			mockResponse: func(mj *repository.MockJobRepository, mca *cache.MockCaching) {
+				mj.EXPECT().GetJobByJobID(uint(1)).Return(model.Job{Model: gorm.Model{ID: 1}, MinExperience: 1, MaxExperience: 3}, nil)
+			},
+		},
+		{
+			name:    "success - replace clears the expiry date",
+			args:    args{userID: 8, jID: 1, jobDetails: model.UpdateJob{ClearExpiresAt: true}},
+			want:    model.Response{Id: 1},
+			wantErr: false,
+			mockResponse: func(mj *repository.MockJobRepository, mca *cache.MockCaching) {
+				expires := time.Now().Add(time.Hour)
+				mj.EXPECT().GetJobByJobID(uint(1)).Return(model.Job{Model: gorm.Model{ID: 1}, ExpiresAt: &expires}, nil)
+				mj.EXPECT().UpdateJob(model.Job{Model: gorm.Model{ID: 1}}).Return(model.Job{Model: gorm.Model{ID: 1}}, nil)
+				mca.EXPECT().DeleteTheCacheData(gomock.Any(), uint(1)).Return(nil)
+				mca.EXPECT().DeleteShortlist(gomock.Any(), uint(1)).Return(nil)
+			},
+		},
+		{
+			name:    "success - cache invalidation failure is not fatal",
+			args:    args{userID: 8, jID: 1, jobDetails: model.UpdateJob{}},
+			want:    model.Response{Id: 1},
+			wantErr: false,
+			mockResponse: func(mj *repository.MockJobRepository, mca *cache.MockCaching) {
+				mj.EXPECT().GetJobByJobID(uint(1)).Return(model.Job{Model: gorm.Model{ID: 1}}, nil)
+				mj.EXPECT().UpdateJob(gomock.Any()).Return(model.Job{Model: gorm.Model{ID: 1}}, nil)
+				mca.EXPECT().DeleteTheCacheData(gomock.Any(), uint(1)).Return(errors.New("error"))
+				mca.EXPECT().DeleteShortlist(gomock.Any(), uint(1)).Return(nil)
 			},
 		},
 	}
@@ -205,19 +660,190 @@ func TestService_ViewAllJobs(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			mc := gomock.NewController(t)
 			mj := repository.NewMockJobRepository(mc)
+			mcr := repository.NewMockComapnyRepo(mc)
+			mt := repository.NewMockTaxonomyRepository(mc)
 			mca := cache.NewMockCaching(mc)
-			s, _ := NewJobService(mj, mca)
+			s, _ := NewJobService(mj, mcr, mt, mca, testRates)
+			mcr.EXPECT().GetCompanyByID(gomock.Any()).Return(model.Company{OwnerID: 8}, nil).AnyTimes()
+			mt.EXPECT().FindMissingTaxonomyIDs(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
 			if tt.mockResponse != nil {
-				mj.EXPECT().GetAllJobs().Return(tt.mockResponse()).AnyTimes()
+				tt.mockResponse(mj, mca)
 			}
-			got, err := s.ViewAllJobs()
+			got, err := s.UpdateJobByJobID(tt.args.userID, tt.args.jID, tt.args.jobDetails)
 			if (err != nil) != tt.wantErr {
-				t.Errorf("Service.UserSignup() error = %v, wantErr %v", err, tt.wantErr)
+				t.Errorf("Service.UpdateJobByJobID() error = %v, wantErr %v", err, tt.wantErr)
 				return
 			}
 			if !reflect.DeepEqual(got, tt.want) {
-				t.Errorf("Service.UserSignup() = %v, want %v", got, tt.want)
+				t.Errorf("Service.UpdateJobByJobID() = %v, want %v", got, tt.want)
+			}
+			if tt.wantFieldErrs != nil {
+				var validationErr *ValidationError
+				if !errors.As(err, &validationErr) {
+					t.Fatalf("Service.UpdateJobByJobID() error = %v, want a validation error", err)
+				}
+				if !reflect.DeepEqual(validationErr.Errors, tt.wantFieldErrs) {
+					t.Errorf("Service.UpdateJobByJobID() field errors = %v, want %v", validationErr.Errors, tt.wantFieldErrs)
+				}
+			}
+		})
+	}
+}
+
+func TestService_DeleteJobByJobID(t *testing.T) {
+	tests := []struct {
+		name         string
+		userID       uint
+		jID          uint
+		wantErr      bool
+		mockResponse func(mj *repository.MockJobRepository, mca *cache.MockCaching)
+	}{
+		{
+			name:    "failure - not the company owner",
+			userID:  3,
+			jID:     1,
+			wantErr: true,
+		},
+		{
+			name:    "failure",
+			userID:  8,
+			jID:     1,
+			wantErr: true,
+			mockResponse: func(mj *repository.MockJobRepository, mca *cache.MockCaching) {
+				mj.EXPECT().DeleteJob(uint(1)).Return(errors.New("error"))
+			},
+		},
+		{
+			name:    "success",
+			userID:  8,
+			jID:     1,
+			wantErr: false,
+			mockResponse: func(mj *repository.MockJobRepository, mca *cache.MockCaching) {
+				mj.EXPECT().DeleteJob(uint(1)).Return(nil)
+				mca.EXPECT().DeleteTheCacheData(gomock.Any(), uint(1)).Return(nil)
+				mca.EXPECT().DeleteShortlist(gomock.Any(), uint(1)).Return(nil)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			mj := repository.NewMockJobRepository(mc)
+			mcr := repository.NewMockComapnyRepo(mc)
+			mt := repository.NewMockTaxonomyRepository(mc)
+			mca := cache.NewMockCaching(mc)
+			s, _ := NewJobService(mj, mcr, mt, mca, testRates)
+			mj.EXPECT().GetJobByJobID(tt.jID).Return(model.Job{Model: gorm.Model{ID: tt.jID}, Cid: 4}, nil)
+			mcr.EXPECT().GetCompanyByID(uint64(4)).Return(model.Company{OwnerID: 8}, nil)
+			if tt.mockResponse != nil {
+				tt.mockResponse(mj, mca)
+			}
+			err := s.DeleteJobByJobID(tt.userID, tt.jID)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Service.DeleteJobByJobID() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestService_ProcessApplication(t *testing.T) {
+	published := func(jID uint) model.Job {
+		return model.Job{Model: gorm.Model{ID: jID}, Status: model.JobStatusPublished, MaxNoticePeriod: 60, MaxExperience: 5}
+	}
+	applications := []model.NewUserApplication{
+		{Name: "first", Jid: 1, Jobs: model.Requestfield{NoticePeriod: 30, Experience: 2}},
+		{Name: "second", Jid: 2, Jobs: model.Requestfield{NoticePeriod: 90, Experience: 9}},
+		{Name: "third", Jid: 1, Jobs: model.Requestfield{NoticePeriod: 90, Experience: 2}},
+		{Name: "fourth", Jid: 3, Jobs: model.Requestfield{NoticePeriod: 30, Experience: 2}},
+		{Name: "fifth", Jid: 2, Jobs: model.Requestfield{NoticePeriod: 30, Experience: 2}},
+	}
+	tests := []struct {
+		name         string
+		cancelled    bool
+		want         []string
+		mockResponse func(mj *repository.MockJobRepository, mca *cache.MockCaching)
+	}{
+		{
+			name: "every job is loaded once and the order is kept",
+			want: []string{"first", "second", "third", "fifth"},
+			mockResponse: func(mj *repository.MockJobRepository, mca *cache.MockCaching) {
+				mca.EXPECT().GetTheCacheData(gomock.Any(), uint(1)).Return(`{"ID":1,"status":"published","max_notice_period":60,"max_experience":5}`, nil).Times(1)
+				mca.EXPECT().GetTheCacheData(gomock.Any(), uint(2)).Return("", errors.New("cache miss")).Times(1)
+				mj.EXPECT().GetJobByJobID(uint(2)).Return(published(2), nil).Times(1)
+				mca.EXPECT().AddToTheCache(gomock.Any(), uint(2), published(2)).Return(nil).Times(1)
+				mca.EXPECT().GetTheCacheData(gomock.Any(), uint(3)).Return("", errors.New("cache miss")).Times(1)
+				mj.EXPECT().GetJobByJobID(uint(3)).Return(model.Job{}, errors.New("error")).Times(1)
+			},
+		},
+		{
+			name: "cache failures are tolerated",
+			want: []string{"first", "second", "third", "fourth", "fifth"},
+			mockResponse: func(mj *repository.MockJobRepository, mca *cache.MockCaching) {
+				mca.EXPECT().GetTheCacheData(gomock.Any(), gomock.Any()).Return("{not json", nil).Times(3)
+				mj.EXPECT().GetJobByJobID(gomock.Any()).DoAndReturn(func(jID uint) (model.Job, error) {
+					return published(jID), nil
+				}).Times(3)
+				mca.EXPECT().AddToTheCache(gomock.Any(), gomock.Any(), gomock.Any()).Return(errors.New("redis down")).Times(3)
+			},
+		},
+		{
+			name:         "cancelled request",
+			cancelled:    true,
+			want:         nil,
+			mockResponse: func(mj *repository.MockJobRepository, mca *cache.MockCaching) {},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			mj := repository.NewMockJobRepository(mc)
+			mcr := repository.NewMockComapnyRepo(mc)
+			mt := repository.NewMockTaxonomyRepository(mc)
+			mca := cache.NewMockCaching(mc)
+			s, _ := NewJobService(mj, mcr, mt, mca, testRates)
+			tt.mockResponse(mj, mca)
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			if tt.cancelled {
+				cancel()
+			}
+
+			var got []string
+			for _, v := range s.ProcessApplication(ctx, applications) {
+				got = append(got, v.Name)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Service.ProcessApplication() = %v, want %v", got, tt.want)
 			}
 		})
 	}
 }
+
+func TestService_ProcessApplication_CancelledLoad(t *testing.T) {
+	mc := gomock.NewController(t)
+	mj := repository.NewMockJobRepository(mc)
+	mca := cache.NewMockCaching(mc)
+	s, _ := NewJobService(mj, repository.NewMockComapnyRepo(mc), repository.NewMockTaxonomyRepository(mc), mca, testRates)
+
+	published := model.Job{Model: gorm.Model{ID: 1}, Status: model.JobStatusPublished}
+	mca.EXPECT().GetTheCacheData(gomock.Any(), uint(1)).Return("", errors.New("cache miss")).Times(2)
+	gomock.InOrder(
+		mj.EXPECT().GetJobByJobID(uint(1)).Return(model.Job{}, context.Canceled),
+		mj.EXPECT().GetJobByJobID(uint(1)).Return(published, nil),
+	)
+	mca.EXPECT().AddToTheCache(gomock.Any(), uint(1), published).Return(nil)
+
+	jobs := newBatchJobs(s.(*Service))
+	_, err := jobs.get(context.Background(), 1)
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("batchJobs.get() error = %v, want %v", err, context.Canceled)
+	}
+	got, err := jobs.get(context.Background(), 1)
+	if err != nil {
+		t.Fatalf("batchJobs.get() error = %v, want the job loaded again", err)
+	}
+	if !reflect.DeepEqual(got, published) {
+		t.Errorf("batchJobs.get() = %v, want %v", got, published)
+	}
+}
@@ -0,0 +1,117 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"job-portal-api/internal/model"
+	"time"
+
+	"github.com/rs/zerolog/log"
+)
+
+// jobStatusTransitions lists the states a job can move to from each state.
+// Expiry is normally done by the sweeper, but an expired job can be
+// published again once its expiry date has been moved forward.
+var jobStatusTransitions = map[string][]string{
+	model.JobStatusDraft:     {model.JobStatusPublished, model.JobStatusClosed},
+	model.JobStatusPublished: {model.JobStatusPaused, model.JobStatusClosed, model.JobStatusExpired},
+	model.JobStatusPaused:    {model.JobStatusPublished, model.JobStatusClosed, model.JobStatusExpired},
+	model.JobStatusExpired:   {model.JobStatusPublished, model.JobStatusClosed},
+	model.JobStatusClosed:    {},
+}
+
+func canChangeJobStatus(from string, to string) bool {
+	for _, v := range jobStatusTransitions[from] {
+		if v == to {
+			return true
+		}
+	}
+	return false
+}
+
+// acceptingApplications reports whether candidates can apply to the job. The
+// sweeper only expires jobs periodically, so a published job past its
+// expiry date is closed to applications before its status catches up.
+func acceptingApplications(jobData model.Job) bool {
+	if jobData.Status != model.JobStatusPublished {
+		return false
+	}
+	return jobData.ExpiresAt == nil || jobData.ExpiresAt.After(time.Now())
+}
+
+func (s *Service) ChangeJobStatus(userID uint, jID uint, status string) (model.Response, error) {
+
+	jobData, err := s.jobRepo.GetJobByJobID(jID)
+	if err != nil {
+		return model.Response{}, err
+	}
+
+	err = s.authorizeCompany(userID, jobData.Cid)
+	if err != nil {
+		return model.Response{}, err
+	}
+
+	if !canChangeJobStatus(jobData.Status, status) {
+		log.Error().Str("from", jobData.Status).Str("to", status).Msg("invalid job status transition")
+		return model.Response{}, errors.New("invalid job status transition")
+	}
+
+	now := time.Now()
+	if status == model.JobStatusPublished {
+		if jobData.ExpiresAt != nil && !jobData.ExpiresAt.After(now) {
+			log.Error().Uint("job id", jID).Msg("cannot publish a job past its expiry date")
+			return model.Response{}, errors.New("job expiry date is in the past")
+		}
+		jobData.PublishedAt = &now
+	}
+	jobData.Status = status
+
+	jobData, err = s.jobRepo.UpdateJob(jobData)
+	if err != nil {
+		return model.Response{}, err
+	}
+
+	s.invalidateJobCache(jID)
+
+	return model.Response{
+		Id: jobData.ID,
+	}, nil
+}
+
+func (s *Service) ExpireJobs() (int, error) {
+
+	jobIDs, err := s.jobRepo.ExpireJobs(time.Now())
+	if err != nil {
+		return 0, err
+	}
+
+	for _, v := range jobIDs {
+		s.invalidateJobCache(v)
+	}
+
+	return len(jobIDs), nil
+}
+
+// StartJobExpirySweeper expires postings past their end date every interval
+// until ctx is cancelled.
+func StartJobExpirySweeper(ctx context.Context, jobService JobService, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			log.Info().Msg("job expiry sweeper stopped")
+			return
+		case <-ticker.C:
+			count, err := jobService.ExpireJobs()
+			if err != nil {
+				log.Error().Err(err).Msg("error in job expiry sweep")
+				continue
+			}
+			if count > 0 {
+				log.Info().Int("expired jobs", count).Msg("job expiry sweep completed")
+			}
+		}
+	}
+}
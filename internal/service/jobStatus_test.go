@@ -0,0 +1,217 @@
+package service
+
+import (
+	"errors"
+	"job-portal-api/internal/cache"
+	"job-portal-api/internal/model"
+	"job-portal-api/internal/repository"
+	"reflect"
+	"testing"
+	"time"
+
+	gomock "go.uber.org/mock/gomock"
+	"gorm.io/gorm"
+)
+
+func TestService_ChangeJobStatus(t *testing.T) {
+	past := time.Now().Add(-time.Hour)
+	future := time.Now().Add(time.Hour)
+	type args struct {
+		userID uint
+		jID    uint
+		status string
+	}
+	tests := []struct {
+		name         string
+		args         args
+		want         model.Response
+		wantErr      bool
+		mockResponse func(mj *repository.MockJobRepository, mca *cache.MockCaching)
+	}{
+		{
+			name:    "failure - job not found",
+			args:    args{userID: 8, jID: 1, status: model.JobStatusPaused},
+			want:    model.Response{},
+			wantErr: true,
+			mockResponse: func(mj *repository.MockJobRepository, mca *cache.MockCaching) {
+				mj.EXPECT().GetJobByJobID(uint(1)).Return(model.Job{}, errors.New("error"))
+			},
+		},
+		{
+			name:    "failure - not the company owner",
+			args:    args{userID: 3, jID: 1, status: model.JobStatusPaused},
+			want:    model.Response{},
+			wantErr: true,
+			mockResponse: func(mj *repository.MockJobRepository, mca *cache.MockCaching) {
+				mj.EXPECT().GetJobByJobID(uint(1)).Return(model.Job{Model: gorm.Model{ID: 1}, Status: model.JobStatusPublished}, nil)
+			},
+		},
+		{
+			name:    "failure - closed job cannot be reopened",
+			args:    args{userID: 8, jID: 1, status: model.JobStatusPublished},
+			want:    model.Response{},
+			wantErr: true,
+			mockResponse: func(mj *repository.MockJobRepository, mca *cache.MockCaching) {
+				mj.EXPECT().GetJobByJobID(uint(1)).Return(model.Job{Model: gorm.Model{ID: 1}, Status: model.JobStatusClosed}, nil)
+			},
+		},
+		{
+			name:    "failure - draft cannot be paused",
+			args:    args{userID: 8, jID: 1, status: model.JobStatusPaused},
+			want:    model.Response{},
+			wantErr: true,
+			mockResponse: func(mj *repository.MockJobRepository, mca *cache.MockCaching) {
+				mj.EXPECT().GetJobByJobID(uint(1)).Return(model.Job{Model: gorm.Model{ID: 1}, Status: model.JobStatusDraft}, nil)
+			},
+		},
+		{
+			name:    "failure - publishing past expiry date",
+			args:    args{userID: 8, jID: 1, status: model.JobStatusPublished},
+			want:    model.Response{},
+			wantErr: true,
+			mockResponse: func(mj *repository.MockJobRepository, mca *cache.MockCaching) {
+				mj.EXPECT().GetJobByJobID(uint(1)).Return(model.Job{Model: gorm.Model{ID: 1}, Status: model.JobStatusExpired, ExpiresAt: &past}, nil)
+			},
+		},
+		{
+			name:    "success - republishing after extending expiry",
+			args:    args{userID: 8, jID: 1, status: model.JobStatusPublished},
+			want:    model.Response{Id: 1},
+			wantErr: false,
+			mockResponse: func(mj *repository.MockJobRepository, mca *cache.MockCaching) {
+				mj.EXPECT().GetJobByJobID(uint(1)).Return(model.Job{Model: gorm.Model{ID: 1}, Status: model.JobStatusExpired, ExpiresAt: &future}, nil)
+				mj.EXPECT().UpdateJob(gomock.Any()).DoAndReturn(func(job model.Job) (model.Job, error) {
+					if job.Status != model.JobStatusPublished || job.PublishedAt == nil {
+						t.Errorf("job not published : %v", job)
+					}
+					return job, nil
+				})
+				mca.EXPECT().DeleteTheCacheData(gomock.Any(), uint(1)).Return(nil)
+				mca.EXPECT().DeleteShortlist(gomock.Any(), uint(1)).Return(nil)
+			},
+		},
+		{
+			name:    "success - pause",
+			args:    args{userID: 8, jID: 1, status: model.JobStatusPaused},
+			want:    model.Response{Id: 1},
+			wantErr: false,
+			mockResponse: func(mj *repository.MockJobRepository, mca *cache.MockCaching) {
+				mj.EXPECT().GetJobByJobID(uint(1)).Return(model.Job{Model: gorm.Model{ID: 1}, Status: model.JobStatusPublished}, nil)
+				mj.EXPECT().UpdateJob(gomock.Any()).Return(model.Job{Model: gorm.Model{ID: 1}, Status: model.JobStatusPaused}, nil)
+				mca.EXPECT().DeleteTheCacheData(gomock.Any(), uint(1)).Return(nil)
+				mca.EXPECT().DeleteShortlist(gomock.Any(), uint(1)).Return(nil)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			mj := repository.NewMockJobRepository(mc)
+			mcr := repository.NewMockComapnyRepo(mc)
+			mt := repository.NewMockTaxonomyRepository(mc)
+			mca := cache.NewMockCaching(mc)
+			s, _ := NewJobService(mj, mcr, mt, mca, testRates)
+			mcr.EXPECT().GetCompanyByID(gomock.Any()).Return(model.Company{OwnerID: 8}, nil).AnyTimes()
+			if tt.mockResponse != nil {
+				tt.mockResponse(mj, mca)
+			}
+			got, err := s.ChangeJobStatus(tt.args.userID, tt.args.jID, tt.args.status)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Service.ChangeJobStatus() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Service.ChangeJobStatus() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestService_ExpireJobs(t *testing.T) {
+	tests := []struct {
+		name         string
+		want         int
+		wantErr      bool
+		mockResponse func(mj *repository.MockJobRepository, mca *cache.MockCaching)
+	}{
+		{
+			name:    "failure",
+			want:    0,
+			wantErr: true,
+			mockResponse: func(mj *repository.MockJobRepository, mca *cache.MockCaching) {
+				mj.EXPECT().ExpireJobs(gomock.Any()).Return(nil, errors.New("error"))
+			},
+		},
+		{
+			name:    "success",
+			want:    2,
+			wantErr: false,
+			mockResponse: func(mj *repository.MockJobRepository, mca *cache.MockCaching) {
+				mj.EXPECT().ExpireJobs(gomock.Any()).Return([]uint{4, 7}, nil)
+				mca.EXPECT().DeleteTheCacheData(gomock.Any(), uint(4)).Return(nil)
+				mca.EXPECT().DeleteShortlist(gomock.Any(), uint(4)).Return(nil)
+				mca.EXPECT().DeleteTheCacheData(gomock.Any(), uint(7)).Return(nil)
+				mca.EXPECT().DeleteShortlist(gomock.Any(), uint(7)).Return(nil)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			mj := repository.NewMockJobRepository(mc)
+			mcr := repository.NewMockComapnyRepo(mc)
+			mt := repository.NewMockTaxonomyRepository(mc)
+			mca := cache.NewMockCaching(mc)
+			s, _ := NewJobService(mj, mcr, mt, mca, testRates)
+			if tt.mockResponse != nil {
+				tt.mockResponse(mj, mca)
+			}
+			got, err := s.ExpireJobs()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Service.ExpireJobs() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("Service.ExpireJobs() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAcceptingApplications(t *testing.T) {
+	past := time.Now().Add(-time.Hour)
+	future := time.Now().Add(time.Hour)
+	tests := []struct {
+		name    string
+		jobData model.Job
+		want    bool
+	}{
+		{
+			name:    "published",
+			jobData: model.Job{Status: model.JobStatusPublished},
+			want:    true,
+		},
+		{
+			name:    "published until a later date",
+			jobData: model.Job{Status: model.JobStatusPublished, ExpiresAt: &future},
+			want:    true,
+		},
+		{
+			name:    "published past its expiry date",
+			jobData: model.Job{Status: model.JobStatusPublished, ExpiresAt: &past},
+			want:    false,
+		},
+		{
+			name:    "paused",
+			jobData: model.Job{Status: model.JobStatusPaused},
+			want:    false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := acceptingApplications(tt.jobData); got != tt.want {
+				t.Errorf("acceptingApplications() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
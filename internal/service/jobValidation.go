@@ -0,0 +1,156 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"job-portal-api/internal/model"
+	"job-portal-api/internal/repository"
+	"strings"
+	"time"
+)
+
+// ValidationError carries every problem found in a job so that the client
+// can fix them all in one go instead of one request per mistake.
+type ValidationError struct {
+	Errors []model.FieldError
+}
+
+func (e *ValidationError) Error() string {
+	var fields []string
+	for _, v := range e.Errors {
+		fields = append(fields, v.Field+" : "+v.Message)
+	}
+	return "invalid job : " + strings.Join(fields, ", ")
+}
+
+func (e *ValidationError) add(field string, message string) {
+	e.Errors = append(e.Errors, model.FieldError{
+		Field:   field,
+		Message: message,
+	})
+}
+
+// validateJob checks the job against the company and reference data tables.
+// The expiry date is only checked when it is being set, so that an expired
+// posting can still be edited.
+func (s *Service) validateJob(jobData model.Job, checkExpiry bool) error {
+	validationErr := &ValidationError{}
+
+	_, err := s.comapnayRepo.GetCompanyByID(uint64(jobData.Cid))
+	if err != nil {
+		validationErr.add("companyID", "company does not exist")
+	}
+
+	s.validateJobFields(jobData, checkExpiry, validationErr)
+
+	references := []struct {
+		field string
+		kind  string
+		ids   []uint
+	}{
+		{field: "location", kind: model.TaxonomyLocation, ids: locationIDs(jobData.Location)},
+		{field: "technologyStack", kind: model.TaxonomyTechnologyStack, ids: technologyStackIDs(jobData.TechnologyStack)},
+		{field: "qualifications", kind: model.TaxonomyQualification, ids: qualificationIDs(jobData.Qualifications)},
+		{field: "shifts", kind: model.TaxonomyShift, ids: shiftIDs(jobData.Shift)},
+		{field: "jobtype", kind: model.TaxonomyJobType, ids: jobTypeIDs(jobData.Jobtype)},
+		{field: "skillRequirements", kind: model.TaxonomyTechnologyStack, ids: skillRequirementIDs(jobData.SkillRequirements)},
+	}
+	for _, v := range references {
+		missing, err := s.taxonomyRepo.FindMissingTaxonomyIDs(v.kind, v.ids)
+		if err != nil {
+			return err
+		}
+		if len(missing) != 0 {
+			validationErr.add(v.field, fmt.Sprintf("unknown ids %v", missing))
+		}
+	}
+
+	if len(validationErr.Errors) != 0 {
+		return validationErr
+	}
+	return nil
+}
+
+// validateJobFields runs the checks that need no lookups.
+func (s *Service) validateJobFields(jobData model.Job, checkExpiry bool, validationErr *ValidationError) {
+	if jobData.MinNoticePeriod < 0 {
+		validationErr.add("minNoticePeriod", "cannot be negative")
+	}
+	if jobData.MinNoticePeriod > int(jobData.MaxNoticePeriod) {
+		validationErr.add("minNoticePeriod", "cannot be greater than maxNoticePeriod")
+	}
+	if jobData.MinExperience < 0 {
+		validationErr.add("minExperience", "cannot be negative")
+	}
+	if jobData.MinExperience > int(jobData.MaxExperience) {
+		validationErr.add("minExperience", "cannot be greater than maxExperience")
+	}
+	if checkExpiry && jobData.ExpiresAt != nil && !jobData.ExpiresAt.After(time.Now()) {
+		validationErr.add("expiresAt", "must be in the future")
+	}
+	s.validateSalary(jobData, validationErr)
+	validateSkillRequirements(jobData.SkillRequirements, validationErr)
+	validateScoringPolicy(jobData, validationErr)
+}
+
+// referenceFields names the job field that holds each kind of reference
+// data.
+var referenceFields = map[string]string{
+	model.TaxonomyLocation:        "location",
+	model.TaxonomyTechnologyStack: "technologyStack",
+	model.TaxonomyQualification:   "qualifications",
+	model.TaxonomyShift:           "shifts",
+	model.TaxonomyJobType:         "jobtype",
+}
+
+// referenceError reports reference data that was retired between
+// validateJob and the save as the same field error validateJob gives.
+func referenceError(err error) error {
+	var referenceErr *repository.MissingReferenceError
+	if !errors.As(err, &referenceErr) {
+		return err
+	}
+	validationErr := &ValidationError{}
+	validationErr.add(referenceFields[referenceErr.Kind], fmt.Sprintf("unknown ids %v", referenceErr.IDs))
+	return validationErr
+}
+
+func locationIDs(locations []model.Location) []uint {
+	var ids []uint
+	for _, v := range locations {
+		ids = append(ids, v.ID)
+	}
+	return ids
+}
+
+func technologyStackIDs(stacks []model.TechnologyStack) []uint {
+	var ids []uint
+	for _, v := range stacks {
+		ids = append(ids, v.ID)
+	}
+	return ids
+}
+
+func qualificationIDs(qualifications []model.Qualification) []uint {
+	var ids []uint
+	for _, v := range qualifications {
+		ids = append(ids, v.ID)
+	}
+	return ids
+}
+
+func shiftIDs(shifts []model.Shift) []uint {
+	var ids []uint
+	for _, v := range shifts {
+		ids = append(ids, v.ID)
+	}
+	return ids
+}
+
+func jobTypeIDs(jobTypes []model.JobType) []uint {
+	var ids []uint
+	for _, v := range jobTypes {
+		ids = append(ids, v.ID)
+	}
+	return ids
+}
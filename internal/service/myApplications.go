@@ -0,0 +1,86 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"job-portal-api/internal/model"
+	"time"
+
+	"github.com/rs/zerolog/log"
+)
+
+// ViewMyApplications lists the candidate's applications newest first, each
+// with the job and company it was made to and the stage it is in.
+func (s *Service) ViewMyApplications(userID uint) ([]model.CandidateApplication, error) {
+
+	applications, err := s.applicationRepo.GetApplicationsByUserID(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	summaries := make([]model.CandidateApplication, 0, len(applications))
+	for _, v := range applications {
+		summaries = append(summaries, model.CandidateApplication{
+			ApplicationID:  v.ID,
+			JobID:          v.JobID,
+			Jobname:        v.Job.Jobname,
+			JobStatus:      v.Job.Status,
+			CompanyID:      v.Job.Cid,
+			CompanyName:    v.Job.Company.CompanyName,
+			Stage:          v.Stage,
+			Accepted:       v.Accepted,
+			AppliedAt:      v.CreatedAt,
+			StageChangedAt: v.StageChangedAt,
+		})
+	}
+
+	return summaries, nil
+}
+
+// WithdrawApplication retracts the candidate's own application while it is
+// still open. The withdrawal is recorded in the stage history and the
+// company that posted the job is notified.
+func (s *Service) WithdrawApplication(userID uint, aID uint) (model.Application, error) {
+
+	application, err := s.applicationRepo.GetApplicationByID(aID)
+	if err != nil {
+		return model.Application{}, err
+	}
+	if application.UserID != userID {
+		log.Error().Uint("application id", aID).Uint("user id", userID).Msg("withdrawal of another user's application")
+		return model.Application{}, ErrForbidden
+	}
+
+	if !canChangeApplicationStage(application.Stage, model.ApplicationStageWithdrawn) {
+		log.Error().Uint("application id", aID).Str("stage", application.Stage).Msg("application can no longer be withdrawn")
+		return model.Application{}, errors.New("application can no longer be withdrawn")
+	}
+
+	jobData, err := s.jobRepo.GetJobByJobID(application.JobID)
+	if err != nil {
+		return model.Application{}, err
+	}
+
+	now := time.Now()
+	application, err = s.applicationRepo.WithdrawApplication(application, model.ApplicationStageHistory{
+		ApplicationID: aID,
+		From:          application.Stage,
+		To:            model.ApplicationStageWithdrawn,
+		ChangedBy:     userID,
+		ChangedAt:     now,
+	}, model.CompanyNotification{
+		CompanyID:     jobData.Cid,
+		JobID:         application.JobID,
+		ApplicationID: aID,
+		Kind:          model.NotificationApplicationWithdrawn,
+		Message:       fmt.Sprintf("%s withdrew application %d to %s", application.Name, aID, jobData.Jobname),
+		CreatedAt:     now,
+	})
+	if err != nil {
+		return model.Application{}, err
+	}
+
+	s.invalidateShortlist(application.JobID)
+
+	return application, nil
+}
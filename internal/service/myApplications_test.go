@@ -0,0 +1,175 @@
+package service
+
+import (
+	"errors"
+	"job-portal-api/internal/cache"
+	"job-portal-api/internal/model"
+	"job-portal-api/internal/repository"
+	"reflect"
+	"testing"
+	"time"
+
+	gomock "go.uber.org/mock/gomock"
+	"gorm.io/gorm"
+)
+
+func TestService_ViewMyApplications(t *testing.T) {
+	appliedAt := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	tests := []struct {
+		name         string
+		want         []model.CandidateApplication
+		wantErr      bool
+		mockResponse func(ma *repository.MockApplicationRepository)
+	}{
+		{
+			name:    "failure",
+			want:    nil,
+			wantErr: true,
+			mockResponse: func(ma *repository.MockApplicationRepository) {
+				ma.EXPECT().GetApplicationsByUserID(uint(1)).Return(nil, errors.New("error"))
+			},
+		},
+		{
+			name:    "no applications",
+			want:    []model.CandidateApplication{},
+			wantErr: false,
+			mockResponse: func(ma *repository.MockApplicationRepository) {
+				ma.EXPECT().GetApplicationsByUserID(uint(1)).Return([]model.Application{}, nil)
+			},
+		},
+		{
+			name: "success",
+			want: []model.CandidateApplication{
+				{ApplicationID: 4, JobID: 2, Jobname: "golang developer", JobStatus: model.JobStatusPublished, CompanyID: 3, CompanyName: "tek", Stage: model.ApplicationStageInterview, Accepted: true, AppliedAt: appliedAt, StageChangedAt: appliedAt.Add(time.Hour)},
+			},
+			wantErr: false,
+			mockResponse: func(ma *repository.MockApplicationRepository) {
+				ma.EXPECT().GetApplicationsByUserID(uint(1)).Return([]model.Application{{
+					Model:          gorm.Model{ID: 4, CreatedAt: appliedAt},
+					UserID:         1,
+					JobID:          2,
+					Job:            model.Job{Model: gorm.Model{ID: 2}, Cid: 3, Company: model.Company{Model: gorm.Model{ID: 3}, CompanyName: "tek"}, Jobname: "golang developer", Status: model.JobStatusPublished},
+					Accepted:       true,
+					Stage:          model.ApplicationStageInterview,
+					StageChangedAt: appliedAt.Add(time.Hour),
+				}}, nil)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			ma := repository.NewMockApplicationRepository(mc)
+			tt.mockResponse(ma)
+			s, _ := NewApplicationService(ma, nil, nil, nil)
+			got, err := s.ViewMyApplications(1)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Service.ViewMyApplications() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Service.ViewMyApplications() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestService_WithdrawApplication(t *testing.T) {
+	application := model.Application{Model: gorm.Model{ID: 4}, UserID: 1, JobID: 2, Name: "asha", Stage: model.ApplicationStageInterview}
+	tests := []struct {
+		name         string
+		userID       uint
+		want         model.Application
+		wantErr      bool
+		errIs        error
+		mockResponse func(ma *repository.MockApplicationRepository, mj *repository.MockJobRepository, mca *cache.MockCaching)
+	}{
+		{
+			name:    "application not found",
+			userID:  1,
+			want:    model.Application{},
+			wantErr: true,
+			mockResponse: func(ma *repository.MockApplicationRepository, mj *repository.MockJobRepository, mca *cache.MockCaching) {
+				ma.EXPECT().GetApplicationByID(uint(4)).Return(model.Application{}, errors.New("error"))
+			},
+		},
+		{
+			name:    "another user's application",
+			userID:  9,
+			want:    model.Application{},
+			wantErr: true,
+			errIs:   ErrForbidden,
+			mockResponse: func(ma *repository.MockApplicationRepository, mj *repository.MockJobRepository, mca *cache.MockCaching) {
+				ma.EXPECT().GetApplicationByID(uint(4)).Return(application, nil)
+			},
+		},
+		{
+			name:    "already hired",
+			userID:  1,
+			want:    model.Application{},
+			wantErr: true,
+			mockResponse: func(ma *repository.MockApplicationRepository, mj *repository.MockJobRepository, mca *cache.MockCaching) {
+				hired := application
+				hired.Stage = model.ApplicationStageHired
+				ma.EXPECT().GetApplicationByID(uint(4)).Return(hired, nil)
+			},
+		},
+		{
+			name:    "failure in saving",
+			userID:  1,
+			want:    model.Application{},
+			wantErr: true,
+			mockResponse: func(ma *repository.MockApplicationRepository, mj *repository.MockJobRepository, mca *cache.MockCaching) {
+				ma.EXPECT().GetApplicationByID(uint(4)).Return(application, nil)
+				mj.EXPECT().GetJobByJobID(uint(2)).Return(model.Job{Model: gorm.Model{ID: 2}, Cid: 3, Jobname: "golang developer"}, nil)
+				ma.EXPECT().WithdrawApplication(gomock.Any(), gomock.Any(), gomock.Any()).Return(model.Application{}, errors.New("error"))
+			},
+		},
+		{
+			name:    "success",
+			userID:  1,
+			want:    model.Application{Model: gorm.Model{ID: 4}, UserID: 1, JobID: 2, Name: "asha", Stage: model.ApplicationStageWithdrawn},
+			wantErr: false,
+			mockResponse: func(ma *repository.MockApplicationRepository, mj *repository.MockJobRepository, mca *cache.MockCaching) {
+				ma.EXPECT().GetApplicationByID(uint(4)).Return(application, nil)
+				mj.EXPECT().GetJobByJobID(uint(2)).Return(model.Job{Model: gorm.Model{ID: 2}, Cid: 3, Jobname: "golang developer"}, nil)
+				ma.EXPECT().WithdrawApplication(application,
+					gomock.Cond(func(x any) bool {
+						change := x.(model.ApplicationStageHistory)
+						return change.ApplicationID == 4 && change.From == model.ApplicationStageInterview && change.To == model.ApplicationStageWithdrawn && change.ChangedBy == 1
+					}),
+					gomock.Cond(func(x any) bool {
+						notification := x.(model.CompanyNotification)
+						return notification.CompanyID == 3 && notification.JobID == 2 && notification.ApplicationID == 4 &&
+							notification.Kind == model.NotificationApplicationWithdrawn && notification.Message == "asha withdrew application 4 to golang developer"
+					}),
+				).DoAndReturn(func(application model.Application, change model.ApplicationStageHistory, notification model.CompanyNotification) (model.Application, error) {
+					application.Stage = change.To
+					return application, nil
+				})
+				mca.EXPECT().DeleteShortlist(gomock.Any(), uint(2)).Return(nil)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			ma := repository.NewMockApplicationRepository(mc)
+			mj := repository.NewMockJobRepository(mc)
+			mca := cache.NewMockCaching(mc)
+			tt.mockResponse(ma, mj, mca)
+			s, _ := NewApplicationService(ma, mj, nil, mca)
+			got, err := s.WithdrawApplication(tt.userID, 4)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Service.WithdrawApplication() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.errIs != nil && !errors.Is(err, tt.errIs) {
+				t.Errorf("Service.WithdrawApplication() error = %v, want %v", err, tt.errIs)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Service.WithdrawApplication() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,64 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"job-portal-api/internal/cache"
+	"job-portal-api/internal/model"
+	"job-portal-api/internal/repository"
+
+	"github.com/rs/zerolog/log"
+)
+
+//go:generate mockgen -source=profileService.go -destination=profileService_mock.go -package=service
+type CandidateProfileService interface {
+	ViewCandidateProfile(userID uint) (model.CandidateProfile, error)
+	UpdateCandidateProfile(userID uint, profileData model.NewCandidateProfile) (model.CandidateProfile, error)
+	ApplyWithProfile(ctx context.Context, userID uint, jID uint) (model.Application, error)
+}
+
+func NewCandidateProfileService(profileRepo repository.CandidateProfileRepository, applicationRepo repository.ApplicationRepository, jobRepo repository.JobRepository, rdb cache.Caching) (CandidateProfileService, error) {
+	if profileRepo == nil || applicationRepo == nil || jobRepo == nil || rdb == nil {
+		log.Info().Msg("profile, application and job repositories and cache cannot be nil")
+		return nil, errors.New("profile, application and job repositories and cache cannot be nil")
+	}
+	return &Service{
+		profileRepo:     profileRepo,
+		applicationRepo: applicationRepo,
+		jobRepo:         jobRepo,
+		rdb:             rdb,
+	}, nil
+}
+
+func (s *Service) ViewCandidateProfile(userID uint) (model.CandidateProfile, error) {
+	return s.profileRepo.GetCandidateProfile(userID)
+}
+
+// UpdateCandidateProfile replaces the candidate's profile, creating it on
+// the first update. Applications already submitted keep their details.
+func (s *Service) UpdateCandidateProfile(userID uint, profileData model.NewCandidateProfile) (model.CandidateProfile, error) {
+	return s.profileRepo.SaveCandidateProfile(model.CandidateProfile{
+		UserID:  userID,
+		Name:    profileData.Name,
+		Age:     profileData.Age,
+		Details: profileData.Jobs,
+	})
+}
+
+// ApplyWithProfile submits an application to the job with the details of
+// the candidate's profile. It follows SubmitApplication, so applying again
+// after the profile changed is an ApplicationConflictError.
+func (s *Service) ApplyWithProfile(ctx context.Context, userID uint, jID uint) (model.Application, error) {
+
+	profile, err := s.profileRepo.GetCandidateProfile(userID)
+	if err != nil {
+		return model.Application{}, err
+	}
+
+	return s.SubmitApplication(ctx, userID, model.NewUserApplication{
+		Name: profile.Name,
+		Age:  profile.Age,
+		Jid:  jID,
+		Jobs: profile.Details,
+	})
+}
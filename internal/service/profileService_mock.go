@@ -0,0 +1,85 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: profileService.go
+//
+// Generated by this command:
+//
+//	mockgen -source=profileService.go -destination=profileService_mock.go -package=service
+//
+// Package service is a generated GoMock package.
+package service
+
+import (
+	context "context"
+	model "job-portal-api/internal/model"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockCandidateProfileService is a mock of CandidateProfileService interface.
+type MockCandidateProfileService struct {
+	ctrl     *gomock.Controller
+	recorder *MockCandidateProfileServiceMockRecorder
+}
+
+// MockCandidateProfileServiceMockRecorder is the mock recorder for MockCandidateProfileService.
+type MockCandidateProfileServiceMockRecorder struct {
+	mock *MockCandidateProfileService
+}
+
+// NewMockCandidateProfileService creates a new mock instance.
+func NewMockCandidateProfileService(ctrl *gomock.Controller) *MockCandidateProfileService {
+	mock := &MockCandidateProfileService{ctrl: ctrl}
+	mock.recorder = &MockCandidateProfileServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCandidateProfileService) EXPECT() *MockCandidateProfileServiceMockRecorder {
+	return m.recorder
+}
+
+// ApplyWithProfile mocks base method.
+func (m *MockCandidateProfileService) ApplyWithProfile(ctx context.Context, userID, jID uint) (model.Application, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ApplyWithProfile", ctx, userID, jID)
+	ret0, _ := ret[0].(model.Application)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ApplyWithProfile indicates an expected call of ApplyWithProfile.
+func (mr *MockCandidateProfileServiceMockRecorder) ApplyWithProfile(ctx, userID, jID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApplyWithProfile", reflect.TypeOf((*MockCandidateProfileService)(nil).ApplyWithProfile), ctx, userID, jID)
+}
+
+// UpdateCandidateProfile mocks base method.
+func (m *MockCandidateProfileService) UpdateCandidateProfile(userID uint, profileData model.NewCandidateProfile) (model.CandidateProfile, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateCandidateProfile", userID, profileData)
+	ret0, _ := ret[0].(model.CandidateProfile)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateCandidateProfile indicates an expected call of UpdateCandidateProfile.
+func (mr *MockCandidateProfileServiceMockRecorder) UpdateCandidateProfile(userID, profileData any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCandidateProfile", reflect.TypeOf((*MockCandidateProfileService)(nil).UpdateCandidateProfile), userID, profileData)
+}
+
+// ViewCandidateProfile mocks base method.
+func (m *MockCandidateProfileService) ViewCandidateProfile(userID uint) (model.CandidateProfile, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ViewCandidateProfile", userID)
+	ret0, _ := ret[0].(model.CandidateProfile)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ViewCandidateProfile indicates an expected call of ViewCandidateProfile.
+func (mr *MockCandidateProfileServiceMockRecorder) ViewCandidateProfile(userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ViewCandidateProfile", reflect.TypeOf((*MockCandidateProfileService)(nil).ViewCandidateProfile), userID)
+}
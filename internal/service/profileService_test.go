@@ -0,0 +1,150 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"job-portal-api/internal/cache"
+	"job-portal-api/internal/model"
+	"job-portal-api/internal/repository"
+	"reflect"
+	"testing"
+	"time"
+
+	gomock "go.uber.org/mock/gomock"
+	"gorm.io/gorm"
+)
+
+func TestService_UpdateCandidateProfile(t *testing.T) {
+	profileData := model.NewCandidateProfile{
+		Name: "asha",
+		Age:  "25",
+		Jobs: model.Requestfield{NoticePeriod: 30, Experience: 2, TechnologyStack: []uint{1}},
+	}
+	tests := []struct {
+		name         string
+		want         model.CandidateProfile
+		wantErr      bool
+		mockResponse func(mp *repository.MockCandidateProfileRepository)
+	}{
+		{
+			name:    "failure",
+			want:    model.CandidateProfile{},
+			wantErr: true,
+			mockResponse: func(mp *repository.MockCandidateProfileRepository) {
+				mp.EXPECT().SaveCandidateProfile(gomock.Any()).Return(model.CandidateProfile{}, errors.New("error"))
+			},
+		},
+		{
+			name:    "success",
+			want:    model.CandidateProfile{UserID: 1, Name: "asha", Age: "25", Details: profileData.Jobs},
+			wantErr: false,
+			mockResponse: func(mp *repository.MockCandidateProfileRepository) {
+				mp.EXPECT().SaveCandidateProfile(model.CandidateProfile{UserID: 1, Name: "asha", Age: "25", Details: profileData.Jobs}).
+					Return(model.CandidateProfile{UserID: 1, Name: "asha", Age: "25", Details: profileData.Jobs}, nil)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			mp := repository.NewMockCandidateProfileRepository(mc)
+			tt.mockResponse(mp)
+			s, _ := NewCandidateProfileService(mp, repository.NewMockApplicationRepository(mc), repository.NewMockJobRepository(mc), cache.NewMockCaching(mc))
+			got, err := s.UpdateCandidateProfile(1, profileData)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Service.UpdateCandidateProfile() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Service.UpdateCandidateProfile() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestService_ApplyWithProfile(t *testing.T) {
+	profile := model.CandidateProfile{
+		UserID:  1,
+		Name:    "asha",
+		Age:     "25",
+		Details: model.Requestfield{NoticePeriod: 30, Experience: 2, Location: []uint{1}, TechnologyStack: []uint{1}},
+	}
+	tests := []struct {
+		name         string
+		want         model.Application
+		wantErr      bool
+		errIs        error
+		mockResponse func(mp *repository.MockCandidateProfileRepository, ma *repository.MockApplicationRepository, mca *cache.MockCaching)
+	}{
+		{
+			name:    "no profile",
+			want:    model.Application{},
+			wantErr: true,
+			errIs:   repository.ErrProfileNotFound,
+			mockResponse: func(mp *repository.MockCandidateProfileRepository, ma *repository.MockApplicationRepository, mca *cache.MockCaching) {
+				mp.EXPECT().GetCandidateProfile(uint(1)).Return(model.CandidateProfile{}, repository.ErrProfileNotFound)
+			},
+		},
+		{
+			name:    "applied earlier with other details",
+			want:    model.Application{},
+			wantErr: true,
+			mockResponse: func(mp *repository.MockCandidateProfileRepository, ma *repository.MockApplicationRepository, mca *cache.MockCaching) {
+				mp.EXPECT().GetCandidateProfile(uint(1)).Return(profile, nil)
+				ma.EXPECT().GetApplicationByUserAndJob(uint(1), uint(2)).Return(model.Application{Model: gorm.Model{ID: 5}, UserID: 1, JobID: 2, Name: "asha", Age: "25", Details: model.Requestfield{NoticePeriod: 90}}, nil)
+			},
+		},
+		{
+			name: "success",
+			want: model.Application{
+				Model:   gorm.Model{ID: 1},
+				UserID:  1,
+				JobID:   2,
+				Name:    "asha",
+				Age:     "25",
+				Details: profile.Details,
+				Screening: model.ScreeningResult{
+					Score:     0,
+					MaxScore:  7,
+					Threshold: 3.5,
+				},
+				Stage: model.ApplicationStageApplied,
+			},
+			wantErr: false,
+			mockResponse: func(mp *repository.MockCandidateProfileRepository, ma *repository.MockApplicationRepository, mca *cache.MockCaching) {
+				mp.EXPECT().GetCandidateProfile(uint(1)).Return(profile, nil)
+				ma.EXPECT().GetApplicationByUserAndJob(uint(1), uint(2)).Return(model.Application{}, repository.ErrApplicationNotFound)
+				mca.EXPECT().GetTheCacheData(gomock.Any(), uint(2)).Return(`{"ID":2,"status":"published"}`, nil)
+				ma.EXPECT().CreateApplication(gomock.Any()).DoAndReturn(func(application model.Application) (model.Application, error) {
+					application.ID = 1
+					return application, nil
+				})
+				mca.EXPECT().DeleteShortlist(gomock.Any(), uint(2)).Return(nil)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			mp := repository.NewMockCandidateProfileRepository(mc)
+			ma := repository.NewMockApplicationRepository(mc)
+			mca := cache.NewMockCaching(mc)
+			tt.mockResponse(mp, ma, mca)
+			s, _ := NewCandidateProfileService(mp, ma, repository.NewMockJobRepository(mc), mca)
+			got, err := s.ApplyWithProfile(context.Background(), 1, 2)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Service.ApplyWithProfile() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.errIs != nil && !errors.Is(err, tt.errIs) {
+				t.Errorf("Service.ApplyWithProfile() error = %v, want %v", err, tt.errIs)
+			}
+			got.ScreenedAt = time.Time{}
+			got.StageChangedAt = time.Time{}
+			got.Screening.Criteria = nil
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Service.ApplyWithProfile() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
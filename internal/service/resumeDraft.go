@@ -0,0 +1,169 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"io"
+	"job-portal-api/internal/model"
+	"job-portal-api/internal/repository"
+	"job-portal-api/internal/resume"
+	"job-portal-api/internal/storage"
+	"strings"
+	"unicode"
+
+	"github.com/rs/zerolog/log"
+)
+
+var ErrResumeUnreadable = errors.New("no text could be read from the resume")
+
+//go:generate mockgen -source=resumeDraft.go -destination=resumeDraft_mock.go -package=service
+type ResumeService interface {
+	DraftApplication(fileName string, size int64, data io.Reader) (model.ApplicationDraft, error)
+	DraftApplicationFromAttachment(ctx context.Context, userID uint, atID uint) (model.ApplicationDraft, error)
+}
+
+func NewResumeService(taxonomyRepo repository.TaxonomyRepository, attachmentRepo repository.AttachmentRepository, store storage.Storage, maxAttachmentSize int64) (ResumeService, error) {
+	if taxonomyRepo == nil || attachmentRepo == nil || store == nil {
+		log.Info().Msg("taxonomy repository, attachment repository and storage cannot be nil")
+		return nil, errors.New("taxonomy repository, attachment repository and storage cannot be nil")
+	}
+	return &Service{
+		taxonomyRepo:      taxonomyRepo,
+		attachmentRepo:    attachmentRepo,
+		store:             store,
+		maxAttachmentSize: maxAttachmentSize,
+	}, nil
+}
+
+// draftKinds are the reference data looked for in a resume.
+var draftKinds = []string{model.TaxonomyLocation, model.TaxonomyTechnologyStack, model.TaxonomyQualification}
+
+// DraftApplication reads an uploaded resume without storing it.
+func (s *Service) DraftApplication(fileName string, size int64, data io.Reader) (model.ApplicationDraft, error) {
+	if size <= 0 || size > s.maxAttachmentSize {
+		log.Error().Int64("size", size).Int64("max size", s.maxAttachmentSize).Msg("invalid resume size")
+		return model.ApplicationDraft{}, ErrAttachmentTooLarge
+	}
+	return s.draftFromFile(fileName, data)
+}
+
+// DraftApplicationFromAttachment reads a resume the candidate has already
+// uploaded with one of their applications.
+func (s *Service) DraftApplicationFromAttachment(ctx context.Context, userID uint, atID uint) (model.ApplicationDraft, error) {
+
+	attachment, err := s.attachmentRepo.GetAttachmentByID(atID)
+	if err != nil {
+		return model.ApplicationDraft{}, err
+	}
+	if attachment.UploadedBy != userID {
+		log.Error().Uint("attachment id", atID).Uint("user id", userID).Msg("resume draft from another user's attachment")
+		return model.ApplicationDraft{}, ErrForbidden
+	}
+
+	data, err := s.store.Get(ctx, attachment.StorageKey)
+	if err != nil {
+		return model.ApplicationDraft{}, err
+	}
+	defer data.Close()
+
+	return s.draftFromFile(attachment.FileName, data)
+}
+
+func (s *Service) draftFromFile(fileName string, data io.Reader) (model.ApplicationDraft, error) {
+	content, err := io.ReadAll(io.LimitReader(data, s.maxAttachmentSize+1))
+	if err != nil {
+		log.Error().Err(err).Msg("error in reading resume")
+		return model.ApplicationDraft{}, errors.New("could not read the resume")
+	}
+	if int64(len(content)) > s.maxAttachmentSize {
+		log.Error().Int64("max size", s.maxAttachmentSize).Msg("resume is too large")
+		return model.ApplicationDraft{}, ErrAttachmentTooLarge
+	}
+
+	fileName, _, err = attachmentType(fileName, content[:min(len(content), 512)])
+	if err != nil {
+		return model.ApplicationDraft{}, err
+	}
+
+	text, err := resume.ExtractText(fileName, content)
+	if err != nil {
+		log.Error().Err(err).Str("file name", fileName).Msg("error in extracting resume text")
+		return model.ApplicationDraft{}, ErrResumeUnreadable
+	}
+
+	return s.draftApplication(text)
+}
+
+// draftApplication suggests the locations, technology stacks and
+// qualifications whose names appear in the resume text as whole words.
+// Short names such as Go can match ordinary words, which is why the result
+// is only a draft.
+func (s *Service) draftApplication(text string) (model.ApplicationDraft, error) {
+	words := " " + strings.Join(nameWords(text), " ") + " "
+
+	draft := model.ApplicationDraft{
+		Jobs: model.Requestfield{
+			Location:        []uint{},
+			TechnologyStack: []uint{},
+			Qualifications:  []uint{},
+			Shift:           []uint{},
+			Jobtype:         []uint{},
+			Skills:          []model.SkillLevel{},
+		},
+		Matches: []model.DraftMatch{},
+	}
+
+	for _, kind := range draftKinds {
+		taxonomies, err := s.taxonomyRepo.GetAllTaxonomies(kind)
+		if err != nil {
+			return model.ApplicationDraft{}, err
+		}
+
+		for _, v := range taxonomies {
+			if !mentions(words, v.Name) {
+				continue
+			}
+			switch kind {
+			case model.TaxonomyLocation:
+				draft.Jobs.Location = append(draft.Jobs.Location, v.ID)
+			case model.TaxonomyTechnologyStack:
+				draft.Jobs.TechnologyStack = append(draft.Jobs.TechnologyStack, v.ID)
+			case model.TaxonomyQualification:
+				draft.Jobs.Qualifications = append(draft.Jobs.Qualifications, v.ID)
+			}
+			draft.Matches = append(draft.Matches, model.DraftMatch{Kind: kind, ID: v.ID, Name: v.Name})
+		}
+	}
+
+	return draft, nil
+}
+
+// nameWords lower cases text and splits it into words, keeping + and # so
+// that C++ and C# stay apart from C.
+func nameWords(text string) []string {
+	return strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r) && r != '+' && r != '#'
+	})
+}
+
+// mentions reports whether words, space separated and padded, contain a
+// name. Every alternative of a name such as "B.E / B.Tech" is tried, both
+// as its words and run together, so Node.js also matches NodeJS.
+func mentions(words string, name string) bool {
+	for _, alternative := range strings.Split(name, "/") {
+		altWords := nameWords(alternative)
+		if len(altWords) == 0 {
+			continue
+		}
+		if strings.Contains(words, " "+strings.Join(altWords, " ")+" ") {
+			return true
+		}
+		//run together forms shorter than three letters, B.E as be, are
+		//ordinary words
+		joined := strings.Join(altWords, "")
+		if len(altWords) > 1 && len(joined) >= 3 && strings.Contains(words, " "+joined+" ") {
+			return true
+		}
+	}
+	return false
+}
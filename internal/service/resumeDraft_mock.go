@@ -0,0 +1,71 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: resumeDraft.go
+//
+// Generated by this command:
+//
+//	mockgen -source=resumeDraft.go -destination=resumeDraft_mock.go -package=service
+//
+// Package service is a generated GoMock package.
+package service
+
+import (
+	context "context"
+	io "io"
+	model "job-portal-api/internal/model"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockResumeService is a mock of ResumeService interface.
+type MockResumeService struct {
+	ctrl     *gomock.Controller
+	recorder *MockResumeServiceMockRecorder
+}
+
+// MockResumeServiceMockRecorder is the mock recorder for MockResumeService.
+type MockResumeServiceMockRecorder struct {
+	mock *MockResumeService
+}
+
+// NewMockResumeService creates a new mock instance.
+func NewMockResumeService(ctrl *gomock.Controller) *MockResumeService {
+	mock := &MockResumeService{ctrl: ctrl}
+	mock.recorder = &MockResumeServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockResumeService) EXPECT() *MockResumeServiceMockRecorder {
+	return m.recorder
+}
+
+// DraftApplication mocks base method.
+func (m *MockResumeService) DraftApplication(fileName string, size int64, data io.Reader) (model.ApplicationDraft, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DraftApplication", fileName, size, data)
+	ret0, _ := ret[0].(model.ApplicationDraft)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DraftApplication indicates an expected call of DraftApplication.
+func (mr *MockResumeServiceMockRecorder) DraftApplication(fileName, size, data any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DraftApplication", reflect.TypeOf((*MockResumeService)(nil).DraftApplication), fileName, size, data)
+}
+
+// DraftApplicationFromAttachment mocks base method.
+func (m *MockResumeService) DraftApplicationFromAttachment(ctx context.Context, userID, atID uint) (model.ApplicationDraft, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DraftApplicationFromAttachment", ctx, userID, atID)
+	ret0, _ := ret[0].(model.ApplicationDraft)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DraftApplicationFromAttachment indicates an expected call of DraftApplicationFromAttachment.
+func (mr *MockResumeServiceMockRecorder) DraftApplicationFromAttachment(ctx, userID, atID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DraftApplicationFromAttachment", reflect.TypeOf((*MockResumeService)(nil).DraftApplicationFromAttachment), ctx, userID, atID)
+}
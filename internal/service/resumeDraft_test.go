@@ -0,0 +1,210 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"io"
+	"job-portal-api/internal/model"
+	"job-portal-api/internal/repository"
+	"job-portal-api/internal/storage"
+	"reflect"
+	"strings"
+	"testing"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+func expectDraftTaxonomies(mt *repository.MockTaxonomyRepository) {
+	mt.EXPECT().GetAllTaxonomies(model.TaxonomyLocation).Return([]model.Taxonomy{{ID: 1, Name: "Bengaluru"}, {ID: 2, Name: "Pune"}}, nil)
+	mt.EXPECT().GetAllTaxonomies(model.TaxonomyTechnologyStack).Return([]model.Taxonomy{{ID: 1, Name: "Go"}, {ID: 2, Name: "Java"}, {ID: 3, Name: "Node.js"}, {ID: 4, Name: "C++"}, {ID: 5, Name: "C"}}, nil)
+	mt.EXPECT().GetAllTaxonomies(model.TaxonomyQualification).Return([]model.Taxonomy{{ID: 1, Name: "B.E / B.Tech"}, {ID: 2, Name: "M.E / M.Tech"}}, nil)
+}
+
+func TestService_DraftApplication(t *testing.T) {
+	resumeText := "Backend developer in Pune.\nSkills: golang, GO, NodeJS, C++\nB.Tech (2019), happy to be on call"
+	draft := model.ApplicationDraft{
+		Jobs: model.Requestfield{
+			Location:        []uint{2},
+			TechnologyStack: []uint{1, 3, 4},
+			Qualifications:  []uint{1},
+			Shift:           []uint{},
+			Jobtype:         []uint{},
+			Skills:          []model.SkillLevel{},
+		},
+		Matches: []model.DraftMatch{
+			{Kind: model.TaxonomyLocation, ID: 2, Name: "Pune"},
+			{Kind: model.TaxonomyTechnologyStack, ID: 1, Name: "Go"},
+			{Kind: model.TaxonomyTechnologyStack, ID: 3, Name: "Node.js"},
+			{Kind: model.TaxonomyTechnologyStack, ID: 4, Name: "C++"},
+			{Kind: model.TaxonomyQualification, ID: 1, Name: "B.E / B.Tech"},
+		},
+	}
+	tests := []struct {
+		name         string
+		fileName     string
+		content      string
+		size         int64
+		want         model.ApplicationDraft
+		wantErr      bool
+		errIs        error
+		mockResponse func(mt *repository.MockTaxonomyRepository)
+	}{
+		{
+			name:     "too large",
+			fileName: "resume.txt",
+			content:  resumeText,
+			size:     1025,
+			wantErr:  true,
+			errIs:    ErrAttachmentTooLarge,
+		},
+		{
+			name:     "larger than declared",
+			fileName: "resume.txt",
+			content:  strings.Repeat("Go ", 400),
+			size:     10,
+			wantErr:  true,
+			errIs:    ErrAttachmentTooLarge,
+		},
+		{
+			name:     "unsupported extension",
+			fileName: "resume.exe",
+			content:  resumeText,
+			size:     int64(len(resumeText)),
+			wantErr:  true,
+			errIs:    ErrAttachmentUnsupported,
+		},
+		{
+			name:     "no text in the resume",
+			fileName: "resume.pdf",
+			content:  "%PDF-1.4\n1 0 obj\n<< >>\nendobj\n",
+			size:     29,
+			wantErr:  true,
+			errIs:    ErrResumeUnreadable,
+		},
+		{
+			name:     "failure in fetching reference data",
+			fileName: "resume.txt",
+			content:  resumeText,
+			size:     int64(len(resumeText)),
+			wantErr:  true,
+			mockResponse: func(mt *repository.MockTaxonomyRepository) {
+				mt.EXPECT().GetAllTaxonomies(model.TaxonomyLocation).Return(nil, errors.New("error"))
+			},
+		},
+		{
+			name:         "success",
+			fileName:     `C:\Users\me\resume.txt`,
+			content:      resumeText,
+			size:         int64(len(resumeText)),
+			want:         draft,
+			mockResponse: expectDraftTaxonomies,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			mt := repository.NewMockTaxonomyRepository(mc)
+			if tt.mockResponse != nil {
+				tt.mockResponse(mt)
+			}
+			s, _ := NewResumeService(mt, repository.NewMockAttachmentRepository(mc), storage.NewMockStorage(mc), 1024)
+			got, err := s.DraftApplication(tt.fileName, tt.size, strings.NewReader(tt.content))
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Service.DraftApplication() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.errIs != nil && !errors.Is(err, tt.errIs) {
+				t.Errorf("Service.DraftApplication() error = %v, want %v", err, tt.errIs)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Service.DraftApplication() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestService_DraftApplicationFromAttachment(t *testing.T) {
+	resumeText := "Java developer from Bengaluru, M.Tech"
+	attachment := model.Attachment{ID: 7, ApplicationID: 3, Kind: model.AttachmentKindResume, FileName: "resume.txt", StorageKey: "applications/3/abc", UploadedBy: 1}
+	tests := []struct {
+		name         string
+		userID       uint
+		want         model.ApplicationDraft
+		wantErr      bool
+		errIs        error
+		mockResponse func(mt *repository.MockTaxonomyRepository, mat *repository.MockAttachmentRepository, ms *storage.MockStorage)
+	}{
+		{
+			name:    "attachment not found",
+			userID:  1,
+			wantErr: true,
+			mockResponse: func(mt *repository.MockTaxonomyRepository, mat *repository.MockAttachmentRepository, ms *storage.MockStorage) {
+				mat.EXPECT().GetAttachmentByID(uint(7)).Return(model.Attachment{}, errors.New("error"))
+			},
+		},
+		{
+			name:    "another user's attachment",
+			userID:  9,
+			wantErr: true,
+			errIs:   ErrForbidden,
+			mockResponse: func(mt *repository.MockTaxonomyRepository, mat *repository.MockAttachmentRepository, ms *storage.MockStorage) {
+				mat.EXPECT().GetAttachmentByID(uint(7)).Return(attachment, nil)
+			},
+		},
+		{
+			name:    "missing file",
+			userID:  1,
+			wantErr: true,
+			errIs:   storage.ErrNotFound,
+			mockResponse: func(mt *repository.MockTaxonomyRepository, mat *repository.MockAttachmentRepository, ms *storage.MockStorage) {
+				mat.EXPECT().GetAttachmentByID(uint(7)).Return(attachment, nil)
+				ms.EXPECT().Get(gomock.Any(), "applications/3/abc").Return(nil, storage.ErrNotFound)
+			},
+		},
+		{
+			name:   "success",
+			userID: 1,
+			want: model.ApplicationDraft{
+				Jobs: model.Requestfield{
+					Location:        []uint{1},
+					TechnologyStack: []uint{2},
+					Qualifications:  []uint{2},
+					Shift:           []uint{},
+					Jobtype:         []uint{},
+					Skills:          []model.SkillLevel{},
+				},
+				Matches: []model.DraftMatch{
+					{Kind: model.TaxonomyLocation, ID: 1, Name: "Bengaluru"},
+					{Kind: model.TaxonomyTechnologyStack, ID: 2, Name: "Java"},
+					{Kind: model.TaxonomyQualification, ID: 2, Name: "M.E / M.Tech"},
+				},
+			},
+			mockResponse: func(mt *repository.MockTaxonomyRepository, mat *repository.MockAttachmentRepository, ms *storage.MockStorage) {
+				mat.EXPECT().GetAttachmentByID(uint(7)).Return(attachment, nil)
+				ms.EXPECT().Get(gomock.Any(), "applications/3/abc").Return(io.NopCloser(strings.NewReader(resumeText)), nil)
+				expectDraftTaxonomies(mt)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			mt := repository.NewMockTaxonomyRepository(mc)
+			mat := repository.NewMockAttachmentRepository(mc)
+			ms := storage.NewMockStorage(mc)
+			tt.mockResponse(mt, mat, ms)
+			s, _ := NewResumeService(mt, mat, ms, 1024)
+			got, err := s.DraftApplicationFromAttachment(context.Background(), tt.userID, 7)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Service.DraftApplicationFromAttachment() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.errIs != nil && !errors.Is(err, tt.errIs) {
+				t.Errorf("Service.DraftApplicationFromAttachment() error = %v, want %v", err, tt.errIs)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Service.DraftApplicationFromAttachment() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
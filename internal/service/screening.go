@@ -0,0 +1,250 @@
+package service
+
+import (
+	"fmt"
+	"job-portal-api/internal/model"
+	"sort"
+)
+
+// defaultScreeningThreshold is the share of the maximum score an application
+// needs when the job does not set its own threshold.
+const defaultScreeningThreshold = 0.5
+
+// CompareData screens an application against a job using the job's scoring
+// policy. Every criterion scores its weight, one point unless the policy says
+// otherwise, times how far the application fulfils it. Only skills can be
+// fulfilled in part, when the job lists skill requirements.
+func CompareData(application model.NewUserApplication, jobData model.Job) model.ScreeningResult {
+	policy := jobData.ScoringPolicy
+	result := model.ScreeningResult{}
+
+	//the criteria are scored from 0 to 1 here and weighted below
+	criteria := []model.CriterionResult{
+		compareRange(model.CriterionNoticePeriod, "notice period", "days", application.Jobs.NoticePeriod, jobData.MinNoticePeriod, int(jobData.MaxNoticePeriod)),
+		compareRange(model.CriterionExperience, "experience", "years", application.Jobs.Experience, jobData.MinExperience, int(jobData.MaxExperience)),
+		compareIDs(model.CriterionLocation, application.Jobs.Location, locationIDs(jobData.Location)),
+	}
+	if len(jobData.SkillRequirements) != 0 {
+		skills, skillResults := compareSkills(application.Jobs, jobData.SkillRequirements)
+		criteria = append(criteria, skills)
+		result.Skills = skillResults
+	} else {
+		criteria = append(criteria, compareIDs(model.CriterionSkills, application.Jobs.TechnologyStack, technologyStackIDs(jobData.TechnologyStack)))
+	}
+	criteria = append(criteria,
+		compareIDs(model.CriterionQualifications, application.Jobs.Qualifications, qualificationIDs(jobData.Qualifications)),
+		compareIDs(model.CriterionShift, application.Jobs.Shift, shiftIDs(jobData.Shift)),
+		compareIDs(model.CriterionJobType, application.Jobs.Jobtype, jobTypeIDs(jobData.Jobtype)),
+	)
+
+	result.Criteria = criteria
+	for i := range result.Criteria {
+		v := &result.Criteria[i]
+		v.Weight = criterionWeight(policy, v.Criterion)
+		v.Score = v.Weight * v.Score
+		result.Score += v.Score
+		result.MaxScore += v.Weight
+	}
+
+	threshold := defaultScreeningThreshold
+	if policy.Threshold != nil {
+		threshold = *policy.Threshold
+	}
+	result.Threshold = result.MaxScore * threshold
+
+	for _, v := range policy.Knockouts {
+		knockout := checkKnockout(v, application.Jobs, result.Criteria)
+		if !knockout.Met {
+			result.KnockedOut = true
+		}
+		result.Knockouts = append(result.Knockouts, knockout)
+	}
+
+	result.Accepted = !result.KnockedOut && result.Score >= result.Threshold
+
+	return result
+}
+
+func criterionWeight(policy model.ScoringPolicy, criterion string) float64 {
+	weight, ok := policy.Weights[criterion]
+	if !ok {
+		return 1
+	}
+	return weight
+}
+
+func checkKnockout(knockout model.Knockout, application model.Requestfield, criteria []model.CriterionResult) model.KnockoutResult {
+	result := model.KnockoutResult{
+		Criterion: knockout.Criterion,
+		ID:        knockout.ID,
+	}
+
+	if knockout.ID != 0 {
+		for _, v := range applicationCriterionIDs(application, knockout.Criterion) {
+			if v == knockout.ID {
+				result.Met = true
+				result.Reason = fmt.Sprintf("lists required id %d", knockout.ID)
+				return result
+			}
+		}
+		result.Reason = fmt.Sprintf("does not list required id %d", knockout.ID)
+		return result
+	}
+
+	for _, v := range criteria {
+		if v.Criterion == knockout.Criterion && v.Matched {
+			result.Met = true
+			result.Reason = "required criterion matched"
+			return result
+		}
+	}
+	result.Reason = "required criterion did not match"
+	return result
+}
+
+func compareRange(criterion string, label string, unit string, value int, min int, max int) model.CriterionResult {
+	result := model.CriterionResult{Criterion: criterion}
+	if value >= min && value <= max {
+		result.Matched = true
+		result.Score = 1
+		result.Reason = fmt.Sprintf("%s of %d %s is within %d-%d %s", label, value, unit, min, max, unit)
+		return result
+	}
+	result.Reason = fmt.Sprintf("%s of %d %s is outside %d-%d %s", label, value, unit, min, max, unit)
+	return result
+}
+
+func compareIDs(criterion string, applied []uint, wanted []uint) model.CriterionResult {
+	result := model.CriterionResult{Criterion: criterion}
+
+	var matched []uint
+	for _, v := range applied {
+		for _, v1 := range wanted {
+			if v == v1 {
+				matched = append(matched, v)
+			}
+		}
+	}
+
+	if len(matched) != 0 {
+		result.Matched = true
+		result.Score = 1
+		result.Reason = fmt.Sprintf("matches %v of %v", matched, wanted)
+		return result
+	}
+	if len(wanted) == 0 {
+		result.Reason = "job does not list any"
+		return result
+	}
+	if len(applied) == 0 {
+		result.Reason = fmt.Sprintf("none given, job asks for one of %v", wanted)
+		return result
+	}
+	result.Reason = fmt.Sprintf("none of %v match %v", applied, wanted)
+	return result
+}
+
+// jobCriterionIDs returns the reference data ids a job lists for a
+// criterion, false when the criterion is not a list of ids.
+func jobCriterionIDs(jobData model.Job, criterion string) ([]uint, bool) {
+	switch criterion {
+	case model.CriterionLocation:
+		return locationIDs(jobData.Location), true
+	case model.CriterionSkills:
+		return append(technologyStackIDs(jobData.TechnologyStack), skillRequirementIDs(jobData.SkillRequirements)...), true
+	case model.CriterionQualifications:
+		return qualificationIDs(jobData.Qualifications), true
+	case model.CriterionShift:
+		return shiftIDs(jobData.Shift), true
+	case model.CriterionJobType:
+		return jobTypeIDs(jobData.Jobtype), true
+	}
+	return nil, false
+}
+
+func applicationCriterionIDs(application model.Requestfield, criterion string) []uint {
+	switch criterion {
+	case model.CriterionLocation:
+		return application.Location
+	case model.CriterionSkills:
+		ids := append([]uint{}, application.TechnologyStack...)
+		for _, v := range application.Skills {
+			ids = append(ids, v.TechnologyStackID)
+		}
+		return ids
+	case model.CriterionQualifications:
+		return application.Qualifications
+	case model.CriterionShift:
+		return application.Shift
+	case model.CriterionJobType:
+		return application.Jobtype
+	}
+	return nil
+}
+
+func isScreeningCriterion(criterion string) bool {
+	for _, v := range model.ScreeningCriteria {
+		if v == criterion {
+			return true
+		}
+	}
+	return false
+}
+
+// validateScoringPolicy checks that the policy only names known criteria and
+// that every knockout id is one the job lists.
+func validateScoringPolicy(jobData model.Job, validationErr *ValidationError) {
+	policy := jobData.ScoringPolicy
+
+	var criteria []string
+	for k := range policy.Weights {
+		criteria = append(criteria, k)
+	}
+	sort.Strings(criteria)
+	for _, v := range criteria {
+		if !isScreeningCriterion(v) {
+			validationErr.add("scoringPolicy.weights", fmt.Sprintf("unknown criterion %q", v))
+			continue
+		}
+		if policy.Weights[v] < 0 {
+			validationErr.add("scoringPolicy.weights", v+" cannot be negative")
+		}
+	}
+
+	maxScore := 0.0
+	for _, v := range model.ScreeningCriteria {
+		maxScore += criterionWeight(policy, v)
+	}
+	if maxScore <= 0 {
+		validationErr.add("scoringPolicy.weights", "at least one criterion needs a weight")
+	}
+
+	if policy.Threshold != nil && (*policy.Threshold < 0 || *policy.Threshold > 1) {
+		validationErr.add("scoringPolicy.threshold", "must be between 0 and 1")
+	}
+
+	for i, v := range policy.Knockouts {
+		field := fmt.Sprintf("scoringPolicy.knockouts[%d]", i)
+		if !isScreeningCriterion(v.Criterion) {
+			validationErr.add(field, fmt.Sprintf("unknown criterion %q", v.Criterion))
+			continue
+		}
+		if v.ID == 0 {
+			continue
+		}
+		ids, ok := jobCriterionIDs(jobData, v.Criterion)
+		if !ok {
+			validationErr.add(field, v.Criterion+" does not take an id")
+			continue
+		}
+		listed := false
+		for _, id := range ids {
+			if id == v.ID {
+				listed = true
+			}
+		}
+		if !listed {
+			validationErr.add(field, fmt.Sprintf("id %d is not listed on the job", v.ID))
+		}
+	}
+}
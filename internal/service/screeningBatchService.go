@@ -0,0 +1,241 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"job-portal-api/internal/cache"
+	"job-portal-api/internal/model"
+	"job-portal-api/internal/repository"
+	"sync/atomic"
+	"time"
+
+	"github.com/rs/zerolog/log"
+)
+
+// maxScreeningBatchItems caps the size of one screening batch
+const maxScreeningBatchItems = 10000
+
+//go:generate mockgen -source=screeningBatchService.go -destination=screeningBatchService_mock.go -package=service
+type ScreeningBatchService interface {
+	SubmitScreeningBatch(userID uint, applications []model.NewUserApplication) (model.ScreeningBatch, error)
+	ViewScreeningBatch(userID uint, bID uint) (model.ScreeningBatch, error)
+	ViewScreeningBatchItems(userID uint, bID uint, status string, offset int, limit int) (model.ScreeningBatchItemPage, error)
+	RunScreeningBatch(ctx context.Context, bID uint) error
+	RunUnfinishedScreeningBatches(ctx context.Context) error
+	ScreeningBatchQueued() <-chan struct{}
+}
+
+func NewScreeningBatchService(batchRepo repository.ScreeningBatchRepository, jobRepo repository.JobRepository, rdb cache.Caching) (ScreeningBatchService, error) {
+	if batchRepo == nil || jobRepo == nil || rdb == nil {
+		log.Info().Msg("screening batch repository, job repository and cache cannot be nil")
+		return nil, errors.New("screening batch repository, job repository and cache cannot be nil")
+	}
+	return &Service{
+		batchRepo:   batchRepo,
+		jobRepo:     jobRepo,
+		rdb:         rdb,
+		batchQueued: make(chan struct{}, 1),
+	}, nil
+}
+
+// SubmitScreeningBatch stores the applications as a queued batch, they are
+// screened in the background by the batch worker.
+func (s *Service) SubmitScreeningBatch(userID uint, applications []model.NewUserApplication) (model.ScreeningBatch, error) {
+
+	if len(applications) == 0 {
+		return model.ScreeningBatch{}, errors.New("screening batch has no applications")
+	}
+	if len(applications) > maxScreeningBatchItems {
+		return model.ScreeningBatch{}, fmt.Errorf("screening batch is limited to %d applications", maxScreeningBatchItems)
+	}
+
+	items := make([]model.ScreeningBatchItem, 0, len(applications))
+	for i, v := range applications {
+		items = append(items, model.ScreeningBatchItem{
+			Position:    i,
+			Status:      model.ScreeningItemPending,
+			Application: v,
+		})
+	}
+
+	batch, err := s.batchRepo.CreateScreeningBatch(model.ScreeningBatch{
+		SubmittedBy: userID,
+		Status:      model.ScreeningBatchQueued,
+		Total:       len(applications),
+	}, items)
+	if err != nil {
+		return model.ScreeningBatch{}, err
+	}
+
+	//a wake up is already pending when the channel is full
+	select {
+	case s.batchQueued <- struct{}{}:
+	default:
+	}
+
+	return batch, nil
+}
+
+func (s *Service) ScreeningBatchQueued() <-chan struct{} {
+	return s.batchQueued
+}
+
+func (s *Service) ViewScreeningBatch(userID uint, bID uint) (model.ScreeningBatch, error) {
+
+	batch, err := s.batchRepo.GetScreeningBatch(bID)
+	if err != nil {
+		return model.ScreeningBatch{}, err
+	}
+
+	err = authorizeScreeningBatch(userID, batch)
+	if err != nil {
+		return model.ScreeningBatch{}, err
+	}
+
+	return batch, nil
+}
+
+func (s *Service) ViewScreeningBatchItems(userID uint, bID uint, status string, offset int, limit int) (model.ScreeningBatchItemPage, error) {
+
+	switch status {
+	case "", model.ScreeningItemPending, model.ScreeningItemScreened, model.ScreeningItemFailed:
+	default:
+		log.Error().Str("status", status).Msg("unknown screening item status")
+		return model.ScreeningBatchItemPage{}, errors.New("unknown screening item status")
+	}
+	if offset < 0 {
+		log.Error().Int("offset", offset).Msg("invalid screening batch offset")
+		return model.ScreeningBatchItemPage{}, errors.New("invalid offset")
+	}
+
+	batch, err := s.batchRepo.GetScreeningBatch(bID)
+	if err != nil {
+		return model.ScreeningBatchItemPage{}, err
+	}
+
+	err = authorizeScreeningBatch(userID, batch)
+	if err != nil {
+		return model.ScreeningBatchItemPage{}, err
+	}
+
+	limit = jobPageSize(limit)
+
+	items, err := s.batchRepo.GetScreeningBatchItems(bID, status, offset, limit+1)
+	if err != nil {
+		return model.ScreeningBatchItemPage{}, err
+	}
+
+	var page model.ScreeningBatchItemPage
+	page.Items, page.NextOffset = pageOf(items, offset, limit)
+
+	return page, nil
+}
+
+// authorizeScreeningBatch allows only the user who submitted the batch.
+func authorizeScreeningBatch(userID uint, batch model.ScreeningBatch) error {
+	if batch.SubmittedBy == 0 || batch.SubmittedBy != userID {
+		log.Error().Uint("batch id", batch.ID).Uint("user id", userID).Msg("user did not submit the screening batch")
+		return ErrForbidden
+	}
+	return nil
+}
+
+// screeningBatchLease is how long a running batch can go without saving an
+// item before it is taken to be interrupted and can be claimed again.
+const screeningBatchLease = 5 * time.Minute
+
+// RunScreeningBatch screens the pending items of a batch, saving every item
+// as it finishes. A batch that is interrupted keeps its screened items and
+// carries on from the pending ones when it is run again. A batch claimed by
+// another worker is left to it.
+func (s *Service) RunScreeningBatch(ctx context.Context, bID uint) error {
+
+	err := s.batchRepo.StartScreeningBatch(bID, time.Now().Add(-screeningBatchLease))
+	if errors.Is(err, repository.ErrScreeningBatchClaimed) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	items, err := s.batchRepo.GetPendingScreeningBatchItems(bID)
+	if err != nil {
+		return err
+	}
+
+	applications := make([]model.NewUserApplication, 0, len(items))
+	for _, v := range items {
+		applications = append(applications, v.Application)
+	}
+
+	var unsaved atomic.Int32
+	s.screenApplications(ctx, applications, func(i int, screened model.ScreenedApplication, err error) {
+		item := items[i]
+		if err != nil {
+			item.Status = model.ScreeningItemFailed
+			item.Error = err.Error()
+		} else {
+			item.Status = model.ScreeningItemScreened
+			item.Screening = &screened.Screening
+		}
+		err = s.batchRepo.SaveScreeningBatchItem(item)
+		if err != nil {
+			unsaved.Add(1)
+		}
+	})
+	if ctx.Err() != nil {
+		return ctx.Err()
+	}
+	if unsaved.Load() != 0 {
+		log.Error().Uint("batch id", bID).Int32("unsaved items", unsaved.Load()).Msg("screening batch left unfinished")
+		return errors.New("could not save every screening batch item")
+	}
+
+	return s.batchRepo.CompleteScreeningBatch(bID, time.Now())
+}
+
+// RunUnfinishedScreeningBatches runs every queued or interrupted batch,
+// oldest first.
+func (s *Service) RunUnfinishedScreeningBatches(ctx context.Context) error {
+
+	batchIDs, err := s.batchRepo.GetUnfinishedScreeningBatchIDs()
+	if err != nil {
+		return err
+	}
+
+	for _, v := range batchIDs {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		err = s.RunScreeningBatch(ctx, v)
+		if err != nil {
+			log.Error().Err(err).Uint("batch id", v).Msg("error in running screening batch")
+		}
+	}
+
+	return nil
+}
+
+// StartScreeningBatchWorker runs the submitted screening batches one after
+// another until ctx is cancelled. Batches left unfinished, e.g. by a
+// restart, are picked up again every interval.
+func StartScreeningBatchWorker(ctx context.Context, screeningService ScreeningBatchService, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		err := screeningService.RunUnfinishedScreeningBatches(ctx)
+		if err != nil && ctx.Err() == nil {
+			log.Error().Err(err).Msg("error in running screening batches")
+		}
+
+		select {
+		case <-ctx.Done():
+			log.Info().Msg("screening batch worker stopped")
+			return
+		case <-ticker.C:
+		case <-screeningService.ScreeningBatchQueued():
+		}
+	}
+}
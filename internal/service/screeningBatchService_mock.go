@@ -0,0 +1,127 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: screeningBatchService.go
+//
+// Generated by this command:
+//
+//	mockgen -source=screeningBatchService.go -destination=screeningBatchService_mock.go -package=service
+//
+// Package service is a generated GoMock package.
+package service
+
+import (
+	context "context"
+	model "job-portal-api/internal/model"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockScreeningBatchService is a mock of ScreeningBatchService interface.
+type MockScreeningBatchService struct {
+	ctrl     *gomock.Controller
+	recorder *MockScreeningBatchServiceMockRecorder
+}
+
+// MockScreeningBatchServiceMockRecorder is the mock recorder for MockScreeningBatchService.
+type MockScreeningBatchServiceMockRecorder struct {
+	mock *MockScreeningBatchService
+}
+
+// NewMockScreeningBatchService creates a new mock instance.
+func NewMockScreeningBatchService(ctrl *gomock.Controller) *MockScreeningBatchService {
+	mock := &MockScreeningBatchService{ctrl: ctrl}
+	mock.recorder = &MockScreeningBatchServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockScreeningBatchService) EXPECT() *MockScreeningBatchServiceMockRecorder {
+	return m.recorder
+}
+
+// RunScreeningBatch mocks base method.
+func (m *MockScreeningBatchService) RunScreeningBatch(ctx context.Context, bID uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RunScreeningBatch", ctx, bID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RunScreeningBatch indicates an expected call of RunScreeningBatch.
+func (mr *MockScreeningBatchServiceMockRecorder) RunScreeningBatch(ctx, bID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunScreeningBatch", reflect.TypeOf((*MockScreeningBatchService)(nil).RunScreeningBatch), ctx, bID)
+}
+
+// RunUnfinishedScreeningBatches mocks base method.
+func (m *MockScreeningBatchService) RunUnfinishedScreeningBatches(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RunUnfinishedScreeningBatches", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RunUnfinishedScreeningBatches indicates an expected call of RunUnfinishedScreeningBatches.
+func (mr *MockScreeningBatchServiceMockRecorder) RunUnfinishedScreeningBatches(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunUnfinishedScreeningBatches", reflect.TypeOf((*MockScreeningBatchService)(nil).RunUnfinishedScreeningBatches), ctx)
+}
+
+// ScreeningBatchQueued mocks base method.
+func (m *MockScreeningBatchService) ScreeningBatchQueued() <-chan struct{} {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ScreeningBatchQueued")
+	ret0, _ := ret[0].(<-chan struct{})
+	return ret0
+}
+
+// ScreeningBatchQueued indicates an expected call of ScreeningBatchQueued.
+func (mr *MockScreeningBatchServiceMockRecorder) ScreeningBatchQueued() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ScreeningBatchQueued", reflect.TypeOf((*MockScreeningBatchService)(nil).ScreeningBatchQueued))
+}
+
+// SubmitScreeningBatch mocks base method.
+func (m *MockScreeningBatchService) SubmitScreeningBatch(userID uint, applications []model.NewUserApplication) (model.ScreeningBatch, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SubmitScreeningBatch", userID, applications)
+	ret0, _ := ret[0].(model.ScreeningBatch)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SubmitScreeningBatch indicates an expected call of SubmitScreeningBatch.
+func (mr *MockScreeningBatchServiceMockRecorder) SubmitScreeningBatch(userID, applications any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubmitScreeningBatch", reflect.TypeOf((*MockScreeningBatchService)(nil).SubmitScreeningBatch), userID, applications)
+}
+
+// ViewScreeningBatch mocks base method.
+func (m *MockScreeningBatchService) ViewScreeningBatch(userID, bID uint) (model.ScreeningBatch, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ViewScreeningBatch", userID, bID)
+	ret0, _ := ret[0].(model.ScreeningBatch)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ViewScreeningBatch indicates an expected call of ViewScreeningBatch.
+func (mr *MockScreeningBatchServiceMockRecorder) ViewScreeningBatch(userID, bID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ViewScreeningBatch", reflect.TypeOf((*MockScreeningBatchService)(nil).ViewScreeningBatch), userID, bID)
+}
+
+// ViewScreeningBatchItems mocks base method.
+func (m *MockScreeningBatchService) ViewScreeningBatchItems(userID, bID uint, status string, offset, limit int) (model.ScreeningBatchItemPage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ViewScreeningBatchItems", userID, bID, status, offset, limit)
+	ret0, _ := ret[0].(model.ScreeningBatchItemPage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ViewScreeningBatchItems indicates an expected call of ViewScreeningBatchItems.
+func (mr *MockScreeningBatchServiceMockRecorder) ViewScreeningBatchItems(userID, bID, status, offset, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ViewScreeningBatchItems", reflect.TypeOf((*MockScreeningBatchService)(nil).ViewScreeningBatchItems), userID, bID, status, offset, limit)
+}
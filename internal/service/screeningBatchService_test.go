@@ -0,0 +1,319 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"job-portal-api/internal/cache"
+	"job-portal-api/internal/model"
+	"job-portal-api/internal/repository"
+	"reflect"
+	"testing"
+
+	gomock "go.uber.org/mock/gomock"
+	"gorm.io/gorm"
+)
+
+func TestService_SubmitScreeningBatch(t *testing.T) {
+	tests := []struct {
+		name         string
+		applications []model.NewUserApplication
+		want         model.ScreeningBatch
+		wantErr      bool
+		wantQueued   bool
+		mockResponse func(mb *repository.MockScreeningBatchRepository)
+	}{
+		{
+			name:         "empty batch",
+			applications: nil,
+			want:         model.ScreeningBatch{},
+			wantErr:      true,
+			mockResponse: func(mb *repository.MockScreeningBatchRepository) {},
+		},
+		{
+			name:         "batch too big",
+			applications: make([]model.NewUserApplication, maxScreeningBatchItems+1),
+			want:         model.ScreeningBatch{},
+			wantErr:      true,
+			mockResponse: func(mb *repository.MockScreeningBatchRepository) {},
+		},
+		{
+			name:         "failure in saving",
+			applications: []model.NewUserApplication{{Name: "asha", Jid: 1}},
+			want:         model.ScreeningBatch{},
+			wantErr:      true,
+			mockResponse: func(mb *repository.MockScreeningBatchRepository) {
+				mb.EXPECT().CreateScreeningBatch(gomock.Any(), gomock.Any()).Return(model.ScreeningBatch{}, errors.New("error"))
+			},
+		},
+		{
+			name:         "success",
+			applications: []model.NewUserApplication{{Name: "asha", Jid: 1}, {Name: "ravi", Jid: 2}},
+			want:         model.ScreeningBatch{ID: 1, SubmittedBy: 8, Status: model.ScreeningBatchQueued, Total: 2},
+			wantErr:      false,
+			wantQueued:   true,
+			mockResponse: func(mb *repository.MockScreeningBatchRepository) {
+				mb.EXPECT().CreateScreeningBatch(model.ScreeningBatch{SubmittedBy: 8, Status: model.ScreeningBatchQueued, Total: 2}, []model.ScreeningBatchItem{
+					{Position: 0, Status: model.ScreeningItemPending, Application: model.NewUserApplication{Name: "asha", Jid: 1}},
+					{Position: 1, Status: model.ScreeningItemPending, Application: model.NewUserApplication{Name: "ravi", Jid: 2}},
+				}).Return(model.ScreeningBatch{ID: 1, SubmittedBy: 8, Status: model.ScreeningBatchQueued, Total: 2}, nil)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			mb := repository.NewMockScreeningBatchRepository(mc)
+			mj := repository.NewMockJobRepository(mc)
+			mca := cache.NewMockCaching(mc)
+			tt.mockResponse(mb)
+			s, _ := NewScreeningBatchService(mb, mj, mca)
+			got, err := s.SubmitScreeningBatch(8, tt.applications)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Service.SubmitScreeningBatch() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Service.SubmitScreeningBatch() = %v, want %v", got, tt.want)
+			}
+			queued := len(s.ScreeningBatchQueued()) == 1
+			if queued != tt.wantQueued {
+				t.Errorf("Service.SubmitScreeningBatch() queued = %v, want %v", queued, tt.wantQueued)
+			}
+		})
+	}
+}
+
+func TestService_ViewScreeningBatch(t *testing.T) {
+	tests := []struct {
+		name         string
+		want         model.ScreeningBatch
+		wantErr      bool
+		errIs        error
+		mockResponse func(mb *repository.MockScreeningBatchRepository)
+	}{
+		{
+			name:    "batch not found",
+			want:    model.ScreeningBatch{},
+			wantErr: true,
+			mockResponse: func(mb *repository.MockScreeningBatchRepository) {
+				mb.EXPECT().GetScreeningBatch(uint(1)).Return(model.ScreeningBatch{}, errors.New("error"))
+			},
+		},
+		{
+			name:    "another user's batch",
+			want:    model.ScreeningBatch{},
+			wantErr: true,
+			errIs:   ErrForbidden,
+			mockResponse: func(mb *repository.MockScreeningBatchRepository) {
+				mb.EXPECT().GetScreeningBatch(uint(1)).Return(model.ScreeningBatch{ID: 1, SubmittedBy: 3, Status: model.ScreeningBatchRunning}, nil)
+			},
+		},
+		{
+			name:    "success",
+			want:    model.ScreeningBatch{ID: 1, SubmittedBy: 8, Status: model.ScreeningBatchRunning},
+			wantErr: false,
+			mockResponse: func(mb *repository.MockScreeningBatchRepository) {
+				mb.EXPECT().GetScreeningBatch(uint(1)).Return(model.ScreeningBatch{ID: 1, SubmittedBy: 8, Status: model.ScreeningBatchRunning}, nil)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			mb := repository.NewMockScreeningBatchRepository(mc)
+			tt.mockResponse(mb)
+			s, _ := NewScreeningBatchService(mb, repository.NewMockJobRepository(mc), cache.NewMockCaching(mc))
+			got, err := s.ViewScreeningBatch(8, 1)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Service.ViewScreeningBatch() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.errIs != nil && !errors.Is(err, tt.errIs) {
+				t.Errorf("Service.ViewScreeningBatch() error = %v, want %v", err, tt.errIs)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Service.ViewScreeningBatch() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestService_ViewScreeningBatchItems(t *testing.T) {
+	nextOffset := 2
+	tests := []struct {
+		name         string
+		status       string
+		offset       int
+		limit        int
+		want         model.ScreeningBatchItemPage
+		wantErr      bool
+		errIs        error
+		mockResponse func(mb *repository.MockScreeningBatchRepository)
+	}{
+		{
+			name:         "unknown status",
+			status:       "done",
+			want:         model.ScreeningBatchItemPage{},
+			wantErr:      true,
+			mockResponse: func(mb *repository.MockScreeningBatchRepository) {},
+		},
+		{
+			name:         "negative offset",
+			offset:       -1,
+			want:         model.ScreeningBatchItemPage{},
+			wantErr:      true,
+			mockResponse: func(mb *repository.MockScreeningBatchRepository) {},
+		},
+		{
+			name:    "batch not found",
+			want:    model.ScreeningBatchItemPage{},
+			wantErr: true,
+			mockResponse: func(mb *repository.MockScreeningBatchRepository) {
+				mb.EXPECT().GetScreeningBatch(uint(1)).Return(model.ScreeningBatch{}, errors.New("error"))
+			},
+		},
+		{
+			name:    "another user's batch",
+			want:    model.ScreeningBatchItemPage{},
+			wantErr: true,
+			errIs:   ErrForbidden,
+			mockResponse: func(mb *repository.MockScreeningBatchRepository) {
+				mb.EXPECT().GetScreeningBatch(uint(1)).Return(model.ScreeningBatch{ID: 1, SubmittedBy: 3}, nil)
+			},
+		},
+		{
+			name:    "empty page",
+			status:  model.ScreeningItemFailed,
+			want:    model.ScreeningBatchItemPage{Items: []model.ScreeningBatchItem{}},
+			wantErr: false,
+			mockResponse: func(mb *repository.MockScreeningBatchRepository) {
+				mb.EXPECT().GetScreeningBatch(uint(1)).Return(model.ScreeningBatch{ID: 1, SubmittedBy: 8}, nil)
+				mb.EXPECT().GetScreeningBatchItems(uint(1), model.ScreeningItemFailed, 0, defaultJobPageSize+1).Return(nil, nil)
+			},
+		},
+		{
+			name:    "page with next offset",
+			limit:   2,
+			want:    model.ScreeningBatchItemPage{Items: []model.ScreeningBatchItem{{ID: 1}, {ID: 2}}, NextOffset: &nextOffset},
+			wantErr: false,
+			mockResponse: func(mb *repository.MockScreeningBatchRepository) {
+				mb.EXPECT().GetScreeningBatch(uint(1)).Return(model.ScreeningBatch{ID: 1, SubmittedBy: 8}, nil)
+				mb.EXPECT().GetScreeningBatchItems(uint(1), "", 0, 3).Return([]model.ScreeningBatchItem{{ID: 1}, {ID: 2}, {ID: 3}}, nil)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			mb := repository.NewMockScreeningBatchRepository(mc)
+			tt.mockResponse(mb)
+			s, _ := NewScreeningBatchService(mb, repository.NewMockJobRepository(mc), cache.NewMockCaching(mc))
+			got, err := s.ViewScreeningBatchItems(8, 1, tt.status, tt.offset, tt.limit)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Service.ViewScreeningBatchItems() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.errIs != nil && !errors.Is(err, tt.errIs) {
+				t.Errorf("Service.ViewScreeningBatchItems() error = %v, want %v", err, tt.errIs)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Service.ViewScreeningBatchItems() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestService_RunScreeningBatch(t *testing.T) {
+	items := []model.ScreeningBatchItem{
+		{ID: 1, BatchID: 1, Position: 0, Status: model.ScreeningItemPending, Application: model.NewUserApplication{Name: "asha", Jid: 1}},
+		{ID: 2, BatchID: 1, Position: 1, Status: model.ScreeningItemPending, Application: model.NewUserApplication{Name: "ravi", Jid: 2}},
+	}
+	screened := func(item model.ScreeningBatchItem) bool {
+		return item.ID == 1 && item.Status == model.ScreeningItemScreened && item.Screening != nil
+	}
+	failed := func(item model.ScreeningBatchItem) bool {
+		return item.ID == 2 && item.Status == model.ScreeningItemFailed && item.Error == "could not find the job"
+	}
+	tests := []struct {
+		name         string
+		cancel       bool
+		wantErr      bool
+		mockResponse func(mb *repository.MockScreeningBatchRepository, mj *repository.MockJobRepository, mca *cache.MockCaching)
+	}{
+		{
+			name:    "batch not found",
+			wantErr: true,
+			mockResponse: func(mb *repository.MockScreeningBatchRepository, mj *repository.MockJobRepository, mca *cache.MockCaching) {
+				mb.EXPECT().StartScreeningBatch(uint(1), gomock.Any()).Return(errors.New("error"))
+			},
+		},
+		{
+			name:    "batch claimed by another worker",
+			wantErr: false,
+			mockResponse: func(mb *repository.MockScreeningBatchRepository, mj *repository.MockJobRepository, mca *cache.MockCaching) {
+				mb.EXPECT().StartScreeningBatch(uint(1), gomock.Any()).Return(repository.ErrScreeningBatchClaimed)
+			},
+		},
+		{
+			name:    "item not saved leaves the batch unfinished",
+			wantErr: true,
+			mockResponse: func(mb *repository.MockScreeningBatchRepository, mj *repository.MockJobRepository, mca *cache.MockCaching) {
+				mb.EXPECT().StartScreeningBatch(uint(1), gomock.Any()).Return(nil)
+				mb.EXPECT().GetPendingScreeningBatchItems(uint(1)).Return(items, nil)
+				mca.EXPECT().GetTheCacheData(gomock.Any(), uint(1)).Return(`{"ID":1,"status":"published"}`, nil)
+				mca.EXPECT().GetTheCacheData(gomock.Any(), uint(2)).Return("", errors.New("cache miss"))
+				mj.EXPECT().GetJobByJobID(uint(2)).Return(model.Job{}, errors.New("error"))
+				mb.EXPECT().SaveScreeningBatchItem(gomock.Cond(func(x any) bool { return screened(x.(model.ScreeningBatchItem)) })).Return(nil)
+				mb.EXPECT().SaveScreeningBatchItem(gomock.Cond(func(x any) bool { return failed(x.(model.ScreeningBatchItem)) })).Return(errors.New("error"))
+			},
+		},
+		{
+			name:    "cancelled run",
+			cancel:  true,
+			wantErr: true,
+			mockResponse: func(mb *repository.MockScreeningBatchRepository, mj *repository.MockJobRepository, mca *cache.MockCaching) {
+				mb.EXPECT().StartScreeningBatch(uint(1), gomock.Any()).Return(nil)
+				mb.EXPECT().GetPendingScreeningBatchItems(uint(1)).Return(items, nil)
+				mca.EXPECT().GetTheCacheData(gomock.Any(), gomock.Any()).Return(`{"status":"published"}`, nil).AnyTimes()
+				mb.EXPECT().SaveScreeningBatchItem(gomock.Any()).Return(nil).AnyTimes()
+			},
+		},
+		{
+			name:    "success",
+			wantErr: false,
+			mockResponse: func(mb *repository.MockScreeningBatchRepository, mj *repository.MockJobRepository, mca *cache.MockCaching) {
+				mb.EXPECT().StartScreeningBatch(uint(1), gomock.Any()).Return(nil)
+				mb.EXPECT().GetPendingScreeningBatchItems(uint(1)).Return(items, nil)
+				mca.EXPECT().GetTheCacheData(gomock.Any(), uint(1)).Return("", errors.New("cache miss"))
+				mj.EXPECT().GetJobByJobID(uint(1)).Return(model.Job{Model: gorm.Model{ID: 1}, Status: model.JobStatusPublished}, nil)
+				mca.EXPECT().AddToTheCache(gomock.Any(), uint(1), gomock.Any()).Return(nil)
+				mca.EXPECT().GetTheCacheData(gomock.Any(), uint(2)).Return("", errors.New("cache miss"))
+				mj.EXPECT().GetJobByJobID(uint(2)).Return(model.Job{}, errors.New("error"))
+				mb.EXPECT().SaveScreeningBatchItem(gomock.Cond(func(x any) bool { return screened(x.(model.ScreeningBatchItem)) })).Return(nil)
+				mb.EXPECT().SaveScreeningBatchItem(gomock.Cond(func(x any) bool { return failed(x.(model.ScreeningBatchItem)) })).Return(nil)
+				mb.EXPECT().CompleteScreeningBatch(uint(1), gomock.Any()).Return(nil)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			mb := repository.NewMockScreeningBatchRepository(mc)
+			mj := repository.NewMockJobRepository(mc)
+			mca := cache.NewMockCaching(mc)
+			tt.mockResponse(mb, mj, mca)
+			s, _ := NewScreeningBatchService(mb, mj, mca)
+			ctx, cancel := context.WithCancel(context.Background())
+			if tt.cancel {
+				cancel()
+			}
+			defer cancel()
+			err := s.RunScreeningBatch(ctx, 1)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Service.RunScreeningBatch() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,67 @@
+package service
+
+import (
+	"context"
+	"job-portal-api/internal/model"
+	"sync"
+
+	"github.com/rs/zerolog/log"
+)
+
+// StreamApplications screens applications as they are read from the
+// channel and emits every result as soon as it is decided, so a stream of
+// any length is never held in memory. Lines that could not be read are
+// emitted with their error. emit is never called concurrently.
+//
+// The caller must keep sending until the channel is closed or ctx is
+// cancelled.
+func (s *Service) StreamApplications(ctx context.Context, applications <-chan model.StreamedApplication, emit func(model.ScreeningStreamResult)) {
+	var mu sync.Mutex
+	send := func(result model.ScreeningStreamResult) {
+		mu.Lock()
+		defer mu.Unlock()
+		emit(result)
+	}
+
+	queue := make(chan queuedApplication)
+	go func() {
+		defer close(queue)
+		for {
+			var v model.StreamedApplication
+			var ok bool
+			select {
+			case <-ctx.Done():
+				log.Info().Err(ctx.Err()).Msg("application stream cancelled")
+				return
+			case v, ok = <-applications:
+				if !ok {
+					return
+				}
+			}
+
+			if v.Err != nil {
+				send(model.ScreeningStreamResult{Line: v.Line, Error: v.Err.Error()})
+				continue
+			}
+
+			select {
+			case <-ctx.Done():
+				log.Info().Err(ctx.Err()).Msg("application stream cancelled")
+				return
+			case queue <- queuedApplication{index: v.Line, application: v.Application}:
+			}
+		}
+	}()
+
+	s.screenQueue(ctx, queue, func(line int, screened model.ScreenedApplication, err error) {
+		if err != nil {
+			send(model.ScreeningStreamResult{Line: line, Error: err.Error()})
+			return
+		}
+		send(model.ScreeningStreamResult{
+			Line:        line,
+			Application: &screened.NewUserApplication,
+			Screening:   &screened.Screening,
+		})
+	})
+}
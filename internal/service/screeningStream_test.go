@@ -0,0 +1,96 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"job-portal-api/internal/cache"
+	"job-portal-api/internal/model"
+	"job-portal-api/internal/repository"
+	"reflect"
+	"sort"
+	"testing"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+func TestService_StreamApplications(t *testing.T) {
+	tests := []struct {
+		name         string
+		applications []model.StreamedApplication
+		cancel       bool
+		want         []model.ScreeningStreamResult
+		mockResponse func(mj *repository.MockJobRepository, mca *cache.MockCaching)
+	}{
+		{
+			name:         "empty stream",
+			applications: nil,
+			want:         nil,
+			mockResponse: func(mj *repository.MockJobRepository, mca *cache.MockCaching) {},
+		},
+		{
+			name:         "cancelled stream",
+			applications: []model.StreamedApplication{{Line: 1, Application: model.NewUserApplication{Name: "asha", Jid: 1}}},
+			cancel:       true,
+			want:         nil,
+			mockResponse: func(mj *repository.MockJobRepository, mca *cache.MockCaching) {},
+		},
+		{
+			name: "every line is answered",
+			applications: []model.StreamedApplication{
+				{Line: 1, Application: model.NewUserApplication{Name: "asha", Jid: 1}},
+				{Line: 2, Err: errors.New("could not decode application")},
+				{Line: 4, Application: model.NewUserApplication{Name: "ravi", Jid: 2}},
+				{Line: 5, Application: model.NewUserApplication{Name: "meera", Jid: 1}},
+			},
+			want: []model.ScreeningStreamResult{
+				{Line: 1, Application: &model.NewUserApplication{Name: "asha", Jid: 1}, Screening: &model.ScreeningResult{}},
+				{Line: 2, Error: "could not decode application"},
+				{Line: 4, Error: "job is not accepting applications"},
+				{Line: 5, Application: &model.NewUserApplication{Name: "meera", Jid: 1}, Screening: &model.ScreeningResult{}},
+			},
+			mockResponse: func(mj *repository.MockJobRepository, mca *cache.MockCaching) {
+				mca.EXPECT().GetTheCacheData(gomock.Any(), uint(1)).Return(`{"ID":1,"status":"published"}`, nil).Times(1)
+				mca.EXPECT().GetTheCacheData(gomock.Any(), uint(2)).Return(`{"ID":2,"status":"closed"}`, nil).Times(1)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			mj := repository.NewMockJobRepository(mc)
+			mca := cache.NewMockCaching(mc)
+			tt.mockResponse(mj, mca)
+			s, _ := NewJobService(mj, nil, nil, mca, testRates)
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			if tt.cancel {
+				cancel()
+			}
+			applications := make(chan model.StreamedApplication)
+			go func(streamed []model.StreamedApplication) {
+				defer close(applications)
+				for _, v := range streamed {
+					select {
+					case <-ctx.Done():
+						return
+					case applications <- v:
+					}
+				}
+			}(tt.applications)
+
+			var got []model.ScreeningStreamResult
+			s.StreamApplications(ctx, applications, func(result model.ScreeningStreamResult) {
+				if result.Screening != nil {
+					//only the line and the application are compared here
+					result.Screening = &model.ScreeningResult{}
+				}
+				got = append(got, result)
+			})
+			sort.Slice(got, func(i, j int) bool { return got[i].Line < got[j].Line })
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Service.StreamApplications() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,262 @@
+package service
+
+import (
+	"errors"
+	"job-portal-api/internal/cache"
+	"job-portal-api/internal/model"
+	"job-portal-api/internal/repository"
+	"reflect"
+	"testing"
+
+	gomock "go.uber.org/mock/gomock"
+	"gorm.io/gorm"
+)
+
+func TestCompareData(t *testing.T) {
+	jobData := model.Job{
+		MinNoticePeriod: 0,
+		MaxNoticePeriod: 60,
+		MinExperience:   2,
+		MaxExperience:   5,
+		Location:        []model.Location{{Model: gorm.Model{ID: 1}}, {Model: gorm.Model{ID: 2}}},
+		TechnologyStack: []model.TechnologyStack{{Model: gorm.Model{ID: 1}}},
+		Qualifications:  []model.Qualification{{Model: gorm.Model{ID: 1}}},
+		Shift:           []model.Shift{{Model: gorm.Model{ID: 1}}},
+	}
+	tests := []struct {
+		name        string
+		application model.Requestfield
+		want        model.ScreeningResult
+	}{
+		{
+			name: "accepted",
+			application: model.Requestfield{
+				NoticePeriod:    30,
+				Experience:      3,
+				Location:        []uint{2, 3},
+				TechnologyStack: []uint{1},
+				Shift:           []uint{2},
+			},
+			want: model.ScreeningResult{
+				Accepted:  true,
+				Score:     4,
+				MaxScore:  7,
+				Threshold: 3.5,
+				Criteria: []model.CriterionResult{
+					{Criterion: model.CriterionNoticePeriod, Matched: true, Weight: 1, Score: 1, Reason: "notice period of 30 days is within 0-60 days"},
+					{Criterion: model.CriterionExperience, Matched: true, Weight: 1, Score: 1, Reason: "experience of 3 years is within 2-5 years"},
+					{Criterion: model.CriterionLocation, Matched: true, Weight: 1, Score: 1, Reason: "matches [2] of [1 2]"},
+					{Criterion: model.CriterionSkills, Matched: true, Weight: 1, Score: 1, Reason: "matches [1] of [1]"},
+					{Criterion: model.CriterionQualifications, Weight: 1, Reason: "none given, job asks for one of [1]"},
+					{Criterion: model.CriterionShift, Weight: 1, Reason: "none of [2] match [1]"},
+					{Criterion: model.CriterionJobType, Weight: 1, Reason: "job does not list any"},
+				},
+			},
+		},
+		{
+			name: "rejected",
+			application: model.Requestfield{
+				NoticePeriod:    90,
+				Experience:      1,
+				Location:        []uint{1},
+				TechnologyStack: []uint{1},
+			},
+			want: model.ScreeningResult{
+				Accepted:  false,
+				Score:     2,
+				MaxScore:  7,
+				Threshold: 3.5,
+				Criteria: []model.CriterionResult{
+					{Criterion: model.CriterionNoticePeriod, Weight: 1, Reason: "notice period of 90 days is outside 0-60 days"},
+					{Criterion: model.CriterionExperience, Weight: 1, Reason: "experience of 1 years is outside 2-5 years"},
+					{Criterion: model.CriterionLocation, Matched: true, Weight: 1, Score: 1, Reason: "matches [1] of [1 2]"},
+					{Criterion: model.CriterionSkills, Matched: true, Weight: 1, Score: 1, Reason: "matches [1] of [1]"},
+					{Criterion: model.CriterionQualifications, Weight: 1, Reason: "none given, job asks for one of [1]"},
+					{Criterion: model.CriterionShift, Weight: 1, Reason: "none given, job asks for one of [1]"},
+					{Criterion: model.CriterionJobType, Weight: 1, Reason: "job does not list any"},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := CompareData(model.NewUserApplication{Jobs: tt.application}, jobData)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("CompareData() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCompareData_scoringPolicy(t *testing.T) {
+	application := model.Requestfield{
+		NoticePeriod:    30,
+		Experience:      3,
+		Location:        []uint{2},
+		TechnologyStack: []uint{1},
+	}
+	tests := []struct {
+		name           string
+		policy         model.ScoringPolicy
+		wantAccepted   bool
+		wantScore      float64
+		wantMaxScore   float64
+		wantThreshold  float64
+		wantKnockedOut bool
+		wantKnockouts  []model.KnockoutResult
+	}{
+		{
+			name:          "weights and threshold",
+			policy:        model.ScoringPolicy{Weights: map[string]float64{model.CriterionSkills: 4, model.CriterionShift: 0, model.CriterionJobType: 0}, Threshold: floatPointer(0.9)},
+			wantAccepted:  false,
+			wantScore:     7,
+			wantMaxScore:  8,
+			wantThreshold: 7.2,
+		},
+		{
+			name:          "lower threshold",
+			policy:        model.ScoringPolicy{Threshold: floatPointer(0.25)},
+			wantAccepted:  true,
+			wantScore:     4,
+			wantMaxScore:  7,
+			wantThreshold: 1.75,
+		},
+		{
+			name:           "failed knockout id",
+			policy:         model.ScoringPolicy{Knockouts: []model.Knockout{{Criterion: model.CriterionSkills, ID: 1}, {Criterion: model.CriterionSkills, ID: 3}}},
+			wantAccepted:   false,
+			wantScore:      4,
+			wantMaxScore:   7,
+			wantThreshold:  3.5,
+			wantKnockedOut: true,
+			wantKnockouts: []model.KnockoutResult{
+				{Criterion: model.CriterionSkills, ID: 1, Met: true, Reason: "lists required id 1"},
+				{Criterion: model.CriterionSkills, ID: 3, Met: false, Reason: "does not list required id 3"},
+			},
+		},
+		{
+			name:           "failed knockout criterion",
+			policy:         model.ScoringPolicy{Knockouts: []model.Knockout{{Criterion: model.CriterionExperience}, {Criterion: model.CriterionQualifications}}},
+			wantAccepted:   false,
+			wantScore:      4,
+			wantMaxScore:   7,
+			wantThreshold:  3.5,
+			wantKnockedOut: true,
+			wantKnockouts: []model.KnockoutResult{
+				{Criterion: model.CriterionExperience, Met: true, Reason: "required criterion matched"},
+				{Criterion: model.CriterionQualifications, Met: false, Reason: "required criterion did not match"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			jobData := model.Job{
+				MaxNoticePeriod: 60,
+				MaxExperience:   5,
+				Location:        []model.Location{{Model: gorm.Model{ID: 2}}},
+				TechnologyStack: []model.TechnologyStack{{Model: gorm.Model{ID: 1}}, {Model: gorm.Model{ID: 3}}},
+				Qualifications:  []model.Qualification{{Model: gorm.Model{ID: 1}}},
+				ScoringPolicy:   tt.policy,
+			}
+			got := CompareData(model.NewUserApplication{Jobs: application}, jobData)
+			if got.Accepted != tt.wantAccepted || got.Score != tt.wantScore || got.MaxScore != tt.wantMaxScore || got.Threshold != tt.wantThreshold || got.KnockedOut != tt.wantKnockedOut {
+				t.Errorf("CompareData() = accepted %v score %v/%v threshold %v knocked out %v, want accepted %v score %v/%v threshold %v knocked out %v",
+					got.Accepted, got.Score, got.MaxScore, got.Threshold, got.KnockedOut,
+					tt.wantAccepted, tt.wantScore, tt.wantMaxScore, tt.wantThreshold, tt.wantKnockedOut)
+			}
+			if !reflect.DeepEqual(got.Knockouts, tt.wantKnockouts) {
+				t.Errorf("CompareData() knockouts = %v, want %v", got.Knockouts, tt.wantKnockouts)
+			}
+		})
+	}
+}
+
+func TestService_CreateJobByCompanyId_scoringPolicy(t *testing.T) {
+	tests := []struct {
+		name          string
+		policy        model.ScoringPolicy
+		wantFieldErrs []model.FieldError
+	}{
+		{
+			name:   "default policy",
+			policy: model.ScoringPolicy{},
+		},
+		{
+			name: "valid policy",
+			policy: model.ScoringPolicy{
+				Weights:   map[string]float64{model.CriterionSkills: 3, model.CriterionShift: 0},
+				Threshold: floatPointer(0.7),
+				Knockouts: []model.Knockout{{Criterion: model.CriterionSkills, ID: 2}, {Criterion: model.CriterionExperience}},
+			},
+		},
+		{
+			name: "invalid policy",
+			policy: model.ScoringPolicy{
+				Weights:   map[string]float64{"salary": 1, model.CriterionSkills: -1},
+				Threshold: floatPointer(1.5),
+				Knockouts: []model.Knockout{
+					{Criterion: "salary"},
+					{Criterion: model.CriterionExperience, ID: 1},
+					{Criterion: model.CriterionSkills, ID: 9},
+				},
+			},
+			wantFieldErrs: []model.FieldError{
+				{Field: "scoringPolicy.weights", Message: `unknown criterion "salary"`},
+				{Field: "scoringPolicy.weights", Message: "skills cannot be negative"},
+				{Field: "scoringPolicy.threshold", Message: "must be between 0 and 1"},
+				{Field: "scoringPolicy.knockouts[0]", Message: `unknown criterion "salary"`},
+				{Field: "scoringPolicy.knockouts[1]", Message: "experience does not take an id"},
+				{Field: "scoringPolicy.knockouts[2]", Message: "id 9 is not listed on the job"},
+			},
+		},
+		{
+			name: "no weight left",
+			policy: model.ScoringPolicy{
+				Weights: map[string]float64{
+					model.CriterionNoticePeriod: 0, model.CriterionExperience: 0, model.CriterionLocation: 0, model.CriterionSkills: 0,
+					model.CriterionQualifications: 0, model.CriterionShift: 0, model.CriterionJobType: 0,
+				},
+			},
+			wantFieldErrs: []model.FieldError{
+				{Field: "scoringPolicy.weights", Message: "at least one criterion needs a weight"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			mj := repository.NewMockJobRepository(mc)
+			mcr := repository.NewMockComapnyRepo(mc)
+			mt := repository.NewMockTaxonomyRepository(mc)
+			mca := cache.NewMockCaching(mc)
+			s, _ := NewJobService(mj, mcr, mt, mca, testRates)
+			mcr.EXPECT().GetCompanyByID(gomock.Any()).Return(model.Company{OwnerID: 8}, nil).AnyTimes()
+			mt.EXPECT().FindMissingTaxonomyIDs(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+
+			var saved model.Job
+			mj.EXPECT().CreateJob(gomock.Any()).DoAndReturn(func(jobData model.Job) (model.Response, error) {
+				saved = jobData
+				return model.Response{Id: 1}, nil
+			}).AnyTimes()
+
+			jobDetails := model.NewJobs{Jobname: "golang developer", TechnologyStack: []uint{1, 2}, ScoringPolicy: tt.policy}
+			_, err := s.CreateJobByCompanyId(8, jobDetails, 1)
+			if tt.wantFieldErrs != nil {
+				var validationErr *ValidationError
+				if !errors.As(err, &validationErr) {
+					t.Fatalf("Service.CreateJobByCompanyId() error = %v, want a validation error", err)
+				}
+				if !reflect.DeepEqual(validationErr.Errors, tt.wantFieldErrs) {
+					t.Errorf("Service.CreateJobByCompanyId() field errors = %v, want %v", validationErr.Errors, tt.wantFieldErrs)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Service.CreateJobByCompanyId() error = %v", err)
+			}
+			if !reflect.DeepEqual(saved.ScoringPolicy, tt.policy) {
+				t.Errorf("Service.CreateJobByCompanyId() scoring policy = %v, want %v", saved.ScoringPolicy, tt.policy)
+			}
+		})
+	}
+}
@@ -4,12 +4,26 @@ import (
 	"job-portal-api/internal/authentication"
 	"job-portal-api/internal/cache"
 	"job-portal-api/internal/repository"
+	"job-portal-api/internal/storage"
 )
 
 type Service struct {
-	userRepo       repository.UserRepository
-	comapnayRepo   repository.ComapnyRepo
-	jobRepo        repository.JobRepository
-	authentication authentication.Authenticaton
-	rdb cache.Caching
+	userRepo         repository.UserRepository
+	comapnayRepo     repository.ComapnyRepo
+	jobRepo          repository.JobRepository
+	taxonomyRepo     repository.TaxonomyRepository
+	applicationRepo  repository.ApplicationRepository
+	batchRepo        repository.ScreeningBatchRepository
+	attachmentRepo   repository.AttachmentRepository
+	profileRepo      repository.CandidateProfileRepository
+	notificationRepo repository.NotificationRepository
+	reviewRepo       repository.ApplicationReviewRepository
+	authentication   authentication.Authenticaton
+	rdb              cache.Caching
+	rates            ExchangeRates
+	store            storage.Storage
+	//signalled when a screening batch is submitted
+	batchQueued chan struct{}
+	//largest attachment accepted, in bytes
+	maxAttachmentSize int64
 }
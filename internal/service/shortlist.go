@@ -0,0 +1,154 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"job-portal-api/internal/cache"
+	"job-portal-api/internal/model"
+	"sort"
+
+	"github.com/rs/zerolog/log"
+)
+
+// ViewShortlist ranks the applications of a job by their score against the
+// job as it is now. The ranking is cached until an application of the job
+// or the job itself changes. Withdrawn applications are left out. Only the
+// company that posted the job can read its shortlist.
+func (s *Service) ViewShortlist(ctx context.Context, userID uint, jID uint, offset int, limit int) (model.Shortlist, error) {
+
+	if offset < 0 {
+		log.Error().Int("offset", offset).Msg("invalid shortlist offset")
+		return model.Shortlist{}, errors.New("invalid offset")
+	}
+
+	err := s.authorizeRecruiter(userID, jID)
+	if err != nil {
+		return model.Shortlist{}, err
+	}
+
+	ranking, err := s.shortlist(ctx, jID)
+	if err != nil {
+		return model.Shortlist{}, err
+	}
+
+	limit = jobPageSize(limit)
+	shortlist := model.Shortlist{
+		JobID:   jID,
+		Total:   len(ranking),
+		Entries: []model.ShortlistEntry{},
+	}
+	if offset < len(ranking) {
+		end := offset + limit
+		if end < len(ranking) {
+			shortlist.NextOffset = &end
+		} else {
+			end = len(ranking)
+		}
+		shortlist.Entries = ranking[offset:end]
+	}
+
+	return shortlist, nil
+}
+
+// shortlist returns the full ranking of a job, from redis when it is cached.
+func (s *Service) shortlist(ctx context.Context, jID uint) ([]model.ShortlistEntry, error) {
+	var ranking []model.ShortlistEntry
+
+	val, err := s.rdb.GetShortlist(ctx, jID)
+	if err == nil {
+		err = json.Unmarshal([]byte(val), &ranking)
+		if err == nil {
+			return ranking, nil
+		}
+		log.Error().Err(err).Uint("job id", jID).Msg("error in un marshaling cached shortlist")
+	}
+
+	//read before ranking, an invalidation in between bumps it and the
+	//ranking is not cached
+	version, versionErr := s.rdb.GetShortlistVersion(ctx, jID)
+
+	jobData, err := s.cachedJob(ctx, jID)
+	if err != nil {
+		return nil, errors.New("could not find the job")
+	}
+
+	applications, err := s.applicationRepo.GetApplicationsByJobID(jID)
+	if err != nil {
+		return nil, err
+	}
+
+	ranking = rankApplications(applications, jobData)
+
+	if versionErr != nil {
+		log.Error().Err(versionErr).Uint("job id", jID).Msg("error in getting shortlist version, not caching shortlist")
+		return ranking, nil
+	}
+
+	err = s.rdb.AddShortlist(ctx, jID, version, ranking)
+	if errors.Is(err, cache.ErrStaleShortlist) {
+		log.Info().Uint("job id", jID).Msg("shortlist changed while ranking, not caching it")
+	} else if err != nil {
+		log.Error().Err(err).Uint("job id", jID).Msg("error in caching shortlist")
+	}
+
+	return ranking, nil
+}
+
+// rankApplications scores every application against the job and sorts
+// them best first. Applications that were not knocked out come first, then
+// the higher score. Ties go to the earlier application.
+func rankApplications(applications []model.Application, jobData model.Job) []model.ShortlistEntry {
+	ranking := make([]model.ShortlistEntry, 0, len(applications))
+	for _, v := range applications {
+		if v.Stage == model.ApplicationStageWithdrawn {
+			continue
+		}
+		result := CompareData(model.NewUserApplication{
+			Name: v.Name,
+			Age:  v.Age,
+			Jid:  v.JobID,
+			Jobs: v.Details,
+		}, jobData)
+		ranking = append(ranking, model.ShortlistEntry{
+			ApplicationID: v.ID,
+			UserID:        v.UserID,
+			Name:          v.Name,
+			Stage:         v.Stage,
+			Accepted:      result.Accepted,
+			KnockedOut:    result.KnockedOut,
+			Score:         result.Score,
+			MaxScore:      result.MaxScore,
+			AppliedAt:     v.CreatedAt,
+		})
+	}
+
+	sort.SliceStable(ranking, func(i, j int) bool {
+		a, b := ranking[i], ranking[j]
+		if a.KnockedOut != b.KnockedOut {
+			return !a.KnockedOut
+		}
+		if a.Score != b.Score {
+			return a.Score > b.Score
+		}
+		if !a.AppliedAt.Equal(b.AppliedAt) {
+			return a.AppliedAt.Before(b.AppliedAt)
+		}
+		return a.ApplicationID < b.ApplicationID
+	})
+	for i := range ranking {
+		ranking[i].Rank = i + 1
+	}
+
+	return ranking
+}
+
+// invalidateShortlist drops the cached ranking of a job. Like the cached
+// job, a redis failure is only logged.
+func (s *Service) invalidateShortlist(jID uint) {
+	ctx := context.Background()
+	err := s.rdb.DeleteShortlist(ctx, jID)
+	if err != nil {
+		log.Error().Err(err).Uint("job id", jID).Msg("error in invalidating cached shortlist")
+	}
+}
@@ -0,0 +1,169 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"job-portal-api/internal/cache"
+	"job-portal-api/internal/model"
+	"job-portal-api/internal/repository"
+	"reflect"
+	"testing"
+	"time"
+
+	gomock "go.uber.org/mock/gomock"
+	"gorm.io/gorm"
+)
+
+func TestService_ViewShortlist(t *testing.T) {
+	applied := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	jobData := `{"ID":2,"status":"published","min_notice_period":0,"max_notice_period":30,"min_experience":1,"max_experience":5}`
+	applications := []model.Application{
+		{Model: gorm.Model{ID: 1, CreatedAt: applied.Add(time.Hour)}, UserID: 11, JobID: 2, Name: "asha", Stage: model.ApplicationStageApplied, Details: model.Requestfield{NoticePeriod: 30, Experience: 2}},
+		{Model: gorm.Model{ID: 2, CreatedAt: applied}, UserID: 12, JobID: 2, Name: "ravi", Stage: model.ApplicationStageInterview, Details: model.Requestfield{NoticePeriod: 60, Experience: 2}},
+		{Model: gorm.Model{ID: 3, CreatedAt: applied}, UserID: 13, JobID: 2, Name: "meera", Stage: model.ApplicationStageScreening, Details: model.Requestfield{NoticePeriod: 30, Experience: 3}},
+		{Model: gorm.Model{ID: 4, CreatedAt: applied}, UserID: 14, JobID: 2, Name: "kiran", Stage: model.ApplicationStageWithdrawn, Details: model.Requestfield{NoticePeriod: 30, Experience: 3}},
+	}
+	ranking := []model.ShortlistEntry{
+		{Rank: 1, ApplicationID: 3, UserID: 13, Name: "meera", Stage: model.ApplicationStageScreening, Score: 2, MaxScore: 7, AppliedAt: applied},
+		{Rank: 2, ApplicationID: 1, UserID: 11, Name: "asha", Stage: model.ApplicationStageApplied, Score: 2, MaxScore: 7, AppliedAt: applied.Add(time.Hour)},
+		{Rank: 3, ApplicationID: 2, UserID: 12, Name: "ravi", Stage: model.ApplicationStageInterview, Score: 1, MaxScore: 7, AppliedAt: applied},
+	}
+	recruiter := func(mj *repository.MockJobRepository, mco *repository.MockComapnyRepo) {
+		mj.EXPECT().GetJobByJobID(uint(2)).Return(model.Job{Cid: 4}, nil)
+		mco.EXPECT().GetCompanyByID(uint64(4)).Return(model.Company{OwnerID: 8}, nil)
+	}
+	nextOffset := 2
+	tests := []struct {
+		name         string
+		offset       int
+		limit        int
+		want         model.Shortlist
+		wantErr      bool
+		errIs        error
+		mockResponse func(ma *repository.MockApplicationRepository, mj *repository.MockJobRepository, mco *repository.MockComapnyRepo, mca *cache.MockCaching)
+	}{
+		{
+			name:    "negative offset",
+			offset:  -1,
+			want:    model.Shortlist{},
+			wantErr: true,
+			mockResponse: func(ma *repository.MockApplicationRepository, mj *repository.MockJobRepository, mco *repository.MockComapnyRepo, mca *cache.MockCaching) {
+			},
+		},
+		{
+			name:    "job not found",
+			want:    model.Shortlist{},
+			wantErr: true,
+			mockResponse: func(ma *repository.MockApplicationRepository, mj *repository.MockJobRepository, mco *repository.MockComapnyRepo, mca *cache.MockCaching) {
+				mj.EXPECT().GetJobByJobID(uint(2)).Return(model.Job{}, errors.New("error"))
+			},
+		},
+		{
+			name:    "not the company owner",
+			want:    model.Shortlist{},
+			wantErr: true,
+			errIs:   ErrForbidden,
+			mockResponse: func(ma *repository.MockApplicationRepository, mj *repository.MockJobRepository, mco *repository.MockComapnyRepo, mca *cache.MockCaching) {
+				mj.EXPECT().GetJobByJobID(uint(2)).Return(model.Job{Cid: 4}, nil)
+				mco.EXPECT().GetCompanyByID(uint64(4)).Return(model.Company{OwnerID: 13}, nil)
+			},
+		},
+		{
+			name:    "failure in fetching applications",
+			want:    model.Shortlist{},
+			wantErr: true,
+			mockResponse: func(ma *repository.MockApplicationRepository, mj *repository.MockJobRepository, mco *repository.MockComapnyRepo, mca *cache.MockCaching) {
+				recruiter(mj, mco)
+				mca.EXPECT().GetShortlist(gomock.Any(), uint(2)).Return("", errors.New("cache miss"))
+				mca.EXPECT().GetShortlistVersion(gomock.Any(), uint(2)).Return(int64(4), nil)
+				mca.EXPECT().GetTheCacheData(gomock.Any(), uint(2)).Return(jobData, nil)
+				ma.EXPECT().GetApplicationsByJobID(uint(2)).Return(nil, errors.New("error"))
+			},
+		},
+		{
+			name:    "ranked and cached top two",
+			limit:   2,
+			want:    model.Shortlist{JobID: 2, Total: 3, Entries: ranking[:2], NextOffset: &nextOffset},
+			wantErr: false,
+			mockResponse: func(ma *repository.MockApplicationRepository, mj *repository.MockJobRepository, mco *repository.MockComapnyRepo, mca *cache.MockCaching) {
+				recruiter(mj, mco)
+				mca.EXPECT().GetShortlist(gomock.Any(), uint(2)).Return("", errors.New("cache miss"))
+				mca.EXPECT().GetShortlistVersion(gomock.Any(), uint(2)).Return(int64(4), nil)
+				mca.EXPECT().GetTheCacheData(gomock.Any(), uint(2)).Return(jobData, nil)
+				ma.EXPECT().GetApplicationsByJobID(uint(2)).Return(applications, nil)
+				mca.EXPECT().AddShortlist(gomock.Any(), uint(2), int64(4), ranking).Return(errors.New("error"))
+			},
+		},
+		{
+			name:    "invalidated while ranking",
+			limit:   2,
+			want:    model.Shortlist{JobID: 2, Total: 3, Entries: ranking[:2], NextOffset: &nextOffset},
+			wantErr: false,
+			mockResponse: func(ma *repository.MockApplicationRepository, mj *repository.MockJobRepository, mco *repository.MockComapnyRepo, mca *cache.MockCaching) {
+				recruiter(mj, mco)
+				mca.EXPECT().GetShortlist(gomock.Any(), uint(2)).Return("", errors.New("cache miss"))
+				mca.EXPECT().GetShortlistVersion(gomock.Any(), uint(2)).Return(int64(4), nil)
+				mca.EXPECT().GetTheCacheData(gomock.Any(), uint(2)).Return(jobData, nil)
+				ma.EXPECT().GetApplicationsByJobID(uint(2)).Return(applications, nil)
+				mca.EXPECT().AddShortlist(gomock.Any(), uint(2), int64(4), ranking).Return(cache.ErrStaleShortlist)
+			},
+		},
+		{
+			name:    "version unavailable, not cached",
+			limit:   2,
+			want:    model.Shortlist{JobID: 2, Total: 3, Entries: ranking[:2], NextOffset: &nextOffset},
+			wantErr: false,
+			mockResponse: func(ma *repository.MockApplicationRepository, mj *repository.MockJobRepository, mco *repository.MockComapnyRepo, mca *cache.MockCaching) {
+				recruiter(mj, mco)
+				mca.EXPECT().GetShortlist(gomock.Any(), uint(2)).Return("", errors.New("cache miss"))
+				mca.EXPECT().GetShortlistVersion(gomock.Any(), uint(2)).Return(int64(0), errors.New("error"))
+				mca.EXPECT().GetTheCacheData(gomock.Any(), uint(2)).Return(jobData, nil)
+				ma.EXPECT().GetApplicationsByJobID(uint(2)).Return(applications, nil)
+			},
+		},
+		{
+			name:    "last page from the cache",
+			offset:  2,
+			limit:   2,
+			want:    model.Shortlist{JobID: 2, Total: 3, Entries: ranking[2:]},
+			wantErr: false,
+			mockResponse: func(ma *repository.MockApplicationRepository, mj *repository.MockJobRepository, mco *repository.MockComapnyRepo, mca *cache.MockCaching) {
+				recruiter(mj, mco)
+				mca.EXPECT().GetShortlist(gomock.Any(), uint(2)).Return(`[{"rank":1,"application_id":3,"user_id":13,"name":"meera","stage":"screening","accepted":false,"knocked_out":false,"score":2,"max_score":7,"applied_at":"2024-01-02T03:04:05Z"},{"rank":2,"application_id":1,"user_id":11,"name":"asha","stage":"applied","accepted":false,"knocked_out":false,"score":2,"max_score":7,"applied_at":"2024-01-02T04:04:05Z"},{"rank":3,"application_id":2,"user_id":12,"name":"ravi","stage":"interview","accepted":false,"knocked_out":false,"score":1,"max_score":7,"applied_at":"2024-01-02T03:04:05Z"}]`, nil)
+			},
+		},
+		{
+			name:    "offset past the end",
+			offset:  5,
+			want:    model.Shortlist{JobID: 2, Total: 0, Entries: []model.ShortlistEntry{}},
+			wantErr: false,
+			mockResponse: func(ma *repository.MockApplicationRepository, mj *repository.MockJobRepository, mco *repository.MockComapnyRepo, mca *cache.MockCaching) {
+				recruiter(mj, mco)
+				mca.EXPECT().GetShortlist(gomock.Any(), uint(2)).Return(`[]`, nil)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			ma := repository.NewMockApplicationRepository(mc)
+			mj := repository.NewMockJobRepository(mc)
+			mco := repository.NewMockComapnyRepo(mc)
+			mca := cache.NewMockCaching(mc)
+			tt.mockResponse(ma, mj, mco, mca)
+			s, _ := NewApplicationService(ma, mj, mco, mca)
+			got, err := s.ViewShortlist(context.Background(), 8, 2, tt.offset, tt.limit)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Service.ViewShortlist() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.errIs != nil && !errors.Is(err, tt.errIs) {
+				t.Errorf("Service.ViewShortlist() error = %v, want %v", err, tt.errIs)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Service.ViewShortlist() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,115 @@
+package service
+
+import (
+	"fmt"
+	"job-portal-api/internal/model"
+	"math"
+)
+
+// proficiencyRanks orders the proficiency levels, an unknown or missing
+// level ranks 0.
+var proficiencyRanks = map[string]int{
+	model.ProficiencyBeginner:     1,
+	model.ProficiencyIntermediate: 2,
+	model.ProficiencyAdvanced:     3,
+	model.ProficiencyExpert:       4,
+}
+
+func skillRequirementIDs(requirements []model.SkillRequirement) []uint {
+	var ids []uint
+	for _, v := range requirements {
+		ids = append(ids, v.TechnologyStackID)
+	}
+	return ids
+}
+
+func validateSkillRequirements(requirements []model.SkillRequirement, validationErr *ValidationError) {
+	seen := make(map[uint]bool, len(requirements))
+	for i, v := range requirements {
+		field := fmt.Sprintf("skillRequirements[%d]", i)
+		if v.TechnologyStackID == 0 {
+			validationErr.add(field, "technologyStackId is required")
+		} else if seen[v.TechnologyStackID] {
+			validationErr.add(field, fmt.Sprintf("technology stack %d is listed twice", v.TechnologyStackID))
+		}
+		seen[v.TechnologyStackID] = true
+		if _, ok := proficiencyRanks[v.MinProficiency]; v.MinProficiency != "" && !ok {
+			validationErr.add(field, "minProficiency must be one of beginner intermediate advanced expert")
+		}
+		if v.MinYears < 0 {
+			validationErr.add(field, "minYears cannot be negative")
+		}
+	}
+}
+
+// compareSkills scores how far an application fulfils the skills a job
+// requires. A requirement counts fully when both its minimum proficiency and
+// years are reached, partly when they are reached in part, and not at all
+// when the skill is missing. The criterion matches when every requirement
+// is fully met, the returned score is the average fulfilment.
+func compareSkills(application model.Requestfield, requirements []model.SkillRequirement) (model.CriterionResult, []model.SkillResult) {
+	levels := make(map[uint]model.SkillLevel)
+	for _, v := range application.TechnologyStack {
+		levels[v] = model.SkillLevel{TechnologyStackID: v}
+	}
+	for _, v := range application.Skills {
+		levels[v.TechnologyStackID] = v
+	}
+
+	skills := make([]model.SkillResult, 0, len(requirements))
+	total := 0.0
+	met := 0
+	for _, v := range requirements {
+		skill := fulfilSkill(v, levels)
+		total += skill.Fulfilment
+		if skill.Fulfilment == 1 {
+			met++
+		}
+		skills = append(skills, skill)
+	}
+
+	fulfilment := math.Round(total/float64(len(requirements))*100) / 100
+	result := model.CriterionResult{
+		Criterion: model.CriterionSkills,
+		Matched:   met == len(requirements),
+		Score:     fulfilment,
+		Reason:    fmt.Sprintf("fully meets %d of %d required skills, %.0f%% overall", met, len(requirements), fulfilment*100),
+	}
+	return result, skills
+}
+
+func fulfilSkill(requirement model.SkillRequirement, levels map[uint]model.SkillLevel) model.SkillResult {
+	result := model.SkillResult{TechnologyStackID: requirement.TechnologyStackID}
+
+	level, ok := levels[requirement.TechnologyStackID]
+	if !ok {
+		result.Reason = "missing, job asks for " + describeSkill(requirement.MinProficiency, requirement.MinYears)
+		return result
+	}
+
+	var parts []float64
+	if required := proficiencyRanks[requirement.MinProficiency]; required != 0 {
+		parts = append(parts, math.Min(1, float64(proficiencyRanks[level.Proficiency])/float64(required)))
+	}
+	if requirement.MinYears > 0 {
+		parts = append(parts, math.Min(1, float64(level.Years)/float64(requirement.MinYears)))
+	}
+
+	result.Fulfilment = 1
+	if len(parts) != 0 {
+		sum := 0.0
+		for _, v := range parts {
+			sum += v
+		}
+		result.Fulfilment = math.Round(sum/float64(len(parts))*100) / 100
+	}
+	result.Reason = describeSkill(level.Proficiency, level.Years) + ", job asks for " + describeSkill(requirement.MinProficiency, requirement.MinYears)
+	return result
+}
+
+func describeSkill(proficiency string, years int) string {
+	if proficiency == "" {
+		proficiency = "any level"
+	}
+	return fmt.Sprintf("%s with %d years", proficiency, years)
+}
@@ -0,0 +1,139 @@
+package service
+
+import (
+	"errors"
+	"job-portal-api/internal/cache"
+	"job-portal-api/internal/model"
+	"job-portal-api/internal/repository"
+	"reflect"
+	"testing"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+func TestCompareData_skills(t *testing.T) {
+	jobData := model.Job{
+		MaxNoticePeriod: 60,
+		MaxExperience:   10,
+		SkillRequirements: []model.SkillRequirement{
+			{TechnologyStackID: 1, MinProficiency: model.ProficiencyAdvanced, MinYears: 4},
+			{TechnologyStackID: 2, MinYears: 2},
+			{TechnologyStackID: 3},
+		},
+	}
+	tests := []struct {
+		name        string
+		application model.Requestfield
+		wantSkills  model.CriterionResult
+		wantResults []model.SkillResult
+	}{
+		{
+			name: "every skill fully met",
+			application: model.Requestfield{
+				TechnologyStack: []uint{3},
+				Skills: []model.SkillLevel{
+					{TechnologyStackID: 1, Proficiency: model.ProficiencyExpert, Years: 6},
+					{TechnologyStackID: 2, Proficiency: model.ProficiencyBeginner, Years: 2},
+				},
+			},
+			wantSkills: model.CriterionResult{Criterion: model.CriterionSkills, Matched: true, Weight: 1, Score: 1, Reason: "fully meets 3 of 3 required skills, 100% overall"},
+			wantResults: []model.SkillResult{
+				{TechnologyStackID: 1, Fulfilment: 1, Reason: "expert with 6 years, job asks for advanced with 4 years"},
+				{TechnologyStackID: 2, Fulfilment: 1, Reason: "beginner with 2 years, job asks for any level with 2 years"},
+				{TechnologyStackID: 3, Fulfilment: 1, Reason: "any level with 0 years, job asks for any level with 0 years"},
+			},
+		},
+		{
+			name: "partly met",
+			application: model.Requestfield{
+				Skills: []model.SkillLevel{
+					{TechnologyStackID: 1, Proficiency: model.ProficiencyBeginner, Years: 1},
+					{TechnologyStackID: 2, Years: 1},
+				},
+			},
+			wantSkills: model.CriterionResult{Criterion: model.CriterionSkills, Matched: false, Weight: 1, Score: 0.26, Reason: "fully meets 0 of 3 required skills, 26% overall"},
+			wantResults: []model.SkillResult{
+				{TechnologyStackID: 1, Fulfilment: 0.29, Reason: "beginner with 1 years, job asks for advanced with 4 years"},
+				{TechnologyStackID: 2, Fulfilment: 0.5, Reason: "any level with 1 years, job asks for any level with 2 years"},
+				{TechnologyStackID: 3, Fulfilment: 0, Reason: "missing, job asks for any level with 0 years"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := CompareData(model.NewUserApplication{Jobs: tt.application}, jobData)
+			if !reflect.DeepEqual(got.Criteria[3], tt.wantSkills) {
+				t.Errorf("CompareData() skills = %v, want %v", got.Criteria[3], tt.wantSkills)
+			}
+			if !reflect.DeepEqual(got.Skills, tt.wantResults) {
+				t.Errorf("CompareData() skill results = %v, want %v", got.Skills, tt.wantResults)
+			}
+		})
+	}
+}
+
+func TestService_CreateJobByCompanyId_skillRequirements(t *testing.T) {
+	tests := []struct {
+		name          string
+		requirements  []model.SkillRequirement
+		missing       []uint
+		wantFieldErrs []model.FieldError
+	}{
+		{
+			name:         "valid requirements",
+			requirements: []model.SkillRequirement{{TechnologyStackID: 1, MinProficiency: model.ProficiencyAdvanced, MinYears: 5}, {TechnologyStackID: 2}},
+		},
+		{
+			name: "invalid requirements",
+			requirements: []model.SkillRequirement{
+				{TechnologyStackID: 1, MinProficiency: "guru"},
+				{TechnologyStackID: 1, MinYears: -1},
+				{TechnologyStackID: 4},
+			},
+			missing: []uint{4},
+			wantFieldErrs: []model.FieldError{
+				{Field: "skillRequirements[0]", Message: "minProficiency must be one of beginner intermediate advanced expert"},
+				{Field: "skillRequirements[1]", Message: "technology stack 1 is listed twice"},
+				{Field: "skillRequirements[1]", Message: "minYears cannot be negative"},
+				{Field: "skillRequirements", Message: "unknown ids [4]"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			mj := repository.NewMockJobRepository(mc)
+			mcr := repository.NewMockComapnyRepo(mc)
+			mt := repository.NewMockTaxonomyRepository(mc)
+			mca := cache.NewMockCaching(mc)
+			s, _ := NewJobService(mj, mcr, mt, mca, testRates)
+			mcr.EXPECT().GetCompanyByID(gomock.Any()).Return(model.Company{OwnerID: 8}, nil).AnyTimes()
+			mt.EXPECT().FindMissingTaxonomyIDs(model.TaxonomyTechnologyStack, skillRequirementIDs(tt.requirements)).Return(tt.missing, nil)
+			mt.EXPECT().FindMissingTaxonomyIDs(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+
+			var saved model.Job
+			mj.EXPECT().CreateJob(gomock.Any()).DoAndReturn(func(jobData model.Job) (model.Response, error) {
+				saved = jobData
+				return model.Response{Id: 1}, nil
+			}).AnyTimes()
+
+			_, err := s.CreateJobByCompanyId(8, model.NewJobs{Jobname: "golang developer", SkillRequirements: tt.requirements}, 1)
+			if tt.wantFieldErrs != nil {
+				var validationErr *ValidationError
+				if !errors.As(err, &validationErr) {
+					t.Fatalf("Service.CreateJobByCompanyId() error = %v, want a validation error", err)
+				}
+				if !reflect.DeepEqual(validationErr.Errors, tt.wantFieldErrs) {
+					t.Errorf("Service.CreateJobByCompanyId() field errors = %v, want %v", validationErr.Errors, tt.wantFieldErrs)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Service.CreateJobByCompanyId() error = %v", err)
+			}
+			if !reflect.DeepEqual(saved.SkillRequirements, tt.requirements) {
+				t.Errorf("Service.CreateJobByCompanyId() skill requirements = %v, want %v", saved.SkillRequirements, tt.requirements)
+			}
+		})
+	}
+}
@@ -0,0 +1,133 @@
+package service
+
+import (
+	"errors"
+	"job-portal-api/internal/model"
+	"job-portal-api/internal/repository"
+	"strings"
+
+	"github.com/rs/zerolog/log"
+)
+
+//go:generate mockgen -source=taxonomyService.go -destination=taxonomyService_mock.go -package=service
+type TaxonomyService interface {
+	AddTaxonomy(kind string, taxonomy model.NewTaxonomy) (model.Taxonomy, error)
+	ViewTaxonomies(kind string) ([]model.Taxonomy, error)
+	RenameTaxonomy(kind string, id uint, taxonomy model.NewTaxonomy) (model.Taxonomy, error)
+	RetireTaxonomy(kind string, id uint) error
+	SeedTaxonomies(catalogue model.TaxonomyCatalogue) (map[string]int, error)
+	SetLocationCoordinates(id uint, coordinates model.Coordinates) (model.Taxonomy, error)
+}
+
+func NewTaxonomyService(taxonomyRepo repository.TaxonomyRepository) (TaxonomyService, error) {
+	if taxonomyRepo == nil {
+		log.Info().Msg("taxonomy repository cannot be nil")
+		return nil, errors.New("taxonomy repository cannot be nil")
+	}
+	return &Service{
+		taxonomyRepo: taxonomyRepo,
+	}, nil
+}
+
+func isTaxonomyKind(kind string) bool {
+	switch kind {
+	case model.TaxonomyLocation, model.TaxonomyTechnologyStack, model.TaxonomyQualification, model.TaxonomyShift, model.TaxonomyJobType:
+		return true
+	}
+	return false
+}
+
+func (s *Service) AddTaxonomy(kind string, taxonomy model.NewTaxonomy) (model.Taxonomy, error) {
+	if !isTaxonomyKind(kind) {
+		log.Error().Str("kind", kind).Msg("unknown reference data kind")
+		return model.Taxonomy{}, errors.New("unknown reference data kind")
+	}
+
+	name := strings.TrimSpace(taxonomy.Name)
+	if name == "" {
+		return model.Taxonomy{}, errors.New("name cannot be empty")
+	}
+
+	return s.taxonomyRepo.CreateTaxonomy(kind, name)
+}
+
+func (s *Service) ViewTaxonomies(kind string) ([]model.Taxonomy, error) {
+	if !isTaxonomyKind(kind) {
+		log.Error().Str("kind", kind).Msg("unknown reference data kind")
+		return nil, errors.New("unknown reference data kind")
+	}
+
+	return s.taxonomyRepo.GetAllTaxonomies(kind)
+}
+
+func (s *Service) RenameTaxonomy(kind string, id uint, taxonomy model.NewTaxonomy) (model.Taxonomy, error) {
+	if !isTaxonomyKind(kind) {
+		log.Error().Str("kind", kind).Msg("unknown reference data kind")
+		return model.Taxonomy{}, errors.New("unknown reference data kind")
+	}
+
+	name := strings.TrimSpace(taxonomy.Name)
+	if name == "" {
+		return model.Taxonomy{}, errors.New("name cannot be empty")
+	}
+
+	return s.taxonomyRepo.RenameTaxonomy(kind, id, name)
+}
+
+func (s *Service) RetireTaxonomy(kind string, id uint) error {
+	if !isTaxonomyKind(kind) {
+		log.Error().Str("kind", kind).Msg("unknown reference data kind")
+		return errors.New("unknown reference data kind")
+	}
+
+	return s.taxonomyRepo.DeleteTaxonomy(kind, id)
+}
+
+func (s *Service) SeedTaxonomies(catalogue model.TaxonomyCatalogue) (map[string]int, error) {
+	entries := map[string][]string{
+		model.TaxonomyLocation:        catalogue.Locations,
+		model.TaxonomyTechnologyStack: catalogue.TechnologyStacks,
+		model.TaxonomyQualification:   catalogue.Qualifications,
+		model.TaxonomyShift:           catalogue.Shifts,
+		model.TaxonomyJobType:         catalogue.JobTypes,
+	}
+
+	created := make(map[string]int, len(entries))
+	for kind, names := range entries {
+		var cleaned []string
+		for _, v := range names {
+			v = strings.TrimSpace(v)
+			if v != "" {
+				cleaned = append(cleaned, v)
+			}
+		}
+		if len(cleaned) == 0 {
+			continue
+		}
+
+		count, err := s.taxonomyRepo.SeedTaxonomies(kind, cleaned)
+		if err != nil {
+			return nil, err
+		}
+		created[kind] = count
+	}
+
+	//coordinates go in after the locations so that new locations get them too
+	if len(catalogue.LocationCoordinates) != 0 {
+		count, err := s.taxonomyRepo.SeedLocationCoordinates(catalogue.LocationCoordinates)
+		if err != nil {
+			return nil, err
+		}
+		created["location_coordinates"] = count
+	}
+
+	return created, nil
+}
+
+func (s *Service) SetLocationCoordinates(id uint, coordinates model.Coordinates) (model.Taxonomy, error) {
+	if coordinates.Latitude == nil || coordinates.Longitude == nil {
+		return model.Taxonomy{}, errors.New("latitude and longitude are required")
+	}
+
+	return s.taxonomyRepo.SetLocationCoordinates(id, coordinates)
+}
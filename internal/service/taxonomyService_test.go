@@ -0,0 +1,346 @@
+package service
+
+import (
+	"errors"
+	"job-portal-api/internal/model"
+	"job-portal-api/internal/repository"
+	"reflect"
+	"testing"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+func TestService_AddTaxonomy(t *testing.T) {
+	type args struct {
+		kind     string
+		taxonomy model.NewTaxonomy
+	}
+	tests := []struct {
+		name         string
+		args         args
+		want         model.Taxonomy
+		wantErr      bool
+		mockResponse func(mt *repository.MockTaxonomyRepository)
+	}{
+		{
+			name:    "failure - unknown kind",
+			args:    args{kind: "countries", taxonomy: model.NewTaxonomy{Name: "India"}},
+			want:    model.Taxonomy{},
+			wantErr: true,
+		},
+		{
+			name:    "failure - blank name",
+			args:    args{kind: model.TaxonomyLocation, taxonomy: model.NewTaxonomy{Name: "   "}},
+			want:    model.Taxonomy{},
+			wantErr: true,
+		},
+		{
+			name:    "failure",
+			args:    args{kind: model.TaxonomyLocation, taxonomy: model.NewTaxonomy{Name: "Mysuru"}},
+			want:    model.Taxonomy{},
+			wantErr: true,
+			mockResponse: func(mt *repository.MockTaxonomyRepository) {
+				mt.EXPECT().CreateTaxonomy(model.TaxonomyLocation, "Mysuru").Return(model.Taxonomy{}, errors.New("error"))
+			},
+		},
+		{
+			name:    "success",
+			args:    args{kind: model.TaxonomyTechnologyStack, taxonomy: model.NewTaxonomy{Name: " Rust "}},
+			want:    model.Taxonomy{ID: 13, Name: "Rust"},
+			wantErr: false,
+			mockResponse: func(mt *repository.MockTaxonomyRepository) {
+				mt.EXPECT().CreateTaxonomy(model.TaxonomyTechnologyStack, "Rust").Return(model.Taxonomy{ID: 13, Name: "Rust"}, nil)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			mt := repository.NewMockTaxonomyRepository(mc)
+			s, _ := NewTaxonomyService(mt)
+			if tt.mockResponse != nil {
+				tt.mockResponse(mt)
+			}
+			got, err := s.AddTaxonomy(tt.args.kind, tt.args.taxonomy)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Service.AddTaxonomy() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Service.AddTaxonomy() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestService_ViewTaxonomies(t *testing.T) {
+	tests := []struct {
+		name         string
+		kind         string
+		want         []model.Taxonomy
+		wantErr      bool
+		mockResponse func(mt *repository.MockTaxonomyRepository)
+	}{
+		{
+			name:    "failure - unknown kind",
+			kind:    "countries",
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name:    "success",
+			kind:    model.TaxonomyShift,
+			want:    []model.Taxonomy{{ID: 1, Name: "Day"}},
+			wantErr: false,
+			mockResponse: func(mt *repository.MockTaxonomyRepository) {
+				mt.EXPECT().GetAllTaxonomies(model.TaxonomyShift).Return([]model.Taxonomy{{ID: 1, Name: "Day"}}, nil)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			mt := repository.NewMockTaxonomyRepository(mc)
+			s, _ := NewTaxonomyService(mt)
+			if tt.mockResponse != nil {
+				tt.mockResponse(mt)
+			}
+			got, err := s.ViewTaxonomies(tt.kind)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Service.ViewTaxonomies() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Service.ViewTaxonomies() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestService_RenameTaxonomy(t *testing.T) {
+	tests := []struct {
+		name         string
+		kind         string
+		taxonomy     model.NewTaxonomy
+		want         model.Taxonomy
+		wantErr      bool
+		mockResponse func(mt *repository.MockTaxonomyRepository)
+	}{
+		{
+			name:     "failure - unknown kind",
+			kind:     "countries",
+			taxonomy: model.NewTaxonomy{Name: "India"},
+			want:     model.Taxonomy{},
+			wantErr:  true,
+		},
+		{
+			name:     "success",
+			kind:     model.TaxonomyLocation,
+			taxonomy: model.NewTaxonomy{Name: "Bengaluru"},
+			want:     model.Taxonomy{ID: 1, Name: "Bengaluru"},
+			wantErr:  false,
+			mockResponse: func(mt *repository.MockTaxonomyRepository) {
+				mt.EXPECT().RenameTaxonomy(model.TaxonomyLocation, uint(1), "Bengaluru").Return(model.Taxonomy{ID: 1, Name: "Bengaluru"}, nil)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			mt := repository.NewMockTaxonomyRepository(mc)
+			s, _ := NewTaxonomyService(mt)
+			if tt.mockResponse != nil {
+				tt.mockResponse(mt)
+			}
+			got, err := s.RenameTaxonomy(tt.kind, 1, tt.taxonomy)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Service.RenameTaxonomy() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Service.RenameTaxonomy() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestService_RetireTaxonomy(t *testing.T) {
+	tests := []struct {
+		name         string
+		kind         string
+		wantErr      bool
+		mockResponse func(mt *repository.MockTaxonomyRepository)
+	}{
+		{
+			name:    "failure - unknown kind",
+			kind:    "countries",
+			wantErr: true,
+		},
+		{
+			name:    "failure",
+			kind:    model.TaxonomyJobType,
+			wantErr: true,
+			mockResponse: func(mt *repository.MockTaxonomyRepository) {
+				mt.EXPECT().DeleteTaxonomy(model.TaxonomyJobType, uint(1)).Return(errors.New("error"))
+			},
+		},
+		{
+			name:    "success",
+			kind:    model.TaxonomyJobType,
+			wantErr: false,
+			mockResponse: func(mt *repository.MockTaxonomyRepository) {
+				mt.EXPECT().DeleteTaxonomy(model.TaxonomyJobType, uint(1)).Return(nil)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			mt := repository.NewMockTaxonomyRepository(mc)
+			s, _ := NewTaxonomyService(mt)
+			if tt.mockResponse != nil {
+				tt.mockResponse(mt)
+			}
+			err := s.RetireTaxonomy(tt.kind, 1)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Service.RetireTaxonomy() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestService_SeedTaxonomies(t *testing.T) {
+	tests := []struct {
+		name         string
+		catalogue    model.TaxonomyCatalogue
+		want         map[string]int
+		wantErr      bool
+		mockResponse func(mt *repository.MockTaxonomyRepository)
+	}{
+		{
+			name:      "failure",
+			catalogue: model.TaxonomyCatalogue{Shifts: []string{"Day"}},
+			want:      nil,
+			wantErr:   true,
+			mockResponse: func(mt *repository.MockTaxonomyRepository) {
+				mt.EXPECT().SeedTaxonomies(model.TaxonomyShift, []string{"Day"}).Return(0, errors.New("error"))
+			},
+		},
+		{
+			name: "success",
+			catalogue: model.TaxonomyCatalogue{
+				Locations: []string{" Pune ", ""},
+				Shifts:    []string{"Day", "Night"},
+			},
+			want:    map[string]int{model.TaxonomyLocation: 1, model.TaxonomyShift: 0},
+			wantErr: false,
+			mockResponse: func(mt *repository.MockTaxonomyRepository) {
+				mt.EXPECT().SeedTaxonomies(model.TaxonomyLocation, []string{"Pune"}).Return(1, nil)
+				mt.EXPECT().SeedTaxonomies(model.TaxonomyShift, []string{"Day", "Night"}).Return(0, nil)
+			},
+		},
+		{
+			name: "failure - location coordinates",
+			catalogue: model.TaxonomyCatalogue{
+				LocationCoordinates: map[string]model.Coordinates{"Pune": {}},
+			},
+			want:    nil,
+			wantErr: true,
+			mockResponse: func(mt *repository.MockTaxonomyRepository) {
+				mt.EXPECT().SeedLocationCoordinates(gomock.Any()).Return(0, errors.New("error"))
+			},
+		},
+		{
+			name: "success - location coordinates",
+			catalogue: model.TaxonomyCatalogue{
+				Locations:           []string{"Pune"},
+				LocationCoordinates: map[string]model.Coordinates{"Pune": {}},
+			},
+			want:    map[string]int{model.TaxonomyLocation: 1, "location_coordinates": 1},
+			wantErr: false,
+			mockResponse: func(mt *repository.MockTaxonomyRepository) {
+				gomock.InOrder(
+					mt.EXPECT().SeedTaxonomies(model.TaxonomyLocation, []string{"Pune"}).Return(1, nil),
+					mt.EXPECT().SeedLocationCoordinates(map[string]model.Coordinates{"Pune": {}}).Return(1, nil),
+				)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			mt := repository.NewMockTaxonomyRepository(mc)
+			s, _ := NewTaxonomyService(mt)
+			if tt.mockResponse != nil {
+				tt.mockResponse(mt)
+			}
+			got, err := s.SeedTaxonomies(tt.catalogue)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Service.SeedTaxonomies() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Service.SeedTaxonomies() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestService_SetLocationCoordinates(t *testing.T) {
+	latitude := 12.97
+	longitude := 77.59
+	type args struct {
+		id          uint
+		coordinates model.Coordinates
+	}
+	tests := []struct {
+		name         string
+		args         args
+		want         model.Taxonomy
+		wantErr      bool
+		mockResponse func(mt *repository.MockTaxonomyRepository)
+	}{
+		{
+			name:    "failure - missing longitude",
+			args:    args{id: 1, coordinates: model.Coordinates{Latitude: &latitude}},
+			want:    model.Taxonomy{},
+			wantErr: true,
+		},
+		{
+			name:    "failure",
+			args:    args{id: 1, coordinates: model.Coordinates{Latitude: &latitude, Longitude: &longitude}},
+			want:    model.Taxonomy{},
+			wantErr: true,
+			mockResponse: func(mt *repository.MockTaxonomyRepository) {
+				mt.EXPECT().SetLocationCoordinates(uint(1), gomock.Any()).Return(model.Taxonomy{}, errors.New("error"))
+			},
+		},
+		{
+			name:    "success",
+			args:    args{id: 1, coordinates: model.Coordinates{Latitude: &latitude, Longitude: &longitude}},
+			want:    model.Taxonomy{ID: 1, Name: "Bengaluru", Latitude: &latitude, Longitude: &longitude},
+			wantErr: false,
+			mockResponse: func(mt *repository.MockTaxonomyRepository) {
+				mt.EXPECT().SetLocationCoordinates(uint(1), model.Coordinates{Latitude: &latitude, Longitude: &longitude}).Return(model.Taxonomy{ID: 1, Name: "Bengaluru", Latitude: &latitude, Longitude: &longitude}, nil)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := gomock.NewController(t)
+			mt := repository.NewMockTaxonomyRepository(mc)
+			s, _ := NewTaxonomyService(mt)
+			if tt.mockResponse != nil {
+				tt.mockResponse(mt)
+			}
+			got, err := s.SetLocationCoordinates(tt.args.id, tt.args.coordinates)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Service.SetLocationCoordinates() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Service.SetLocationCoordinates() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
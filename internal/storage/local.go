@@ -0,0 +1,116 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/rs/zerolog/log"
+)
+
+// LocalStorage keeps every object as a file below its root directory.
+type LocalStorage struct {
+	root string
+}
+
+func NewLocalStorage(root string) (Storage, error) {
+	if root == "" {
+		log.Info().Msg("storage directory cannot be empty")
+		return nil, errors.New("storage directory cannot be empty")
+	}
+	err := os.MkdirAll(root, 0o750)
+	if err != nil {
+		log.Error().Err(err).Msg("error in creating storage directory")
+		return nil, fmt.Errorf("error in creating storage directory : %w", err)
+	}
+	return &LocalStorage{
+		root: root,
+	}, nil
+}
+
+func (l *LocalStorage) path(key string) (string, error) {
+	if !validKey(key) {
+		return "", fmt.Errorf("invalid storage key %q", key)
+	}
+	return filepath.Join(l.root, filepath.FromSlash(key)), nil
+}
+
+// Put writes the object to a temporary file first, so that a failed upload
+// never leaves a partial file under the key.
+func (l *LocalStorage) Put(ctx context.Context, key string, data io.Reader, size int64, contentType string) error {
+	path, err := l.path(key)
+	if err != nil {
+		return err
+	}
+
+	err = os.MkdirAll(filepath.Dir(path), 0o750)
+	if err != nil {
+		log.Error().Err(err).Msg("error in creating storage directory")
+		return errors.New("could not store the object")
+	}
+
+	file, err := os.CreateTemp(filepath.Dir(path), ".upload-*")
+	if err != nil {
+		log.Error().Err(err).Msg("error in creating storage file")
+		return errors.New("could not store the object")
+	}
+	defer os.Remove(file.Name())
+
+	written, err := io.Copy(file, data)
+	closeErr := file.Close()
+	if err != nil || closeErr != nil {
+		log.Error().Err(errors.Join(err, closeErr)).Msg("error in writing storage file")
+		return errors.New("could not store the object")
+	}
+	if written != size {
+		log.Error().Int64("size", size).Int64("written", written).Msg("object size does not match")
+		return errors.New("could not store the object")
+	}
+	if ctx.Err() != nil {
+		return ctx.Err()
+	}
+
+	err = os.Rename(file.Name(), path)
+	if err != nil {
+		log.Error().Err(err).Msg("error in moving storage file")
+		return errors.New("could not store the object")
+	}
+
+	return nil
+}
+
+func (l *LocalStorage) Get(ctx context.Context, key string) (io.ReadCloser, error) {
+	path, err := l.path(key)
+	if err != nil {
+		return nil, err
+	}
+
+	file, err := os.Open(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		log.Error().Err(err).Msg("error in opening storage file")
+		return nil, errors.New("could not read the object")
+	}
+
+	return file, nil
+}
+
+func (l *LocalStorage) Delete(ctx context.Context, key string) error {
+	path, err := l.path(key)
+	if err != nil {
+		return err
+	}
+
+	err = os.Remove(path)
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		log.Error().Err(err).Msg("error in deleting storage file")
+		return errors.New("could not delete the object")
+	}
+
+	return nil
+}
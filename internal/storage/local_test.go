@@ -0,0 +1,64 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestLocalStorage(t *testing.T) {
+	root := t.TempDir()
+	s, err := NewLocalStorage(root)
+	if err != nil {
+		t.Fatalf("NewLocalStorage() error = %v", err)
+	}
+	ctx := context.Background()
+
+	err = s.Put(ctx, "applications/1/resume", strings.NewReader("my resume"), 9, "text/plain")
+	if err != nil {
+		t.Fatalf("LocalStorage.Put() error = %v", err)
+	}
+
+	object, err := s.Get(ctx, "applications/1/resume")
+	if err != nil {
+		t.Fatalf("LocalStorage.Get() error = %v", err)
+	}
+	data, _ := io.ReadAll(object)
+	object.Close()
+	if string(data) != "my resume" {
+		t.Errorf("LocalStorage.Get() = %q, want %q", data, "my resume")
+	}
+
+	err = s.Put(ctx, "applications/1/short", strings.NewReader("cut"), 9, "text/plain")
+	if err == nil {
+		t.Errorf("LocalStorage.Put() of a short upload did not fail")
+	}
+	_, err = os.Stat(filepath.Join(root, "applications", "1", "short"))
+	if !errors.Is(err, os.ErrNotExist) {
+		t.Errorf("LocalStorage.Put() left a partial file, stat error = %v", err)
+	}
+
+	for _, key := range []string{"", "../secret", "applications//1", "/etc/passwd", `applications\..\1`} {
+		err = s.Put(ctx, key, strings.NewReader("x"), 1, "text/plain")
+		if err == nil {
+			t.Errorf("LocalStorage.Put(%q) did not fail", key)
+		}
+	}
+
+	err = s.Delete(ctx, "applications/1/resume")
+	if err != nil {
+		t.Fatalf("LocalStorage.Delete() error = %v", err)
+	}
+	_, err = s.Get(ctx, "applications/1/resume")
+	if !errors.Is(err, ErrNotFound) {
+		t.Errorf("LocalStorage.Get() after delete error = %v, want %v", err, ErrNotFound)
+	}
+	err = s.Delete(ctx, "applications/1/resume")
+	if err != nil {
+		t.Errorf("LocalStorage.Delete() of a missing object error = %v", err)
+	}
+}
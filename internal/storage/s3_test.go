@@ -0,0 +1,183 @@
+package storage
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// fakeS3 is a local stand-in for an S3 bucket. It checks the signature of
+// every request the same way S3 does and keeps the objects in memory.
+type fakeS3 struct {
+	bucket    string
+	accessKey string
+	secretKey string
+	region    string
+
+	mu      sync.Mutex
+	objects map[string]string
+	types   map[string]string
+}
+
+var authorization = regexp.MustCompile(`^AWS4-HMAC-SHA256 Credential=([^/]+)/(\d{8})/([^/]+)/s3/aws4_request, SignedHeaders=([a-z0-9;-]+), Signature=([0-9a-f]{64})$`)
+
+func (f *fakeS3) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if !f.verify(r) {
+		w.WriteHeader(http.StatusForbidden)
+		io.WriteString(w, "<Error><Code>SignatureDoesNotMatch</Code></Error>")
+		return
+	}
+
+	prefix := "/" + f.bucket + "/"
+	if !strings.HasPrefix(r.URL.Path, prefix) {
+		w.WriteHeader(http.StatusNotFound)
+		io.WriteString(w, "<Error><Code>NoSuchBucket</Code></Error>")
+		return
+	}
+	key := strings.TrimPrefix(r.URL.Path, prefix)
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	switch r.Method {
+	case http.MethodPut:
+		data, _ := io.ReadAll(r.Body)
+		f.objects[key] = string(data)
+		f.types[key] = r.Header.Get("Content-Type")
+	case http.MethodGet:
+		data, ok := f.objects[key]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			io.WriteString(w, "<Error><Code>NoSuchKey</Code></Error>")
+			return
+		}
+		io.WriteString(w, data)
+	case http.MethodDelete:
+		delete(f.objects, key)
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
+func (f *fakeS3) verify(r *http.Request) bool {
+	match := authorization.FindStringSubmatch(r.Header.Get("Authorization"))
+	if match == nil || match[1] != f.accessKey || match[3] != f.region {
+		return false
+	}
+	amzDate := r.Header.Get("X-Amz-Date")
+	if !strings.HasPrefix(amzDate, match[2]) {
+		return false
+	}
+
+	var canonicalHeaders []string
+	for _, v := range strings.Split(match[4], ";") {
+		value := r.Header.Get(v)
+		if v == "host" {
+			value = r.Host
+		}
+		canonicalHeaders = append(canonicalHeaders, v+":"+value)
+	}
+	canonicalRequest := strings.Join([]string{
+		r.Method,
+		r.URL.EscapedPath(),
+		r.URL.RawQuery,
+		strings.Join(canonicalHeaders, "\n"),
+		"",
+		match[4],
+		r.Header.Get("X-Amz-Content-Sha256"),
+	}, "\n")
+	stringToSign := strings.Join([]string{
+		"AWS4-HMAC-SHA256",
+		amzDate,
+		match[2] + "/" + match[3] + "/s3/aws4_request",
+		hashHex([]byte(canonicalRequest)),
+	}, "\n")
+	want := hex.EncodeToString(hmacSHA256(signingKey(f.secretKey, match[2], match[3], "s3"), stringToSign))
+
+	return want == match[5]
+}
+
+func TestSigningKey(t *testing.T) {
+	//example from the AWS signature V4 documentation
+	got := hex.EncodeToString(signingKey("wJalrXUtnFEMI/K7MDENG+bPxRfiCYEXAMPLEKEY", "20120215", "us-east-1", "iam"))
+	want := "f4780e2d9f65fa895f9c67b32ce1baf0b0d8a43505a000a1a9e090d414db404d"
+	if got != want {
+		t.Errorf("signingKey() = %v, want %v", got, want)
+	}
+}
+
+func TestS3Storage(t *testing.T) {
+	fake := &fakeS3{
+		bucket:    "attachments",
+		accessKey: "minio",
+		secretKey: "minio-secret",
+		region:    "eu-west-1",
+		objects:   map[string]string{},
+		types:     map[string]string{},
+	}
+	server := httptest.NewServer(fake)
+	defer server.Close()
+
+	s, err := NewS3Storage(S3Config{
+		Endpoint:  server.URL,
+		Region:    "eu-west-1",
+		Bucket:    "attachments",
+		AccessKey: "minio",
+		SecretKey: "minio-secret",
+	}, server.Client())
+	if err != nil {
+		t.Fatalf("NewS3Storage() error = %v", err)
+	}
+	s.(*S3Storage).now = func() time.Time { return time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC) }
+	ctx := context.Background()
+
+	err = s.Put(ctx, "applications/1/cover letter.txt", strings.NewReader("dear team"), 9, "text/plain")
+	if err != nil {
+		t.Fatalf("S3Storage.Put() error = %v", err)
+	}
+	if fake.objects["applications/1/cover letter.txt"] != "dear team" || fake.types["applications/1/cover letter.txt"] != "text/plain" {
+		t.Errorf("S3Storage.Put() stored %q as %q", fake.objects["applications/1/cover letter.txt"], fake.types["applications/1/cover letter.txt"])
+	}
+
+	object, err := s.Get(ctx, "applications/1/cover letter.txt")
+	if err != nil {
+		t.Fatalf("S3Storage.Get() error = %v", err)
+	}
+	data, _ := io.ReadAll(object)
+	object.Close()
+	if string(data) != "dear team" {
+		t.Errorf("S3Storage.Get() = %q, want %q", data, "dear team")
+	}
+
+	err = s.Delete(ctx, "applications/1/cover letter.txt")
+	if err != nil {
+		t.Fatalf("S3Storage.Delete() error = %v", err)
+	}
+	_, err = s.Get(ctx, "applications/1/cover letter.txt")
+	if !errors.Is(err, ErrNotFound) {
+		t.Errorf("S3Storage.Get() after delete error = %v, want %v", err, ErrNotFound)
+	}
+
+	_, err = s.Get(ctx, "../attachments")
+	if err == nil {
+		t.Errorf("S3Storage.Get() of an invalid key did not fail")
+	}
+
+	wrongKey, _ := NewS3Storage(S3Config{
+		Endpoint:  server.URL,
+		Region:    "eu-west-1",
+		Bucket:    "attachments",
+		AccessKey: "minio",
+		SecretKey: "wrong",
+	}, server.Client())
+	err = wrongKey.Put(ctx, "applications/1/resume", strings.NewReader("x"), 1, "text/plain")
+	if err == nil {
+		t.Errorf("S3Storage.Put() with a wrong secret did not fail")
+	}
+}
@@ -0,0 +1,32 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strings"
+)
+
+// ErrNotFound is returned by Get when nothing is stored under the key.
+var ErrNotFound = errors.New("object not found")
+
+//go:generate mockgen -source=storage.go -destination=storage_mock.go -package=storage
+type Storage interface {
+	Put(ctx context.Context, key string, data io.Reader, size int64, contentType string) error
+	Get(ctx context.Context, key string) (io.ReadCloser, error)
+	Delete(ctx context.Context, key string) error
+}
+
+// validKey accepts slash separated keys without empty, "." or ".." parts,
+// so that a key can never point outside of the storage root.
+func validKey(key string) bool {
+	if key == "" {
+		return false
+	}
+	for _, v := range strings.Split(key, "/") {
+		if v == "" || v == "." || v == ".." || strings.ContainsRune(v, '\\') {
+			return false
+		}
+	}
+	return true
+}